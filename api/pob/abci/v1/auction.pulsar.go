@@ -13,524 +13,6 @@ import (
 	sync "sync"
 )
 
-var (
-	md_AuctionInfo                      protoreflect.MessageDescriptor
-	fd_AuctionInfo_extended_commit_info protoreflect.FieldDescriptor
-	fd_AuctionInfo_max_tx_bytes         protoreflect.FieldDescriptor
-	fd_AuctionInfo_num_txs              protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_pob_abci_v1_auction_proto_init()
-	md_AuctionInfo = File_pob_abci_v1_auction_proto.Messages().ByName("AuctionInfo")
-	fd_AuctionInfo_extended_commit_info = md_AuctionInfo.Fields().ByName("extended_commit_info")
-	fd_AuctionInfo_max_tx_bytes = md_AuctionInfo.Fields().ByName("max_tx_bytes")
-	fd_AuctionInfo_num_txs = md_AuctionInfo.Fields().ByName("num_txs")
-}
-
-var _ protoreflect.Message = (*fastReflection_AuctionInfo)(nil)
-
-type fastReflection_AuctionInfo AuctionInfo
-
-func (x *AuctionInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AuctionInfo)(x)
-}
-
-func (x *AuctionInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_pob_abci_v1_auction_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_AuctionInfo_messageType fastReflection_AuctionInfo_messageType
-var _ protoreflect.MessageType = fastReflection_AuctionInfo_messageType{}
-
-type fastReflection_AuctionInfo_messageType struct{}
-
-func (x fastReflection_AuctionInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AuctionInfo)(nil)
-}
-func (x fastReflection_AuctionInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_AuctionInfo)
-}
-func (x fastReflection_AuctionInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AuctionInfo
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_AuctionInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_AuctionInfo
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AuctionInfo) Type() protoreflect.MessageType {
-	return _fastReflection_AuctionInfo_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AuctionInfo) New() protoreflect.Message {
-	return new(fastReflection_AuctionInfo)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_AuctionInfo) Interface() protoreflect.ProtoMessage {
-	return (*AuctionInfo)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_AuctionInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ExtendedCommitInfo) != 0 {
-		value := protoreflect.ValueOfBytes(x.ExtendedCommitInfo)
-		if !f(fd_AuctionInfo_extended_commit_info, value) {
-			return
-		}
-	}
-	if x.MaxTxBytes != int64(0) {
-		value := protoreflect.ValueOfInt64(x.MaxTxBytes)
-		if !f(fd_AuctionInfo_max_tx_bytes, value) {
-			return
-		}
-	}
-	if x.NumTxs != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NumTxs)
-		if !f(fd_AuctionInfo_num_txs, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_AuctionInfo) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "pob.abci.v1.AuctionInfo.extended_commit_info":
-		return len(x.ExtendedCommitInfo) != 0
-	case "pob.abci.v1.AuctionInfo.max_tx_bytes":
-		return x.MaxTxBytes != int64(0)
-	case "pob.abci.v1.AuctionInfo.num_txs":
-		return x.NumTxs != uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pob.abci.v1.AuctionInfo"))
-		}
-		panic(fmt.Errorf("message pob.abci.v1.AuctionInfo does not contain field %s", fd.FullName()))
-	}
-}
-
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuctionInfo) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "pob.abci.v1.AuctionInfo.extended_commit_info":
-		x.ExtendedCommitInfo = nil
-	case "pob.abci.v1.AuctionInfo.max_tx_bytes":
-		x.MaxTxBytes = int64(0)
-	case "pob.abci.v1.AuctionInfo.num_txs":
-		x.NumTxs = uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pob.abci.v1.AuctionInfo"))
-		}
-		panic(fmt.Errorf("message pob.abci.v1.AuctionInfo does not contain field %s", fd.FullName()))
-	}
-}
-
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AuctionInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "pob.abci.v1.AuctionInfo.extended_commit_info":
-		value := x.ExtendedCommitInfo
-		return protoreflect.ValueOfBytes(value)
-	case "pob.abci.v1.AuctionInfo.max_tx_bytes":
-		value := x.MaxTxBytes
-		return protoreflect.ValueOfInt64(value)
-	case "pob.abci.v1.AuctionInfo.num_txs":
-		value := x.NumTxs
-		return protoreflect.ValueOfUint64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pob.abci.v1.AuctionInfo"))
-		}
-		panic(fmt.Errorf("message pob.abci.v1.AuctionInfo does not contain field %s", descriptor.FullName()))
-	}
-}
-
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuctionInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "pob.abci.v1.AuctionInfo.extended_commit_info":
-		x.ExtendedCommitInfo = value.Bytes()
-	case "pob.abci.v1.AuctionInfo.max_tx_bytes":
-		x.MaxTxBytes = value.Int()
-	case "pob.abci.v1.AuctionInfo.num_txs":
-		x.NumTxs = value.Uint()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pob.abci.v1.AuctionInfo"))
-		}
-		panic(fmt.Errorf("message pob.abci.v1.AuctionInfo does not contain field %s", fd.FullName()))
-	}
-}
-
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuctionInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "pob.abci.v1.AuctionInfo.extended_commit_info":
-		panic(fmt.Errorf("field extended_commit_info of message pob.abci.v1.AuctionInfo is not mutable"))
-	case "pob.abci.v1.AuctionInfo.max_tx_bytes":
-		panic(fmt.Errorf("field max_tx_bytes of message pob.abci.v1.AuctionInfo is not mutable"))
-	case "pob.abci.v1.AuctionInfo.num_txs":
-		panic(fmt.Errorf("field num_txs of message pob.abci.v1.AuctionInfo is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pob.abci.v1.AuctionInfo"))
-		}
-		panic(fmt.Errorf("message pob.abci.v1.AuctionInfo does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AuctionInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "pob.abci.v1.AuctionInfo.extended_commit_info":
-		return protoreflect.ValueOfBytes(nil)
-	case "pob.abci.v1.AuctionInfo.max_tx_bytes":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "pob.abci.v1.AuctionInfo.num_txs":
-		return protoreflect.ValueOfUint64(uint64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pob.abci.v1.AuctionInfo"))
-		}
-		panic(fmt.Errorf("message pob.abci.v1.AuctionInfo does not contain field %s", fd.FullName()))
-	}
-}
-
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AuctionInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in pob.abci.v1.AuctionInfo", d.FullName()))
-	}
-	panic("unreachable")
-}
-
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AuctionInfo) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
-}
-
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuctionInfo) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
-}
-
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_AuctionInfo) IsValid() bool {
-	return x != nil
-}
-
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_AuctionInfo) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AuctionInfo)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.ExtendedCommitInfo)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.MaxTxBytes != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxTxBytes))
-		}
-		if x.NumTxs != 0 {
-			n += 1 + runtime.Sov(uint64(x.NumTxs))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AuctionInfo)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.NumTxs != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumTxs))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.MaxTxBytes != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTxBytes))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.ExtendedCommitInfo) > 0 {
-			i -= len(x.ExtendedCommitInfo)
-			copy(dAtA[i:], x.ExtendedCommitInfo)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtendedCommitInfo)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AuctionInfo)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionInfo: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExtendedCommitInfo = append(x.ExtendedCommitInfo[:0], dAtA[iNdEx:postIndex]...)
-				if x.ExtendedCommitInfo == nil {
-					x.ExtendedCommitInfo = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
-				}
-				x.MaxTxBytes = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxTxBytes |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
-				}
-				x.NumTxs = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.NumTxs |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
 var _ protoreflect.Map = (*_VoteExtensionInfo_2_map)(nil)
 
 type _VoteExtensionInfo_2_map struct {
@@ -633,7 +115,7 @@ func (x *VoteExtensionInfo) ProtoReflect() protoreflect.Message {
 }
 
 func (x *VoteExtensionInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_pob_abci_v1_auction_proto_msgTypes[1]
+	mi := &file_pob_abci_v1_auction_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,77 +688,22 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// AuctionInfo contains information about the top of block auction
-// that was run in PrepareProposal using vote extensions.
-type AuctionInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// extended_commit_info contains the vote extensions that were used to run the auction.
-	ExtendedCommitInfo []byte `protobuf:"bytes,1,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info,omitempty"`
-	// max_tx_bytes is the maximum number of bytes that were allowed for the proposal.
-	MaxTxBytes int64 `protobuf:"varint,2,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty"`
-	// num_txs is the number of transactions that were included in the proposal.
-	NumTxs uint64 `protobuf:"varint,3,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
-}
-
-func (x *AuctionInfo) Reset() {
-	*x = AuctionInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pob_abci_v1_auction_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AuctionInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AuctionInfo) ProtoMessage() {}
-
-// Deprecated: Use AuctionInfo.ProtoReflect.Descriptor instead.
-func (*AuctionInfo) Descriptor() ([]byte, []int) {
-	return file_pob_abci_v1_auction_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *AuctionInfo) GetExtendedCommitInfo() []byte {
-	if x != nil {
-		return x.ExtendedCommitInfo
-	}
-	return nil
-}
-
-func (x *AuctionInfo) GetMaxTxBytes() int64 {
-	if x != nil {
-		return x.MaxTxBytes
-	}
-	return 0
-}
-
-func (x *AuctionInfo) GetNumTxs() uint64 {
-	if x != nil {
-		return x.NumTxs
-	}
-	return 0
-}
-
-// VoteExtensionInfo wraps all vote extension data into a registry which allows applications to define
-// multiple different vote extensions that can be applied to state.
+// VoteExtensionInfo contains the top bids that a validator has seen in its
+// local top-of-block auction mempool.
 type VoteExtensionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// registry is the data of the vote extension.
+	// registry is a map of the hex-encoded hash of each bid transaction to the
+	// bid transaction.
 	Registry map[string][]byte `protobuf:"bytes,2,rep,name=registry,proto3" json:"registry,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *VoteExtensionInfo) Reset() {
 	*x = VoteExtensionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pob_abci_v1_auction_proto_msgTypes[1]
+		mi := &file_pob_abci_v1_auction_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1290,7 +717,7 @@ func (*VoteExtensionInfo) ProtoMessage() {}
 
 // Deprecated: Use VoteExtensionInfo.ProtoReflect.Descriptor instead.
 func (*VoteExtensionInfo) Descriptor() ([]byte, []int) {
-	return file_pob_abci_v1_auction_proto_rawDescGZIP(), []int{1}
+	return file_pob_abci_v1_auction_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *VoteExtensionInfo) GetRegistry() map[string][]byte {
@@ -1305,34 +732,27 @@ var File_pob_abci_v1_auction_proto protoreflect.FileDescriptor
 var file_pob_abci_v1_auction_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x70, 0x6f, 0x62, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x70, 0x6f, 0x62,
-	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x7a, 0x0a, 0x0b, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
-	0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e,
-	0x75, 0x6d, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x54, 0x78, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x48, 0x0a, 0x08, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70,
-	0x6f, 0x62, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x92, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x62, 0x2e, 0x61, 0x62,
-	0x63, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x62, 0x2f, 0x61, 0x62, 0x63, 0x69,
-	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58,
-	0xaa, 0x02, 0x0b, 0x50, 0x6f, 0x62, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x0b, 0x50, 0x6f, 0x62, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x50,
-	0x6f, 0x62, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x50, 0x6f, 0x62, 0x3a, 0x3a, 0x41, 0x62,
-	0x63, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x56, 0x6f, 0x74,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x48,
+	0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x70, 0x6f, 0x62, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x92, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f,
+	0x62, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x62, 0x2f,
+	0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x50, 0x6f, 0x62, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0b, 0x50, 0x6f, 0x62, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x17, 0x50, 0x6f, 0x62, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x50, 0x6f, 0x62,
+	0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1347,14 +767,13 @@ func file_pob_abci_v1_auction_proto_rawDescGZIP() []byte {
 	return file_pob_abci_v1_auction_proto_rawDescData
 }
 
-var file_pob_abci_v1_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pob_abci_v1_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_pob_abci_v1_auction_proto_goTypes = []interface{}{
-	(*AuctionInfo)(nil),       // 0: pob.abci.v1.AuctionInfo
-	(*VoteExtensionInfo)(nil), // 1: pob.abci.v1.VoteExtensionInfo
-	nil,                       // 2: pob.abci.v1.VoteExtensionInfo.RegistryEntry
+	(*VoteExtensionInfo)(nil), // 0: pob.abci.v1.VoteExtensionInfo
+	nil,                       // 1: pob.abci.v1.VoteExtensionInfo.RegistryEntry
 }
 var file_pob_abci_v1_auction_proto_depIdxs = []int32{
-	2, // 0: pob.abci.v1.VoteExtensionInfo.registry:type_name -> pob.abci.v1.VoteExtensionInfo.RegistryEntry
+	1, // 0: pob.abci.v1.VoteExtensionInfo.registry:type_name -> pob.abci.v1.VoteExtensionInfo.RegistryEntry
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -1369,18 +788,6 @@ func file_pob_abci_v1_auction_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_pob_abci_v1_auction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuctionInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pob_abci_v1_auction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VoteExtensionInfo); i {
 			case 0:
 				return &v.state
@@ -1399,7 +806,7 @@ func file_pob_abci_v1_auction_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pob_abci_v1_auction_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1411,4 +818,4 @@ func file_pob_abci_v1_auction_proto_init() {
 	file_pob_abci_v1_auction_proto_rawDesc = nil
 	file_pob_abci_v1_auction_proto_goTypes = nil
 	file_pob_abci_v1_auction_proto_depIdxs = nil
-}
\ No newline at end of file
+}
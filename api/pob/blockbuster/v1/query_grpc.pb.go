@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: pob/blockbuster/v1/query.proto
+
+package blockbusterv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Lanes_FullMethodName          = "/pob.blockbuster.v1.Query/Lanes"
+	Query_TxDistribution_FullMethodName = "/pob.blockbuster.v1.Query/TxDistribution"
+	Query_TxLane_FullMethodName         = "/pob.blockbuster.v1.Query/TxLane"
+	Query_MempoolTx_FullMethodName      = "/pob.blockbuster.v1.Query/MempoolTx"
+	Query_FeeEstimates_FullMethodName   = "/pob.blockbuster.v1.Query/FeeEstimates"
+	Query_SimulateBundle_FullMethodName = "/pob.blockbuster.v1.Query/SimulateBundle"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Lanes queries the configuration and current size of each lane in the
+	// mempool. Lanes are returned in the order they are registered.
+	Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error)
+	// TxDistribution queries the number of transactions in each lane.
+	TxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error)
+	// TxLane queries the lane that a transaction would be inserted into.
+	TxLane(ctx context.Context, in *QueryTxLaneRequest, opts ...grpc.CallOption) (*QueryTxLaneResponse, error)
+	// MempoolTx queries whether a transaction with the given hash is currently
+	// in the mempool and, if so, which lane it is stored in.
+	MempoolTx(ctx context.Context, in *QueryMempoolTxRequest, opts ...grpc.CallOption) (*QueryMempoolTxResponse, error)
+	// FeeEstimates queries the estimated fee required for a transaction to be
+	// included in each lane in the next block alongside fee percentiles over
+	// recent blocks.
+	FeeEstimates(ctx context.Context, in *QueryFeeEstimatesRequest, opts ...grpc.CallOption) (*QueryFeeEstimatesResponse, error)
+	// SimulateBundle simulates a bid transaction and its bundled transactions
+	// against the latest committed state without submitting the bid. It returns
+	// the outcome of each transaction and whether the bid would currently win
+	// the auction.
+	SimulateBundle(ctx context.Context, in *QuerySimulateBundleRequest, opts ...grpc.CallOption) (*QuerySimulateBundleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error) {
+	out := new(QueryLanesResponse)
+	err := c.cc.Invoke(ctx, Query_Lanes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error) {
+	out := new(QueryTxDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_TxDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxLane(ctx context.Context, in *QueryTxLaneRequest, opts ...grpc.CallOption) (*QueryTxLaneResponse, error) {
+	out := new(QueryTxLaneResponse)
+	err := c.cc.Invoke(ctx, Query_TxLane_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MempoolTx(ctx context.Context, in *QueryMempoolTxRequest, opts ...grpc.CallOption) (*QueryMempoolTxResponse, error) {
+	out := new(QueryMempoolTxResponse)
+	err := c.cc.Invoke(ctx, Query_MempoolTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeEstimates(ctx context.Context, in *QueryFeeEstimatesRequest, opts ...grpc.CallOption) (*QueryFeeEstimatesResponse, error) {
+	out := new(QueryFeeEstimatesResponse)
+	err := c.cc.Invoke(ctx, Query_FeeEstimates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateBundle(ctx context.Context, in *QuerySimulateBundleRequest, opts ...grpc.CallOption) (*QuerySimulateBundleResponse, error) {
+	out := new(QuerySimulateBundleResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Lanes queries the configuration and current size of each lane in the
+	// mempool. Lanes are returned in the order they are registered.
+	Lanes(context.Context, *QueryLanesRequest) (*QueryLanesResponse, error)
+	// TxDistribution queries the number of transactions in each lane.
+	TxDistribution(context.Context, *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error)
+	// TxLane queries the lane that a transaction would be inserted into.
+	TxLane(context.Context, *QueryTxLaneRequest) (*QueryTxLaneResponse, error)
+	// MempoolTx queries whether a transaction with the given hash is currently
+	// in the mempool and, if so, which lane it is stored in.
+	MempoolTx(context.Context, *QueryMempoolTxRequest) (*QueryMempoolTxResponse, error)
+	// FeeEstimates queries the estimated fee required for a transaction to be
+	// included in each lane in the next block alongside fee percentiles over
+	// recent blocks.
+	FeeEstimates(context.Context, *QueryFeeEstimatesRequest) (*QueryFeeEstimatesResponse, error)
+	// SimulateBundle simulates a bid transaction and its bundled transactions
+	// against the latest committed state without submitting the bid. It returns
+	// the outcome of each transaction and whether the bid would currently win
+	// the auction.
+	SimulateBundle(context.Context, *QuerySimulateBundleRequest) (*QuerySimulateBundleResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Lanes(context.Context, *QueryLanesRequest) (*QueryLanesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lanes not implemented")
+}
+func (UnimplementedQueryServer) TxDistribution(context.Context, *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxDistribution not implemented")
+}
+func (UnimplementedQueryServer) TxLane(context.Context, *QueryTxLaneRequest) (*QueryTxLaneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxLane not implemented")
+}
+func (UnimplementedQueryServer) MempoolTx(context.Context, *QueryMempoolTxRequest) (*QueryMempoolTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MempoolTx not implemented")
+}
+func (UnimplementedQueryServer) FeeEstimates(context.Context, *QueryFeeEstimatesRequest) (*QueryFeeEstimatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEstimates not implemented")
+}
+func (UnimplementedQueryServer) SimulateBundle(context.Context, *QuerySimulateBundleRequest) (*QuerySimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Lanes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLanesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lanes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Lanes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lanes(ctx, req.(*QueryLanesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TxDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxDistribution(ctx, req.(*QueryTxDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxLane_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxLaneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxLane(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TxLane_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxLane(ctx, req.(*QueryTxLaneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MempoolTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMempoolTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MempoolTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MempoolTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MempoolTx(ctx, req.(*QueryMempoolTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeEstimates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEstimatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEstimates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeEstimates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEstimates(ctx, req.(*QueryFeeEstimatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBundle(ctx, req.(*QuerySimulateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pob.blockbuster.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lanes",
+			Handler:    _Query_Lanes_Handler,
+		},
+		{
+			MethodName: "TxDistribution",
+			Handler:    _Query_TxDistribution_Handler,
+		},
+		{
+			MethodName: "TxLane",
+			Handler:    _Query_TxLane_Handler,
+		},
+		{
+			MethodName: "MempoolTx",
+			Handler:    _Query_MempoolTx_Handler,
+		},
+		{
+			MethodName: "FeeEstimates",
+			Handler:    _Query_FeeEstimates_Handler,
+		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Query_SimulateBundle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pob/blockbuster/v1/query.proto",
+}
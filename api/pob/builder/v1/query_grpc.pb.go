@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/pob.builder.v1.Query/Params"
+	Query_Params_FullMethodName           = "/pob.builder.v1.Query/Params"
+	Query_AuctionResult_FullMethodName    = "/pob.builder.v1.Query/AuctionResult"
+	Query_AuctionResults_FullMethodName   = "/pob.builder.v1.Query/AuctionResults"
+	Query_Searcher_FullMethodName         = "/pob.builder.v1.Query/Searcher"
+	Query_PendingRewards_FullMethodName   = "/pob.builder.v1.Query/PendingRewards"
+	Query_ValidatorRewards_FullMethodName = "/pob.builder.v1.Query/ValidatorRewards"
+	Query_CensorshipScore_FullMethodName  = "/pob.builder.v1.Query/CensorshipScore"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +34,21 @@ const (
 type QueryClient interface {
 	// Params queries the parameters of the x/builder module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AuctionResult queries the auction results at a given block height.
+	AuctionResult(ctx context.Context, in *QueryAuctionResultRequest, opts ...grpc.CallOption) (*QueryAuctionResultResponse, error)
+	// AuctionResults queries all of the auction results retained in state.
+	AuctionResults(ctx context.Context, in *QueryAuctionResultsRequest, opts ...grpc.CallOption) (*QueryAuctionResultsResponse, error)
+	// Searcher queries a registered searcher.
+	Searcher(ctx context.Context, in *QuerySearcherRequest, opts ...grpc.CallOption) (*QuerySearcherResponse, error)
+	// PendingRewards queries the auction proceeds that have been accrued for a
+	// recipient but not paid out yet.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// ValidatorRewards queries the proposer rewards accrued by a validator that
+	// have not been withdrawn yet.
+	ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error)
+	// CensorshipScore queries the number of blocks in which a validator was
+	// reported to have censored a bid.
+	CensorshipScore(ctx context.Context, in *QueryCensorshipScoreRequest, opts ...grpc.CallOption) (*QueryCensorshipScoreResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +68,81 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AuctionResult(ctx context.Context, in *QueryAuctionResultRequest, opts ...grpc.CallOption) (*QueryAuctionResultResponse, error) {
+	out := new(QueryAuctionResultResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionResults(ctx context.Context, in *QueryAuctionResultsRequest, opts ...grpc.CallOption) (*QueryAuctionResultsResponse, error) {
+	out := new(QueryAuctionResultsResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Searcher(ctx context.Context, in *QuerySearcherRequest, opts ...grpc.CallOption) (*QuerySearcherResponse, error) {
+	out := new(QuerySearcherResponse)
+	err := c.cc.Invoke(ctx, Query_Searcher_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error) {
+	out := new(QueryValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CensorshipScore(ctx context.Context, in *QueryCensorshipScoreRequest, opts ...grpc.CallOption) (*QueryCensorshipScoreResponse, error) {
+	out := new(QueryCensorshipScoreResponse)
+	err := c.cc.Invoke(ctx, Query_CensorshipScore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Params queries the parameters of the x/builder module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AuctionResult queries the auction results at a given block height.
+	AuctionResult(context.Context, *QueryAuctionResultRequest) (*QueryAuctionResultResponse, error)
+	// AuctionResults queries all of the auction results retained in state.
+	AuctionResults(context.Context, *QueryAuctionResultsRequest) (*QueryAuctionResultsResponse, error)
+	// Searcher queries a registered searcher.
+	Searcher(context.Context, *QuerySearcherRequest) (*QuerySearcherResponse, error)
+	// PendingRewards queries the auction proceeds that have been accrued for a
+	// recipient but not paid out yet.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// ValidatorRewards queries the proposer rewards accrued by a validator that
+	// have not been withdrawn yet.
+	ValidatorRewards(context.Context, *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error)
+	// CensorshipScore queries the number of blocks in which a validator was
+	// reported to have censored a bid.
+	CensorshipScore(context.Context, *QueryCensorshipScoreRequest) (*QueryCensorshipScoreResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +153,24 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) AuctionResult(context.Context, *QueryAuctionResultRequest) (*QueryAuctionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionResult not implemented")
+}
+func (UnimplementedQueryServer) AuctionResults(context.Context, *QueryAuctionResultsRequest) (*QueryAuctionResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionResults not implemented")
+}
+func (UnimplementedQueryServer) Searcher(context.Context, *QuerySearcherRequest) (*QuerySearcherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Searcher not implemented")
+}
+func (UnimplementedQueryServer) PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (UnimplementedQueryServer) ValidatorRewards(context.Context, *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewards not implemented")
+}
+func (UnimplementedQueryServer) CensorshipScore(context.Context, *QueryCensorshipScoreRequest) (*QueryCensorshipScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CensorshipScore not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +202,114 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AuctionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionResult(ctx, req.(*QueryAuctionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionResults(ctx, req.(*QueryAuctionResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Searcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Searcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Searcher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Searcher(ctx, req.(*QuerySearcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewards(ctx, req.(*QueryValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CensorshipScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCensorshipScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CensorshipScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CensorshipScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CensorshipScore(ctx, req.(*QueryCensorshipScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +321,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AuctionResult",
+			Handler:    _Query_AuctionResult_Handler,
+		},
+		{
+			MethodName: "AuctionResults",
+			Handler:    _Query_AuctionResults_Handler,
+		},
+		{
+			MethodName: "Searcher",
+			Handler:    _Query_Searcher_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorRewards",
+			Handler:    _Query_ValidatorRewards_Handler,
+		},
+		{
+			MethodName: "CensorshipScore",
+			Handler:    _Query_CensorshipScore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pob/builder/v1/query.proto",
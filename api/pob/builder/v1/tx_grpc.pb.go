@@ -19,8 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_AuctionBid_FullMethodName   = "/pob.builder.v1.Msg/AuctionBid"
-	Msg_UpdateParams_FullMethodName = "/pob.builder.v1.Msg/UpdateParams"
+	Msg_AuctionBid_FullMethodName                       = "/pob.builder.v1.Msg/AuctionBid"
+	Msg_CommitBid_FullMethodName                        = "/pob.builder.v1.Msg/CommitBid"
+	Msg_RevealBid_FullMethodName                        = "/pob.builder.v1.Msg/RevealBid"
+	Msg_RevealBidCommitment_FullMethodName              = "/pob.builder.v1.Msg/RevealBidCommitment"
+	Msg_SubmitBundle_FullMethodName                     = "/pob.builder.v1.Msg/SubmitBundle"
+	Msg_RegisterSearcher_FullMethodName                 = "/pob.builder.v1.Msg/RegisterSearcher"
+	Msg_UnbondSearcherCollateral_FullMethodName         = "/pob.builder.v1.Msg/UnbondSearcherCollateral"
+	Msg_WithdrawSearcherCollateral_FullMethodName       = "/pob.builder.v1.Msg/WithdrawSearcherCollateral"
+	Msg_WithdrawBuilderRewards_FullMethodName           = "/pob.builder.v1.Msg/WithdrawBuilderRewards"
+	Msg_SetBuilderRewardsWithdrawAddress_FullMethodName = "/pob.builder.v1.Msg/SetBuilderRewardsWithdrawAddress"
+	Msg_ReportCensoredBid_FullMethodName                = "/pob.builder.v1.Msg/ReportCensoredBid"
+	Msg_UpdateParams_FullMethodName                     = "/pob.builder.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +39,39 @@ const (
 type MsgClient interface {
 	// AuctionBid defines a method for sending bids to the x/builder module.
 	AuctionBid(ctx context.Context, in *MsgAuctionBid, opts ...grpc.CallOption) (*MsgAuctionBidResponse, error)
+	// CommitBid defines a method for committing to a sealed bid.
+	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method for revealing a sealed bid. Revealed bids
+	// participate in the auction in the same way as bids sent with AuctionBid.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	// RevealBidCommitment defines a method for revealing a sealed bid without
+	// bidding, e.g. once the revealed bid lost the auction. The collateral of a
+	// revealed commitment is returned to the bidder once the commitment expires.
+	RevealBidCommitment(ctx context.Context, in *MsgRevealBidCommitment, opts ...grpc.CallOption) (*MsgRevealBidCommitmentResponse, error)
+	// SubmitBundle defines a method for submitting an ordered bundle of
+	// transactions that is included atomically without a bid. Bundles are
+	// ranked by the combined fees of their transactions.
+	SubmitBundle(ctx context.Context, in *MsgSubmitBundle, opts ...grpc.CallOption) (*MsgSubmitBundleResponse, error)
+	// RegisterSearcher defines a method for registering a searcher and bonding
+	// collateral. Registered searchers can add collateral by registering again.
+	RegisterSearcher(ctx context.Context, in *MsgRegisterSearcher, opts ...grpc.CallOption) (*MsgRegisterSearcherResponse, error)
+	// UnbondSearcherCollateral defines a method for unbonding collateral of a
+	// registered searcher. The collateral can be withdrawn once the searcher
+	// unbonding period has passed.
+	UnbondSearcherCollateral(ctx context.Context, in *MsgUnbondSearcherCollateral, opts ...grpc.CallOption) (*MsgUnbondSearcherCollateralResponse, error)
+	// WithdrawSearcherCollateral defines a method for withdrawing the unbonded
+	// collateral of a registered searcher.
+	WithdrawSearcherCollateral(ctx context.Context, in *MsgWithdrawSearcherCollateral, opts ...grpc.CallOption) (*MsgWithdrawSearcherCollateralResponse, error)
+	// WithdrawBuilderRewards defines a method for withdrawing the proposer
+	// rewards accrued by a validator.
+	WithdrawBuilderRewards(ctx context.Context, in *MsgWithdrawBuilderRewards, opts ...grpc.CallOption) (*MsgWithdrawBuilderRewardsResponse, error)
+	// SetBuilderRewardsWithdrawAddress defines a method for setting the address
+	// that a validator withdraws its proposer rewards to.
+	SetBuilderRewardsWithdrawAddress(ctx context.Context, in *MsgSetBuilderRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetBuilderRewardsWithdrawAddressResponse, error)
+	// ReportCensoredBid defines a method for reporting that the proposer of a
+	// block omitted a bid of the reporter that was included in the vote
+	// extension of a validator.
+	ReportCensoredBid(ctx context.Context, in *MsgReportCensoredBid, opts ...grpc.CallOption) (*MsgReportCensoredBidResponse, error)
 	// UpdateParams defines a governance operation for updating the x/builder
 	// module parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -51,6 +94,96 @@ func (c *msgClient) AuctionBid(ctx context.Context, in *MsgAuctionBid, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error) {
+	out := new(MsgCommitBidResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBidCommitment(ctx context.Context, in *MsgRevealBidCommitment, opts ...grpc.CallOption) (*MsgRevealBidCommitmentResponse, error) {
+	out := new(MsgRevealBidCommitmentResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealBidCommitment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBundle(ctx context.Context, in *MsgSubmitBundle, opts ...grpc.CallOption) (*MsgSubmitBundleResponse, error) {
+	out := new(MsgSubmitBundleResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSearcher(ctx context.Context, in *MsgRegisterSearcher, opts ...grpc.CallOption) (*MsgRegisterSearcherResponse, error) {
+	out := new(MsgRegisterSearcherResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterSearcher_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondSearcherCollateral(ctx context.Context, in *MsgUnbondSearcherCollateral, opts ...grpc.CallOption) (*MsgUnbondSearcherCollateralResponse, error) {
+	out := new(MsgUnbondSearcherCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_UnbondSearcherCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSearcherCollateral(ctx context.Context, in *MsgWithdrawSearcherCollateral, opts ...grpc.CallOption) (*MsgWithdrawSearcherCollateralResponse, error) {
+	out := new(MsgWithdrawSearcherCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawSearcherCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawBuilderRewards(ctx context.Context, in *MsgWithdrawBuilderRewards, opts ...grpc.CallOption) (*MsgWithdrawBuilderRewardsResponse, error) {
+	out := new(MsgWithdrawBuilderRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawBuilderRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBuilderRewardsWithdrawAddress(ctx context.Context, in *MsgSetBuilderRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetBuilderRewardsWithdrawAddressResponse, error) {
+	out := new(MsgSetBuilderRewardsWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBuilderRewardsWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportCensoredBid(ctx context.Context, in *MsgReportCensoredBid, opts ...grpc.CallOption) (*MsgReportCensoredBidResponse, error) {
+	out := new(MsgReportCensoredBidResponse)
+	err := c.cc.Invoke(ctx, Msg_ReportCensoredBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -66,6 +199,39 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// AuctionBid defines a method for sending bids to the x/builder module.
 	AuctionBid(context.Context, *MsgAuctionBid) (*MsgAuctionBidResponse, error)
+	// CommitBid defines a method for committing to a sealed bid.
+	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method for revealing a sealed bid. Revealed bids
+	// participate in the auction in the same way as bids sent with AuctionBid.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	// RevealBidCommitment defines a method for revealing a sealed bid without
+	// bidding, e.g. once the revealed bid lost the auction. The collateral of a
+	// revealed commitment is returned to the bidder once the commitment expires.
+	RevealBidCommitment(context.Context, *MsgRevealBidCommitment) (*MsgRevealBidCommitmentResponse, error)
+	// SubmitBundle defines a method for submitting an ordered bundle of
+	// transactions that is included atomically without a bid. Bundles are
+	// ranked by the combined fees of their transactions.
+	SubmitBundle(context.Context, *MsgSubmitBundle) (*MsgSubmitBundleResponse, error)
+	// RegisterSearcher defines a method for registering a searcher and bonding
+	// collateral. Registered searchers can add collateral by registering again.
+	RegisterSearcher(context.Context, *MsgRegisterSearcher) (*MsgRegisterSearcherResponse, error)
+	// UnbondSearcherCollateral defines a method for unbonding collateral of a
+	// registered searcher. The collateral can be withdrawn once the searcher
+	// unbonding period has passed.
+	UnbondSearcherCollateral(context.Context, *MsgUnbondSearcherCollateral) (*MsgUnbondSearcherCollateralResponse, error)
+	// WithdrawSearcherCollateral defines a method for withdrawing the unbonded
+	// collateral of a registered searcher.
+	WithdrawSearcherCollateral(context.Context, *MsgWithdrawSearcherCollateral) (*MsgWithdrawSearcherCollateralResponse, error)
+	// WithdrawBuilderRewards defines a method for withdrawing the proposer
+	// rewards accrued by a validator.
+	WithdrawBuilderRewards(context.Context, *MsgWithdrawBuilderRewards) (*MsgWithdrawBuilderRewardsResponse, error)
+	// SetBuilderRewardsWithdrawAddress defines a method for setting the address
+	// that a validator withdraws its proposer rewards to.
+	SetBuilderRewardsWithdrawAddress(context.Context, *MsgSetBuilderRewardsWithdrawAddress) (*MsgSetBuilderRewardsWithdrawAddressResponse, error)
+	// ReportCensoredBid defines a method for reporting that the proposer of a
+	// block omitted a bid of the reporter that was included in the vote
+	// extension of a validator.
+	ReportCensoredBid(context.Context, *MsgReportCensoredBid) (*MsgReportCensoredBidResponse, error)
 	// UpdateParams defines a governance operation for updating the x/builder
 	// module parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -79,6 +245,36 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) AuctionBid(context.Context, *MsgAuctionBid) (*MsgAuctionBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuctionBid not implemented")
 }
+func (UnimplementedMsgServer) CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (UnimplementedMsgServer) RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (UnimplementedMsgServer) RevealBidCommitment(context.Context, *MsgRevealBidCommitment) (*MsgRevealBidCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBidCommitment not implemented")
+}
+func (UnimplementedMsgServer) SubmitBundle(context.Context, *MsgSubmitBundle) (*MsgSubmitBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBundle not implemented")
+}
+func (UnimplementedMsgServer) RegisterSearcher(context.Context, *MsgRegisterSearcher) (*MsgRegisterSearcherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSearcher not implemented")
+}
+func (UnimplementedMsgServer) UnbondSearcherCollateral(context.Context, *MsgUnbondSearcherCollateral) (*MsgUnbondSearcherCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondSearcherCollateral not implemented")
+}
+func (UnimplementedMsgServer) WithdrawSearcherCollateral(context.Context, *MsgWithdrawSearcherCollateral) (*MsgWithdrawSearcherCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSearcherCollateral not implemented")
+}
+func (UnimplementedMsgServer) WithdrawBuilderRewards(context.Context, *MsgWithdrawBuilderRewards) (*MsgWithdrawBuilderRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBuilderRewards not implemented")
+}
+func (UnimplementedMsgServer) SetBuilderRewardsWithdrawAddress(context.Context, *MsgSetBuilderRewardsWithdrawAddress) (*MsgSetBuilderRewardsWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBuilderRewardsWithdrawAddress not implemented")
+}
+func (UnimplementedMsgServer) ReportCensoredBid(context.Context, *MsgReportCensoredBid) (*MsgReportCensoredBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCensoredBid not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -113,6 +309,186 @@ func _Msg_AuctionBid_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBid(ctx, req.(*MsgCommitBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBidCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBidCommitment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBidCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealBidCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBidCommitment(ctx, req.(*MsgRevealBidCommitment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBundle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBundle(ctx, req.(*MsgSubmitBundle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSearcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSearcher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSearcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterSearcher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSearcher(ctx, req.(*MsgRegisterSearcher))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondSearcherCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondSearcherCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondSearcherCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnbondSearcherCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondSearcherCollateral(ctx, req.(*MsgUnbondSearcherCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSearcherCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSearcherCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSearcherCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawSearcherCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSearcherCollateral(ctx, req.(*MsgWithdrawSearcherCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawBuilderRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawBuilderRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawBuilderRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawBuilderRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawBuilderRewards(ctx, req.(*MsgWithdrawBuilderRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBuilderRewardsWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBuilderRewardsWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBuilderRewardsWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBuilderRewardsWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBuilderRewardsWithdrawAddress(ctx, req.(*MsgSetBuilderRewardsWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportCensoredBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportCensoredBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportCensoredBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReportCensoredBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportCensoredBid(ctx, req.(*MsgReportCensoredBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -142,6 +518,46 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuctionBid",
 			Handler:    _Msg_AuctionBid_Handler,
 		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _Msg_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
+		{
+			MethodName: "RevealBidCommitment",
+			Handler:    _Msg_RevealBidCommitment_Handler,
+		},
+		{
+			MethodName: "SubmitBundle",
+			Handler:    _Msg_SubmitBundle_Handler,
+		},
+		{
+			MethodName: "RegisterSearcher",
+			Handler:    _Msg_RegisterSearcher_Handler,
+		},
+		{
+			MethodName: "UnbondSearcherCollateral",
+			Handler:    _Msg_UnbondSearcherCollateral_Handler,
+		},
+		{
+			MethodName: "WithdrawSearcherCollateral",
+			Handler:    _Msg_WithdrawSearcherCollateral_Handler,
+		},
+		{
+			MethodName: "WithdrawBuilderRewards",
+			Handler:    _Msg_WithdrawBuilderRewards_Handler,
+		},
+		{
+			MethodName: "SetBuilderRewardsWithdrawAddress",
+			Handler:    _Msg_SetBuilderRewardsWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "ReportCensoredBid",
+			Handler:    _Msg_ReportCensoredBid_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
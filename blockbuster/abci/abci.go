@@ -4,10 +4,13 @@ import (
 	"fmt"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/pob/blockbuster"
 	"github.com/skip-mev/pob/blockbuster/lanes/terminator"
+	"github.com/skip-mev/pob/blockbuster/metrics"
 	"github.com/skip-mev/pob/blockbuster/utils"
 )
 
@@ -19,6 +22,17 @@ type (
 		txDecoder           sdk.TxDecoder
 		prepareLanesHandler blockbuster.PrepareLanesHandler
 		processLanesHandler blockbuster.ProcessLanesHandler
+
+		// lanes and allocationMode determine how block space is allocated across lanes
+		// and are utilized to verify the block space consumed by each lane in a proposal.
+		lanes          []blockbuster.Lane
+		allocationMode blockbuster.AllocationMode
+
+		// auctionLane and validateVoteExtensions are utilized to run the top-of-block auction
+		// over the bids included in the vote extensions of the previous block. If the auction
+		// lane is not set, the auction is run over the bids in the local mempool.
+		auctionLane            AuctionLane
+		validateVoteExtensions ValidateVoteExtensionsFn
 	}
 )
 
@@ -30,7 +44,30 @@ func NewProposalHandler(logger log.Logger, txDecoder sdk.TxDecoder, lanes []bloc
 		txDecoder:           txDecoder,
 		prepareLanesHandler: ChainPrepareLanes(lanes...),
 		processLanesHandler: ChainProcessLanes(lanes...),
+		lanes:               lanes,
+		allocationMode:      blockbuster.AllocationModeFixed,
+	}
+}
+
+// SetAllocationMode sets the mode used to allocate block space across lanes. Both
+// PrepareProposal and ProcessProposal respect the rollover allocation mode, so all validators
+// on the network must utilize the same allocation mode.
+func (h *ProposalHandler) SetAllocationMode(mode blockbuster.AllocationMode) {
+	h.allocationMode = mode
+}
+
+// SetVoteExtensionAuction enables the top-of-block auction over the bids included in vote
+// extensions. Once vote extensions are enabled, proposers inject an AuctionInfo as the first
+// transaction of each proposal and the winning bundles are determined by the bids included
+// in the vote extensions of the previous block rather than the proposer's local mempool.
+// The auction lane must be the first lane of the proposal handler, otherwise this panics.
+func (h *ProposalHandler) SetVoteExtensionAuction(lane AuctionLane, validateVoteExtensions ValidateVoteExtensionsFn) {
+	if len(h.lanes) == 0 || h.lanes[0].Name() != lane.Name() {
+		panic(fmt.Sprintf("auction lane %s must be the first lane of the proposal handler", lane.Name()))
 	}
+
+	h.auctionLane = lane
+	h.validateVoteExtensions = validateVoteExtensions
 }
 
 // PrepareProposalHandler prepares the proposal by selecting transactions from each lane
@@ -48,7 +85,29 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 			}
 		}()
 
-		proposal, err := h.prepareLanesHandler(ctx, blockbuster.NewProposal(req.MaxTxBytes))
+		var (
+			proposal            blockbuster.BlockProposal
+			prepareLanesHandler = h.prepareLanesHandler
+		)
+
+		// If vote extensions are enabled, the auction is run over the bids included in the vote
+		// extensions and the remaining lanes prepare the rest of the proposal.
+		if h.voteExtensionAuctionEnabled(ctx, req.Height) {
+			proposal, err = h.prepareVoteExtensionAuction(ctx, req)
+			if err != nil {
+				h.logger.Error("failed to prepare auction over vote extensions", "err", err)
+				return &abci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
+			}
+
+			prepareLanesHandler = ChainPrepareLanes(h.lanes[1:]...)
+		} else {
+			proposal = blockbuster.NewProposalWithLimits(req.MaxTxBytes, getMaxGasLimit(ctx), h.allocationMode)
+		}
+
+		if prepareLanesHandler != nil {
+			proposal, err = prepareLanesHandler(ctx, proposal)
+		}
+
 		if err != nil {
 			h.logger.Error("failed to prepare proposal", "err", err)
 			return &abci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
@@ -77,6 +136,7 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		defer func() {
 			if rec := recover(); rec != nil {
 				h.logger.Error("failed to process proposal", "recover_err", rec)
+				metrics.IncrProposalRejected("", metrics.ReasonPanic)
 
 				resp = &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
 				err = fmt.Errorf("failed to process proposal: %v", rec)
@@ -84,6 +144,28 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		}()
 
 		txs := req.Txs
+
+		// The proposer prepares the proposal with the maximum number of bytes given by CometBFT,
+		// which is not known when processing the proposal, so it is bounded by the maximum
+		// block size instead.
+		maxTxBytes := getMaxBlockBytes(ctx)
+
+		// If vote extensions are enabled, the first transaction is the auction info, which must
+		// match the auction run over the bids included in the vote extensions. Proposals without
+		// an auction info are rejected, even if they are empty. The auction info records the
+		// maximum number of bytes the proposal was prepared with.
+		if h.voteExtensionAuctionEnabled(ctx, req.Height) {
+			info, err := h.verifyAuctionInfo(ctx, req.Height, txs)
+			if err != nil {
+				h.logger.Error("failed to verify auction info", "err", err)
+				metrics.IncrProposalRejected(h.auctionLane.Name(), metrics.ReasonInvalidAuction)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
+			}
+
+			txs = txs[1:]
+			maxTxBytes = info.MaxTxBytes
+		}
+
 		if len(txs) == 0 {
 			h.logger.Info("accepted empty proposal")
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
@@ -93,11 +175,24 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		decodedTxs, err := utils.GetDecodedTxs(h.txDecoder, txs)
 		if err != nil {
 			h.logger.Error("failed to decode transactions", "err", err)
+			metrics.IncrProposalRejected("", metrics.ReasonDecodeFailure)
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
 		}
 
-		// Verify the proposal using the verification logic from each lane.
-		if _, err := h.processLanesHandler(ctx, decodedTxs); err != nil {
+		// Verify the proposal using the verification logic from each lane. If the block
+		// parameters are known, the size and gas consumed by each lane is verified as well. The
+		// block space allocated to each lane is only enforced by the rollover allocation mode.
+		processLanesHandler := h.processLanesHandler
+		if block := ctx.ConsensusParams().Block; block != nil {
+			proposal := blockbuster.NewProposalWithLimits(maxTxBytes, getMaxGasLimit(ctx), h.allocationMode)
+			if h.allocationMode == blockbuster.AllocationModeFixed {
+				proposal.SkipLaneTxBytesLimits()
+			}
+
+			processLanesHandler = ChainProcessLanesWithProposal(proposal, txs, h.lanes...)
+		}
+
+		if _, err := processLanesHandler(ctx, decodedTxs); err != nil {
 			h.logger.Error("failed to validate the proposal", "err", err)
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
 		}
@@ -163,17 +258,10 @@ func ChainPrepareLanes(chain ...blockbuster.Lane) blockbuster.PrepareLanesHandle
 			}
 		}()
 
-		// Get the maximum number of bytes that can be included in the proposal for this lane.
-		maxTxBytesForLane := utils.GetMaxTxBytesForLane(
-			partialProposal.GetMaxTxBytes(),
-			partialProposal.GetTotalTxBytes(),
-			lane.GetMaxBlockSpace(),
-		)
-
 		return lane.PrepareLane(
 			cacheCtx,
 			partialProposal,
-			maxTxBytesForLane,
+			getMaxTxBytesForLane(partialProposal, lane, chain[1:]),
 			ChainPrepareLanes(chain[1:]...),
 		)
 	}
@@ -183,6 +271,18 @@ func ChainPrepareLanes(chain ...blockbuster.Lane) blockbuster.PrepareLanesHandle
 // into a single function. The first lane in the chain is the first lane to be verified and
 // the last lane in the chain is the last lane to be verified.
 func ChainProcessLanes(chain ...blockbuster.Lane) blockbuster.ProcessLanesHandler {
+	return ChainProcessLanesWithProposal(nil, nil, chain...)
+}
+
+// ChainProcessLanesWithProposal chains together the proposal verification logic from each
+// lane like ChainProcessLanes. Additionally, the portion of the raw proposal transactions
+// (txs) that each lane verifies is added to the given proposal, which ensures that the lane
+// respects the block space it is allocated. If the proposal is nil, block space is not verified.
+func ChainProcessLanesWithProposal(
+	proposal blockbuster.BlockProposal,
+	txs [][]byte,
+	chain ...blockbuster.Lane,
+) blockbuster.ProcessLanesHandler {
 	if len(chain) == 0 {
 		return nil
 	}
@@ -202,9 +302,75 @@ func ChainProcessLanes(chain ...blockbuster.Lane) blockbuster.ProcessLanesHandle
 
 		if err := chain[0].CheckOrder(ctx, proposalTxs); err != nil {
 			chain[0].Logger().Error("failed to process lane", "lane", chain[0].Name(), "err", err)
+			metrics.IncrProposalRejected(chain[0].Name(), metrics.ReasonInvalidOrder)
 			return ctx, err
 		}
 
-		return chain[0].ProcessLane(ctx, proposalTxs, ChainProcessLanes(chain[1:]...))
+		next := ChainProcessLanesWithProposal(proposal, txs, chain[1:]...)
+		if proposal == nil {
+			return chain[0].ProcessLane(ctx, proposalTxs, next)
+		}
+
+		// The transactions that have yet to be verified are always a suffix of the proposal,
+		// so the portion of the proposal verified by the lane is everything in between.
+		start := len(txs) - len(proposalTxs)
+		lane := chain[0]
+
+		return lane.ProcessLane(ctx, proposalTxs, func(ctx sdk.Context, remainingTxs []sdk.Tx) (sdk.Context, error) {
+			if err := proposal.UpdateProposal(lane, txs[start:len(txs)-len(remainingTxs)]); err != nil {
+				lane.Logger().Error("failed to process lane", "lane", lane.Name(), "err", err)
+				metrics.IncrProposalRejected(lane.Name(), metrics.ReasonBlockSpace)
+				return ctx, err
+			}
+
+			return next(ctx, remainingTxs)
+		})
+	}
+}
+
+// getMaxTxBytesForLane returns the maximum number of bytes that the lane can include in the
+// proposal. The block space reserved for the subsequent lanes is not available to the lane.
+func getMaxTxBytesForLane(proposal blockbuster.BlockProposal, lane blockbuster.Lane, subsequentLanes []blockbuster.Lane) int64 {
+	maxTxBytesForLane := proposal.GetMaxTxBytesForLane(lane)
+	reservedTxBytes := getReservedTxBytes(proposal.GetMaxTxBytes(), subsequentLanes)
+	if remainder := proposal.GetMaxTxBytes() - proposal.GetTotalTxBytes() - reservedTxBytes; maxTxBytesForLane > remainder {
+		maxTxBytesForLane = remainder
+	}
+
+	if maxTxBytesForLane < 0 {
+		return 0
 	}
+
+	return maxTxBytesForLane
+}
+
+// getReservedTxBytes returns the number of bytes reserved by the given lanes based on their
+// min block space.
+func getReservedTxBytes(maxTxBytes int64, lanes []blockbuster.Lane) int64 {
+	reserved := math.LegacyZeroDec()
+	for _, lane := range lanes {
+		reserved = reserved.Add(lane.GetMinBlockSpace())
+	}
+
+	return reserved.MulInt64(maxTxBytes).TruncateInt().Int64()
+}
+
+// getMaxBlockBytes returns the maximum block size defined by the consensus parameters. A value
+// of -1 means that blocks are only limited by the maximum block size allowed by CometBFT.
+func getMaxBlockBytes(ctx sdk.Context) int64 {
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxBytes > 0 {
+		return block.MaxBytes
+	}
+
+	return cmttypes.MaxBlockSizeBytes
+}
+
+// getMaxGasLimit returns the block gas limit defined by the consensus parameters. A value of
+// zero means there is no limit.
+func getMaxGasLimit(ctx sdk.Context) uint64 {
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+		return uint64(block.MaxGas)
+	}
+
+	return 0
 }
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/pob/blockbuster"
@@ -19,9 +20,38 @@ import (
 	"github.com/skip-mev/pob/blockbuster/lanes/base"
 	"github.com/skip-mev/pob/blockbuster/lanes/free"
 	testutils "github.com/skip-mev/pob/testutils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
 	"github.com/stretchr/testify/suite"
 )
 
+// maxBundlesPerBlock is a bundle limiter that allows a fixed number of bundles per block.
+type maxBundlesPerBlock uint32
+
+func (m maxBundlesPerBlock) GetMaxBundlesPerBlock(_ sdk.Context) (uint32, error) {
+	return uint32(m), nil
+}
+
+// pricingRule is a pricing rule provider that uses a fixed pricing rule.
+type pricingRule buildertypes.PricingRule
+
+func (p pricingRule) GetPricingRule(_ sdk.Context) (buildertypes.PricingRule, error) {
+	return buildertypes.PricingRule(p), nil
+}
+
+// sealedBids is a sealed bids provider that reports whether sealed bids are enabled.
+type sealedBids bool
+
+func (e sealedBids) SealedBidsEnabled(_ sdk.Context) (bool, error) {
+	return bool(e), nil
+}
+
+// invalidBundle is a bundle validator that rejects all decrypted bundles.
+type invalidBundle struct{}
+
+func (invalidBundle) ValidateDecryptedBundle(_ sdk.Context, _ *buildertypes.BidInfo) error {
+	return fmt.Errorf("invalid bundle")
+}
+
 type ProposalsTestSuite struct {
 	suite.Suite
 	ctx sdk.Context
@@ -428,6 +458,143 @@ func (s *ProposalsTestSuite) TestPrepareProposal() {
 		s.Require().Equal(7, len(resp.Txs))
 		s.Require().Equal(proposal, resp.Txs)
 	})
+
+	s.Run("can build a proposal with multiple non-conflicting bundles", func() {
+		highBidTx, highBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+			0,
+			0,
+			s.accounts[0:1],
+		)
+		s.Require().NoError(err)
+
+		lowBidTx, lowBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+			0,
+			0,
+			s.accounts[1:2],
+		)
+		s.Require().NoError(err)
+
+		tobLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.5"), map[sdk.Tx]bool{
+			highBidTx:     true,
+			highBundle[0]: true,
+			lowBidTx:      true,
+			lowBundle[0]:  true,
+		})
+		tobLane.SetBundleLimiter(maxBundlesPerBlock(2))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, lowBidTx))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, highBidTx))
+
+		defaultLane := s.setUpDefaultLane(math.LegacyMustNewDecFromStr("0.5"), nil)
+
+		proposalHandler := s.setUpProposalHandlers([]blockbuster.Lane{tobLane, defaultLane}).PrepareProposalHandler()
+
+		resp, err := proposalHandler(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: 10000000000})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Require().Equal(s.getTxBytes(highBidTx, highBundle[0], lowBidTx, lowBundle[0]), resp.Txs)
+	})
+
+	s.Run("can build a proposal with at most max bundles per block", func() {
+		highBidTx, highBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+			0,
+			0,
+			s.accounts[0:1],
+		)
+		s.Require().NoError(err)
+
+		lowBidTx, lowBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+			0,
+			0,
+			s.accounts[1:2],
+		)
+		s.Require().NoError(err)
+
+		tobLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.5"), map[sdk.Tx]bool{
+			highBidTx:     true,
+			highBundle[0]: true,
+			lowBidTx:      true,
+			lowBundle[0]:  true,
+		})
+		tobLane.SetBundleLimiter(maxBundlesPerBlock(1))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, lowBidTx))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, highBidTx))
+
+		defaultLane := s.setUpDefaultLane(math.LegacyMustNewDecFromStr("0.5"), nil)
+
+		proposalHandler := s.setUpProposalHandlers([]blockbuster.Lane{tobLane, defaultLane}).PrepareProposalHandler()
+
+		resp, err := proposalHandler(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: 10000000000})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Require().Equal(s.getTxBytes(highBidTx, highBundle[0]), resp.Txs)
+	})
+
+	s.Run("can build a proposal that skips conflicting bundles", func() {
+		highBidTx, highBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(3000000)),
+			0,
+			0,
+			s.accounts[2:3],
+		)
+		s.Require().NoError(err)
+
+		// This bundle shares a signer with the highest bidding bundle.
+		conflictingBidTx, conflictingBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+			0,
+			0,
+			s.accounts[2:3],
+		)
+		s.Require().NoError(err)
+
+		lowBidTx, lowBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[3],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+			0,
+			0,
+			s.accounts[3:4],
+		)
+		s.Require().NoError(err)
+
+		tobLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.5"), map[sdk.Tx]bool{
+			highBidTx:            true,
+			highBundle[0]:        true,
+			conflictingBidTx:     true,
+			conflictingBundle[0]: true,
+			lowBidTx:             true,
+			lowBundle[0]:         true,
+		})
+		tobLane.SetBundleLimiter(maxBundlesPerBlock(3))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, highBidTx))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, conflictingBidTx))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, lowBidTx))
+
+		defaultLane := s.setUpDefaultLane(math.LegacyMustNewDecFromStr("0.5"), nil)
+
+		proposalHandler := s.setUpProposalHandlers([]blockbuster.Lane{tobLane, defaultLane}).PrepareProposalHandler()
+
+		resp, err := proposalHandler(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: 10000000000})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Require().Equal(s.getTxBytes(highBidTx, highBundle[0], lowBidTx, lowBundle[0]), resp.Txs)
+	})
 }
 
 func (s *ProposalsTestSuite) TestPrepareProposalEdgeCases() {
@@ -688,6 +855,827 @@ func (s *ProposalsTestSuite) TestProcessProposal() {
 		s.Require().NotNil(resp)
 		s.Require().Error(err)
 	})
+
+	s.Run("can process a proposal with multiple bundles", func() {
+		highBidTx, highBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+			0,
+			1,
+			s.accounts[0:1],
+		)
+		s.Require().NoError(err)
+
+		lowBidTx, lowBundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+			0,
+			1,
+			s.accounts[1:2],
+		)
+		s.Require().NoError(err)
+
+		expectedExecution := map[sdk.Tx]bool{
+			highBidTx:     true,
+			highBundle[0]: true,
+			lowBidTx:      true,
+			lowBundle[0]:  true,
+		}
+
+		cases := []struct {
+			name       string
+			maxBundles uint32
+			txs        []sdk.Tx
+			pass       bool
+		}{
+			{
+				"valid proposal with two bundles",
+				2,
+				[]sdk.Tx{highBidTx, highBundle[0], lowBidTx, lowBundle[0]},
+				true,
+			},
+			{
+				"too many bundles",
+				1,
+				[]sdk.Tx{highBidTx, highBundle[0], lowBidTx, lowBundle[0]},
+				false,
+			},
+			{
+				"bundles not ordered by bid",
+				2,
+				[]sdk.Tx{lowBidTx, lowBundle[0], highBidTx, highBundle[0]},
+				false,
+			},
+			{
+				"bundles interleaved",
+				2,
+				[]sdk.Tx{highBidTx, lowBidTx, highBundle[0], lowBundle[0]},
+				false,
+			},
+		}
+
+		for _, tc := range cases {
+			s.Run(tc.name, func() {
+				tobLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.5"), expectedExecution)
+				tobLane.SetBundleLimiter(maxBundlesPerBlock(tc.maxBundles))
+				defaultLane := s.setUpDefaultLane(math.LegacyMustNewDecFromStr("0.5"), expectedExecution)
+
+				proposalHandler := s.setUpProposalHandlers([]blockbuster.Lane{tobLane, defaultLane}).ProcessProposalHandler()
+				resp, err := proposalHandler(s.ctx, &cometabci.RequestProcessProposal{Txs: s.getTxBytes(tc.txs...)})
+				s.Require().NotNil(resp)
+
+				if tc.pass {
+					s.Require().NoError(err)
+					s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+				} else {
+					s.Require().Error(err)
+					s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+				}
+			})
+		}
+	})
+}
+
+func (s *ProposalsTestSuite) TestBlockSpaceAllocation() {
+	// Create two transactions of equal size that will be inserted into the default lane.
+	tx1, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		1,
+		0,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+	)
+	s.Require().NoError(err)
+
+	tx2, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		1,
+		0,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	txBz := s.getTxBytes(tx1, tx2)
+	s.Require().Equal(len(txBz[0]), len(txBz[1]))
+
+	// The free lane and the default lane are each allocated half of the block, which is
+	// only large enough for a single transaction.
+	maxTxBytes := int64(len(txBz[0]) + len(txBz[1]))
+	expectedExecution := map[sdk.Tx]bool{tx1: true, tx2: true}
+
+	setUpProposalHandler := func(mode blockbuster.AllocationMode) *abci.ProposalHandler {
+		freeLane := s.setUpFreeLane(math.LegacyMustNewDecFromStr("0.5"), expectedExecution)
+		defaultLane := s.setUpDefaultLane(math.LegacyMustNewDecFromStr("0.5"), expectedExecution)
+		s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx1))
+		s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx2))
+
+		proposalHandler := s.setUpProposalHandlers([]blockbuster.Lane{freeLane, defaultLane})
+		proposalHandler.SetAllocationMode(mode)
+
+		return proposalHandler
+	}
+
+	processCtx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: maxTxBytes},
+	})
+
+	s.Run("fixed allocation does not utilize unused block space", func() {
+		proposalHandler := setUpProposalHandler(blockbuster.AllocationModeFixed)
+
+		resp, err := proposalHandler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(txBz[:1], resp.Txs)
+	})
+
+	s.Run("rollover allocation utilizes unused block space of preceding lanes", func() {
+		proposalHandler := setUpProposalHandler(blockbuster.AllocationModeRollover)
+
+		resp, err := proposalHandler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(txBz, resp.Txs)
+	})
+
+	s.Run("fixed allocation does not enforce the block space of a lane", func() {
+		proposalHandler := setUpProposalHandler(blockbuster.AllocationModeFixed)
+
+		resp, err := proposalHandler.ProcessProposalHandler()(processCtx, &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
+
+	s.Run("fixed allocation rejects a proposal that exceeds the block size", func() {
+		proposalHandler := setUpProposalHandler(blockbuster.AllocationModeFixed)
+
+		ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: maxTxBytes - 1},
+		})
+
+		resp, err := proposalHandler.ProcessProposalHandler()(ctx, &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+
+	s.Run("rollover allocation accepts a lane that utilizes rolled over block space", func() {
+		proposalHandler := setUpProposalHandler(blockbuster.AllocationModeRollover)
+
+		resp, err := proposalHandler.ProcessProposalHandler()(processCtx, &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
+
+	s.Run("rollover allocation rejects a proposal that exceeds the block size", func() {
+		proposalHandler := setUpProposalHandler(blockbuster.AllocationModeRollover)
+
+		ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: maxTxBytes - 1},
+		})
+
+		resp, err := proposalHandler.ProcessProposalHandler()(ctx, &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+}
+
+func (s *ProposalsTestSuite) TestMinBlockSpace() {
+	// Create two free transactions that saturate the free lane and a transaction
+	// for the default lane.
+	freeTx1, err := testutils.CreateFreeTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		0,
+		"val1",
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(100)),
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+	)
+	s.Require().NoError(err)
+
+	freeTx2, err := testutils.CreateFreeTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		0,
+		"val1",
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(100)),
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	tx, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[2],
+		0,
+		1,
+		0,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	freeTxBz := s.getTxBytes(freeTx1, freeTx2)
+	txBz := s.getTxBytes(tx)
+	s.Require().Equal(len(freeTxBz[0]), len(freeTxBz[1]))
+	s.Require().Less(len(freeTxBz[0]), len(txBz[0]))
+
+	// The block is large enough for both of the free transactions but not for both of the
+	// free transactions and the default lane transaction.
+	maxTxBytes := int64(2 * len(txBz[0]))
+	expectedExecution := map[sdk.Tx]bool{freeTx1: true, freeTx2: true, tx: true}
+
+	setUpProposalHandler := func(minBlockSpace math.LegacyDec) *abci.ProposalHandler {
+		freeLane := s.setUpFreeLane(math.LegacyZeroDec(), expectedExecution)
+		s.Require().NoError(freeLane.Insert(sdk.Context{}, freeTx1))
+		s.Require().NoError(freeLane.Insert(sdk.Context{}, freeTx2))
+
+		defaultLane := base.NewDefaultLane(blockbuster.LaneConfig{
+			Logger:        log.NewTestLogger(s.T()),
+			TxEncoder:     s.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     s.encodingConfig.TxConfig.TxDecoder(),
+			AnteHandler:   s.setUpAnteHandler(expectedExecution),
+			MaxBlockSpace: math.LegacyZeroDec(),
+			MinBlockSpace: minBlockSpace,
+		})
+		s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx))
+
+		return s.setUpProposalHandlers([]blockbuster.Lane{freeLane, defaultLane})
+	}
+
+	s.Run("preceding lane can starve a lane without reserved block space", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyZeroDec()).PrepareProposalHandler()
+
+		resp, err := proposalHandler(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(freeTxBz, resp.Txs)
+	})
+
+	s.Run("preceding lane cannot consume reserved block space", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyMustNewDecFromStr("0.5")).PrepareProposalHandler()
+
+		resp, err := proposalHandler(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(append(freeTxBz[:1], txBz...), resp.Txs)
+	})
+}
+
+func (s *ProposalsTestSuite) TestGasLimits() {
+	// Create two transactions that each have a gas limit of 100.
+	txs := make([]sdk.Tx, 2)
+	for i := range txs {
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[i],
+			0,
+			1,
+			0,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(int64(2000000-i))),
+		)
+		s.Require().NoError(err)
+
+		txBuilder, err := s.encodingConfig.TxConfig.WrapTxBuilder(tx)
+		s.Require().NoError(err)
+		txBuilder.SetGasLimit(100)
+
+		txs[i] = txBuilder.GetTx()
+	}
+
+	txBz := s.getTxBytes(txs...)
+	maxTxBytes := int64(len(txBz[0]) + len(txBz[1]))
+	expectedExecution := map[sdk.Tx]bool{txs[0]: true, txs[1]: true}
+
+	setUpProposalHandler := func(maxBlockGas math.LegacyDec) *abci.ProposalHandler {
+		defaultLane := base.NewDefaultLane(blockbuster.LaneConfig{
+			Logger:        log.NewTestLogger(s.T()),
+			TxEncoder:     s.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     s.encodingConfig.TxConfig.TxDecoder(),
+			AnteHandler:   s.setUpAnteHandler(expectedExecution),
+			MaxBlockSpace: math.LegacyZeroDec(),
+			MaxBlockGas:   maxBlockGas,
+		})
+
+		for _, tx := range txs {
+			s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx))
+		}
+
+		return s.setUpProposalHandlers([]blockbuster.Lane{defaultLane})
+	}
+
+	ctxWithMaxGas := func(maxGas int64) sdk.Context {
+		return s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: maxTxBytes, MaxGas: maxGas},
+		})
+	}
+
+	s.Run("all transactions are included without a block gas limit", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyZeroDec()).PrepareProposalHandler()
+
+		resp, err := proposalHandler(ctxWithMaxGas(-1), &cometabci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(txBz, resp.Txs)
+	})
+
+	s.Run("transactions that exceed the block gas limit are not included", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyZeroDec()).PrepareProposalHandler()
+
+		resp, err := proposalHandler(ctxWithMaxGas(150), &cometabci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(txBz[:1], resp.Txs)
+	})
+
+	s.Run("transactions that exceed the lane gas limit are not included", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyMustNewDecFromStr("0.5")).PrepareProposalHandler()
+
+		resp, err := proposalHandler(ctxWithMaxGas(300), &cometabci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(txBz[:1], resp.Txs)
+	})
+
+	s.Run("proposal within the gas limits is accepted", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyZeroDec()).ProcessProposalHandler()
+
+		resp, err := proposalHandler(ctxWithMaxGas(200), &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
+
+	s.Run("proposal that exceeds the block gas limit is rejected", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyZeroDec()).ProcessProposalHandler()
+
+		resp, err := proposalHandler(ctxWithMaxGas(150), &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+
+	s.Run("proposal that exceeds the lane gas limit is rejected", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyMustNewDecFromStr("0.5")).ProcessProposalHandler()
+
+		resp, err := proposalHandler(ctxWithMaxGas(300), &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+
+	s.Run("proposal that exceeds the block gas limit is rejected without a maximum block size", func() {
+		proposalHandler := setUpProposalHandler(math.LegacyZeroDec()).ProcessProposalHandler()
+
+		ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: -1, MaxGas: 150},
+		})
+
+		resp, err := proposalHandler(ctx, &cometabci.RequestProcessProposal{Txs: txBz})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+}
+
+func (s *ProposalsTestSuite) TestSecondPriceAuction() {
+	highBidTx, highBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		0,
+		0,
+		s.accounts[0:1],
+	)
+	s.Require().NoError(err)
+
+	lowBidTx, lowBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[1:2],
+	)
+	s.Require().NoError(err)
+
+	expectedExecution := map[sdk.Tx]bool{
+		highBidTx:     true,
+		highBundle[0]: true,
+		lowBidTx:      true,
+		lowBundle[0]:  true,
+	}
+
+	// The second price pricing rule requires the auction to be run over the bids of the vote
+	// extensions, which are enabled at height 1.
+	ctx := s.ctx.WithBlockHeight(2).WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+	})
+
+	setUpProposalHandler := func(rule buildertypes.PricingRule) *abci.ProposalHandler {
+		tobLane := s.setUpTOBLane(math.LegacyZeroDec(), expectedExecution)
+		tobLane.SetPricingRuleProvider(pricingRule(rule))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, lowBidTx))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, highBidTx))
+
+		defaultLane := s.setUpDefaultLane(math.LegacyZeroDec(), nil)
+		mempool := blockbuster.NewMempool(log.NewTestLogger(s.T()), true, tobLane, defaultLane)
+
+		proposalHandler := abci.NewProposalHandler(log.NewTestLogger(s.T()), tobLane.TxDecoder(), mempool.Registry())
+		proposalHandler.SetVoteExtensionAuction(
+			tobLane,
+			func(_ sdk.Context, _ int64, _ cometabci.ExtendedCommitInfo) error { return nil },
+		)
+
+		return proposalHandler
+	}
+
+	prepare := func(ctx sdk.Context, rule buildertypes.PricingRule, bidTxs ...sdk.Tx) [][]byte {
+		proposalHandler := setUpProposalHandler(rule).PrepareProposalHandler()
+
+		resp, err := proposalHandler(ctx, &cometabci.RequestPrepareProposal{
+			Height:     ctx.BlockHeight(),
+			MaxTxBytes: 10000000000,
+			LocalLastCommit: cometabci.ExtendedCommitInfo{
+				Votes: []cometabci.ExtendedVoteInfo{{VoteExtension: s.getVoteExtension(bidTxs...)}},
+			},
+		})
+		s.Require().NoError(err)
+
+		return resp.Txs
+	}
+
+	encodeSettlement := func(settlement buildertypes.AuctionSettlement) []byte {
+		settlementBz, err := buildertypes.EncodeAuctionSettlement(settlement)
+		s.Require().NoError(err)
+
+		return settlementBz
+	}
+
+	lowBidTxHash := sha256.Sum256(s.getTxBytes(lowBidTx)[0])
+	settlementBz := encodeSettlement(buildertypes.AuctionSettlement{
+		RunnerUpBid:       sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		RunnerUpBidTxHash: lowBidTxHash[:],
+	})
+	winningBundle := s.getTxBytes(highBidTx, highBundle[0])
+
+	s.Run("runner-up bid is not included with the first price pricing rule", func() {
+		txs := prepare(ctx, buildertypes.PricingRule_PRICING_RULE_FIRST_PRICE, lowBidTx, highBidTx)
+		s.Require().Equal(winningBundle, txs[1:])
+	})
+
+	s.Run("runner-up bid of the vote extensions is included after the winning bundle with the second price pricing rule", func() {
+		txs := prepare(ctx, buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE, lowBidTx, highBidTx)
+		s.Require().Equal(append(winningBundle, settlementBz), txs[1:])
+	})
+
+	s.Run("auction settlement without a runner-up bid is included if there is no runner-up bid", func() {
+		txs := prepare(ctx, buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE, highBidTx)
+		s.Require().Equal(append(winningBundle, encodeSettlement(buildertypes.AuctionSettlement{})), txs[1:])
+	})
+
+	s.Run("auction is not run over the local mempool with the second price pricing rule", func() {
+		txs := prepare(s.ctx, buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE)
+		s.Require().Empty(txs)
+	})
+
+	txs := prepare(ctx, buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE, lowBidTx, highBidTx)
+
+	// withNumTxs returns the auction info of the prepared proposal with the given number of txs.
+	withNumTxs := func(numTxs uint64) []byte {
+		info, err := buildertypes.DecodeAuctionInfo(txs[0])
+		s.Require().NoError(err)
+
+		info.NumTxs = numTxs
+		bz, err := buildertypes.EncodeAuctionInfo(info)
+		s.Require().NoError(err)
+
+		return bz
+	}
+
+	testCases := []struct {
+		name     string
+		ctx      sdk.Context
+		rule     buildertypes.PricingRule
+		txs      [][]byte
+		expected cometabci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			"valid auction settlement",
+			ctx,
+			buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE,
+			txs,
+			cometabci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			"auction settlement with the first price pricing rule",
+			ctx,
+			buildertypes.PricingRule_PRICING_RULE_FIRST_PRICE,
+			txs,
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"winning bundle without an auction settlement",
+			ctx,
+			buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE,
+			append([][]byte{withNumTxs(2)}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction settlement with a lower runner-up bid",
+			ctx,
+			buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE,
+			append(append([][]byte{txs[0]}, winningBundle...), encodeSettlement(buildertypes.AuctionSettlement{
+				RunnerUpBid:       sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+				RunnerUpBidTxHash: lowBidTxHash[:],
+			})),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction settlement without the runner-up bid",
+			ctx,
+			buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE,
+			append(append([][]byte{txs[0]}, winningBundle...), encodeSettlement(buildertypes.AuctionSettlement{})),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction settlement before the winning bundle",
+			ctx,
+			buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE,
+			append([][]byte{txs[0], settlementBz}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"winning bundle without the vote extension auction",
+			s.ctx,
+			buildertypes.PricingRule_PRICING_RULE_SECOND_PRICE,
+			append(winningBundle, settlementBz),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			proposalHandler := setUpProposalHandler(tc.rule).ProcessProposalHandler()
+
+			resp, err := proposalHandler(tc.ctx, &cometabci.RequestProcessProposal{Height: tc.ctx.BlockHeight(), Txs: tc.txs})
+			if tc.expected == cometabci.ResponseProcessProposal_ACCEPT {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+			s.Require().Equal(tc.expected, resp.Status)
+		})
+	}
+}
+
+func (s *ProposalsTestSuite) TestFailedBidsOfVoteExtensionAuction() {
+	validBidTx, validBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[0:1],
+	)
+	s.Require().NoError(err)
+
+	invalidBidTx, invalidBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		0,
+		0,
+		s.accounts[1:2],
+	)
+	s.Require().NoError(err)
+
+	// The bundle of the highest bid fails verification.
+	expectedExecution := map[sdk.Tx]bool{
+		validBidTx:       true,
+		validBundle[0]:   true,
+		invalidBidTx:     true,
+		invalidBundle[0]: false,
+	}
+
+	ctx := s.ctx.WithBlockHeight(2).WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+	})
+
+	setUpProposalHandler := func() *abci.ProposalHandler {
+		tobLane := s.setUpTOBLane(math.LegacyZeroDec(), expectedExecution)
+		defaultLane := s.setUpDefaultLane(math.LegacyZeroDec(), nil)
+		mempool := blockbuster.NewMempool(log.NewTestLogger(s.T()), true, tobLane, defaultLane)
+
+		proposalHandler := abci.NewProposalHandler(log.NewTestLogger(s.T()), tobLane.TxDecoder(), mempool.Registry())
+		proposalHandler.SetVoteExtensionAuction(
+			tobLane,
+			func(_ sdk.Context, _ int64, _ cometabci.ExtendedCommitInfo) error { return nil },
+		)
+
+		return proposalHandler
+	}
+
+	resp, err := setUpProposalHandler().PrepareProposalHandler()(ctx, &cometabci.RequestPrepareProposal{
+		Height:     ctx.BlockHeight(),
+		MaxTxBytes: 10000000000,
+		LocalLastCommit: cometabci.ExtendedCommitInfo{
+			Votes: []cometabci.ExtendedVoteInfo{{VoteExtension: s.getVoteExtension(validBidTx, invalidBidTx)}},
+		},
+	})
+	s.Require().NoError(err)
+
+	info, err := buildertypes.DecodeAuctionInfo(resp.Txs[0])
+	s.Require().NoError(err)
+
+	invalidBidTxHash := sha256.Sum256(s.getTxBytes(invalidBidTx)[0])
+	s.Require().Equal([][]byte{invalidBidTxHash[:]}, info.FailedBidTxHashes)
+	s.Require().Equal(s.getTxBytes(validBidTx, validBundle[0]), resp.Txs[1:])
+
+	process := func(info buildertypes.AuctionInfo) cometabci.ResponseProcessProposal_ProposalStatus {
+		infoBz, err := buildertypes.EncodeAuctionInfo(info)
+		s.Require().NoError(err)
+
+		txs := append([][]byte{infoBz}, resp.Txs[1:]...)
+		processResp, _ := setUpProposalHandler().ProcessProposalHandler()(ctx, &cometabci.RequestProcessProposal{
+			Height: ctx.BlockHeight(),
+			Txs:    txs,
+		})
+
+		return processResp.Status
+	}
+
+	s.Run("proposal that records the failed bids is accepted", func() {
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, process(info))
+	})
+
+	s.Run("proposal that omits a failed bid is rejected", func() {
+		omitted := info
+		omitted.FailedBidTxHashes = nil
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, process(omitted))
+	})
+
+	s.Run("proposal that records a valid bid as failed is rejected", func() {
+		validBidTxHash := sha256.Sum256(s.getTxBytes(validBidTx)[0])
+
+		framed := info
+		framed.FailedBidTxHashes = [][]byte{validBidTxHash[:], invalidBidTxHash[:]}
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, process(framed))
+	})
+}
+
+func (s *ProposalsTestSuite) TestSealedBidsRequireVoteExtensionAuction() {
+	bidTx, bundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[0:1],
+	)
+	s.Require().NoError(err)
+
+	setUpProposalHandler := func(enabled bool) *abci.ProposalHandler {
+		tobLane := s.setUpTOBLane(math.LegacyZeroDec(), map[sdk.Tx]bool{bidTx: true, bundle[0]: true})
+		tobLane.SetSealedBidsProvider(sealedBids(enabled))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, bidTx))
+
+		defaultLane := s.setUpDefaultLane(math.LegacyZeroDec(), nil)
+		mempool := blockbuster.NewMempool(log.NewTestLogger(s.T()), true, tobLane, defaultLane)
+
+		return abci.NewProposalHandler(log.NewTestLogger(s.T()), tobLane.TxDecoder(), mempool.Registry())
+	}
+
+	winningBundle := s.getTxBytes(bidTx, bundle[0])
+
+	s.Run("auction is run over the local mempool without sealed bids", func() {
+		resp, err := setUpProposalHandler(false).PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: 10000000000})
+		s.Require().NoError(err)
+		s.Require().Equal(winningBundle, resp.Txs)
+	})
+
+	s.Run("auction is not run over the local mempool with sealed bids", func() {
+		resp, err := setUpProposalHandler(true).PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: 10000000000})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Txs)
+	})
+
+	s.Run("bids are rejected without the vote extension auction with sealed bids", func() {
+		resp, err := setUpProposalHandler(true).ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: winningBundle})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+}
+
+func (s *ProposalsTestSuite) TestEncryptedBundles() {
+	shares, err := auction.NewKeyShares([]byte("seed"), 2, 3)
+	s.Require().NoError(err)
+
+	decrypter, err := auction.NewKeyShareDecrypter(shares[1:], 2)
+	s.Require().NoError(err)
+
+	bundledTx, err := testutils.CreateRandomTx(s.encodingConfig.TxConfig, s.accounts[1], 0, 1, 0)
+	s.Require().NoError(err)
+
+	bundledTxBz := s.getTxBytes(bundledTx)[0]
+	encryptedTxBz, err := decrypter.Encrypt(bundledTxBz, 0)
+	s.Require().NoError(err)
+
+	bidTx, err := testutils.CreateTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		0,
+		[]sdk.Msg{
+			buildertypes.NewMsgAuctionBid(
+				s.accounts[0].Address,
+				sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+				[][]byte{encryptedTxBz},
+			),
+		},
+	)
+	s.Require().NoError(err)
+
+	expectedExecution := map[sdk.Tx]bool{
+		bidTx:     true,
+		bundledTx: true,
+	}
+
+	setUpProposalHandler := func(bundleValidator auction.BundleValidator) *abci.ProposalHandler {
+		cfg := blockbuster.LaneConfig{
+			Logger:        log.NewTestLogger(s.T()),
+			TxEncoder:     s.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     s.encodingConfig.TxConfig.TxDecoder(),
+			AnteHandler:   s.setUpAnteHandler(expectedExecution),
+			MaxBlockSpace: math.LegacyZeroDec(),
+		}
+
+		tobLane := auction.NewTOBLane(
+			cfg,
+			auction.NewDefaultAuctionFactoryWithDecrypter(cfg.TxDecoder, auction.DefaultAccessSetHook, decrypter),
+		)
+		tobLane.SetBundleValidator(bundleValidator)
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, bidTx))
+
+		return s.setUpProposalHandlers([]blockbuster.Lane{tobLane, s.setUpDefaultLane(math.LegacyZeroDec(), nil)})
+	}
+
+	s.Run("encrypted bundle is included as its decrypted transactions", func() {
+		proposalHandler := setUpProposalHandler(nil).PrepareProposalHandler()
+
+		resp, err := proposalHandler(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: 10000000000})
+		s.Require().NoError(err)
+		s.Require().Equal([][]byte{s.getTxBytes(bidTx)[0], bundledTxBz}, resp.Txs)
+	})
+
+	s.Run("encrypted bundle is not included if the decrypted bundle is invalid", func() {
+		proposalHandler := setUpProposalHandler(invalidBundle{}).PrepareProposalHandler()
+
+		resp, err := proposalHandler(s.ctx, &cometabci.RequestPrepareProposal{MaxTxBytes: 10000000000})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Txs)
+	})
+
+	otherTx, err := testutils.CreateRandomTx(s.encodingConfig.TxConfig, s.accounts[2], 0, 1, 0)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name            string
+		bundleValidator auction.BundleValidator
+		txs             [][]byte
+		expected        cometabci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			"decrypted bundle",
+			nil,
+			[][]byte{s.getTxBytes(bidTx)[0], bundledTxBz},
+			cometabci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			"encrypted bundle",
+			nil,
+			[][]byte{s.getTxBytes(bidTx)[0], encryptedTxBz},
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"bundle does not match the decrypted bundle",
+			nil,
+			s.getTxBytes(bidTx, otherTx),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"invalid decrypted bundle",
+			invalidBundle{},
+			[][]byte{s.getTxBytes(bidTx)[0], bundledTxBz},
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			proposalHandler := setUpProposalHandler(tc.bundleValidator).ProcessProposalHandler()
+
+			resp, err := proposalHandler(s.ctx, &cometabci.RequestProcessProposal{Txs: tc.txs})
+			if tc.expected == cometabci.ResponseProcessProposal_ACCEPT {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+			s.Require().Equal(tc.expected, resp.Status)
+		})
+	}
 }
 
 func (s *ProposalsTestSuite) setUpAnteHandler(expectedExecution map[sdk.Tx]bool) sdk.AnteHandler {
@@ -0,0 +1,219 @@
+package abci
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	gomath "math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+// voteExtensionAuctionEnabled returns true if the auction is run over the bids included in the
+// vote extensions of the previous block. Vote extensions of the previous block are available
+// once the block at the vote extensions enable height has been committed.
+func (h *ProposalHandler) voteExtensionAuctionEnabled(ctx sdk.Context, height int64) bool {
+	if h.auctionLane == nil {
+		return false
+	}
+
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// prepareVoteExtensionAuction returns a proposal that includes the winning bundles of the
+// auction run over the bids included in the vote extensions of the previous block. The auction
+// info is added to the top of the proposal so that validators can verify the auction.
+func (h *ProposalHandler) prepareVoteExtensionAuction(
+	ctx sdk.Context,
+	req *abci.RequestPrepareProposal,
+) (blockbuster.BlockProposal, error) {
+	if err := h.validateVoteExtensions(ctx, req.Height, req.LocalLastCommit); err != nil {
+		return nil, fmt.Errorf("invalid vote extensions: %w", err)
+	}
+
+	extCommitBz, err := req.LocalLastCommit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validators do not receive the vote extensions when processing the proposal, so they are
+	// included in the auction info along with the bids that are rebuilt from them.
+	info := buildertypes.AuctionInfo{
+		BidTxs:             getBidTxsFromVoteExtensions(h.auctionLane.TxDecoder(), req.LocalLastCommit),
+		ExtendedCommitInfo: extCommitBz,
+	}
+
+	// The auction is run with the block space given by the consensus parameters so that
+	// validators can rerun it without knowing the maximum number of bytes given by CometBFT.
+	infoSize := getAuctionInfoSize(info)
+	maxTxBytes := getMaxBlockBytes(ctx) - infoSize
+	if maxTxBytes <= 0 {
+		return nil, fmt.Errorf("auction info is too large: %d > %d", infoSize, getMaxBlockBytes(ctx))
+	}
+
+	// CometBFT reserves space for the block header, the commit and the evidence, so the proposal
+	// itself may be limited to fewer bytes.
+	proposalMaxTxBytes := req.MaxTxBytes - infoSize
+	if proposalMaxTxBytes > maxTxBytes {
+		proposalMaxTxBytes = maxTxBytes
+	}
+
+	if proposalMaxTxBytes <= 0 {
+		return nil, fmt.Errorf("auction info is too large: %d > %d", infoSize, req.MaxTxBytes)
+	}
+
+	// The state changes of the winning bundles are written only if the auction succeeds so that
+	// the remaining lanes are prepared against the state after the winning bundles.
+	cacheCtx, write := ctx.CacheContext()
+	auctionProposal := blockbuster.NewProposalWithLimits(maxTxBytes, getMaxGasLimit(ctx), h.allocationMode)
+
+	txs, failedBidTxHashes, err := h.runVoteExtensionAuction(cacheCtx, auctionProposal, info.BidTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := blockbuster.NewProposalWithLimits(proposalMaxTxBytes, getMaxGasLimit(ctx), h.allocationMode)
+	if err := proposal.UpdateProposal(h.auctionLane, txs); err != nil {
+		return nil, err
+	}
+
+	write()
+
+	info.MaxTxBytes = maxTxBytes
+	info.NumTxs = uint64(len(txs))
+	info.FailedBidTxHashes = failedBidTxHashes
+
+	infoBz, err := buildertypes.EncodeAuctionInfo(info)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.AddVoteExtension(infoBz)
+
+	return proposal, nil
+}
+
+// verifyAuctionInfo verifies that the first transaction of the proposal is an auction info
+// whose vote extensions are valid and that the transactions that follow it are exactly the
+// winning bundles of the auction run over the bids included in the vote extensions. The bids
+// and the block space of the auction info must match the ones rebuilt from the vote extensions
+// and the consensus parameters, and the bids that the auction info records as failed must be
+// exactly the bids that failed verification. The auction info is verified even if the proposal
+// does not include any auction transactions, so a proposer cannot skip the auction. It returns
+// the verified auction info.
+func (h *ProposalHandler) verifyAuctionInfo(ctx sdk.Context, height int64, txs [][]byte) (buildertypes.AuctionInfo, error) {
+	if len(txs) == 0 {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("proposal does not include an auction info")
+	}
+
+	info, err := buildertypes.DecodeAuctionInfo(txs[0])
+	if err != nil {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("failed to decode auction info: %w", err)
+	}
+
+	if info.NumTxs > uint64(len(txs)-1) {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("auction info includes more txs than the proposal: %d > %d", info.NumTxs, len(txs)-1)
+	}
+
+	extCommit := abci.ExtendedCommitInfo{}
+	if err := extCommit.Unmarshal(info.ExtendedCommitInfo); err != nil {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("failed to decode extended commit info: %w", err)
+	}
+
+	if err := h.validateVoteExtensions(ctx, height, extCommit); err != nil {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("invalid vote extensions: %w", err)
+	}
+
+	// The bids are rebuilt from the vote extensions so that the proposer cannot omit any of them.
+	bidTxs := getBidTxsFromVoteExtensions(h.auctionLane.TxDecoder(), extCommit)
+	if len(bidTxs) != len(info.BidTxs) {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("expected %d bid txs, got %d", len(bidTxs), len(info.BidTxs))
+	}
+
+	for i, bidTx := range bidTxs {
+		if !bytes.Equal(bidTx, info.BidTxs[i]) {
+			return buildertypes.AuctionInfo{}, fmt.Errorf("bid tx %d does not match the vote extensions", i)
+		}
+	}
+
+	if maxTxBytes := getMaxBlockBytes(ctx) - getAuctionInfoSize(info); info.MaxTxBytes != maxTxBytes {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("expected max tx bytes of %d, got %d", maxTxBytes, info.MaxTxBytes)
+	}
+
+	// The auction is only run to determine the expected transactions, so any state changes
+	// are discarded. The transactions are executed when the lanes process the proposal.
+	cacheCtx, _ := ctx.CacheContext()
+	proposal := blockbuster.NewProposalWithLimits(info.MaxTxBytes, getMaxGasLimit(ctx), h.allocationMode)
+
+	expectedTxs, failedBidTxHashes, err := h.runVoteExtensionAuction(cacheCtx, proposal, info.BidTxs)
+	if err != nil {
+		return buildertypes.AuctionInfo{}, err
+	}
+
+	if len(failedBidTxHashes) != len(info.FailedBidTxHashes) {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("expected %d failed bids, got %d", len(failedBidTxHashes), len(info.FailedBidTxHashes))
+	}
+
+	for i, failedBidTxHash := range failedBidTxHashes {
+		if !bytes.Equal(failedBidTxHash, info.FailedBidTxHashes[i]) {
+			return buildertypes.AuctionInfo{}, fmt.Errorf("failed bid %d does not match the auction run over the auction info", i)
+		}
+	}
+
+	if uint64(len(expectedTxs)) != info.NumTxs {
+		return buildertypes.AuctionInfo{}, fmt.Errorf("expected %d auction txs, got %d", len(expectedTxs), info.NumTxs)
+	}
+
+	for i, expectedTx := range expectedTxs {
+		if !bytes.Equal(expectedTx, txs[i+1]) {
+			return buildertypes.AuctionInfo{}, fmt.Errorf("auction tx %d does not match the auction run over the auction info", i)
+		}
+	}
+
+	return info, nil
+}
+
+// getAuctionInfoSize returns the size of the auction info once it is included in the block.
+// The block space of the auction, the number of transactions and the bids that fail
+// verification are not known before the auction is run, so the largest possible values are
+// used to determine the size.
+func getAuctionInfoSize(info buildertypes.AuctionInfo) int64 {
+	info.MaxTxBytes = gomath.MaxInt64
+	info.NumTxs = gomath.MaxUint64
+	info.FailedBidTxHashes = make([][]byte, len(info.BidTxs))
+	for i := range info.FailedBidTxHashes {
+		info.FailedBidTxHashes[i] = make([]byte, sha256.Size)
+	}
+
+	return int64(len(buildertypes.AuctionInfoPrefix) + info.Size())
+}
+
+// runVoteExtensionAuction runs the auction over the given bid transactions and returns the
+// transactions of the winning bundles and the hashes of the bid transactions that failed
+// verification.
+func (h *ProposalHandler) runVoteExtensionAuction(
+	ctx sdk.Context,
+	proposal blockbuster.BlockProposal,
+	bidTxsBz [][]byte,
+) ([][]byte, [][]byte, error) {
+	bidTxs := make([]sdk.Tx, 0, len(bidTxsBz))
+	for _, bidTxBz := range bidTxsBz {
+		bidTx, err := h.auctionLane.TxDecoder()(bidTxBz)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode bid tx: %w", err)
+		}
+
+		bidTxs = append(bidTxs, bidTx)
+	}
+
+	return h.auctionLane.PrepareAuction(
+		ctx,
+		proposal,
+		getMaxTxBytesForLane(proposal, h.auctionLane, h.lanes[1:]),
+		bidTxs,
+	)
+}
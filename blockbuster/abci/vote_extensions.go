@@ -0,0 +1,226 @@
+package abci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/utils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+type (
+	// AuctionLane defines the interface of the top-of-block auction lane that is utilized to
+	// extend votes with the top bids of a validator and to run the auction over the bids that
+	// are included in the vote extensions.
+	AuctionLane interface {
+		blockbuster.Lane
+
+		// GetAuctionBidInfo returns the bid info of a bid transaction.
+		GetAuctionBidInfo(tx sdk.Tx) (*buildertypes.BidInfo, error)
+
+		// VerifyTx verifies the bid transaction and all of its bundled transactions.
+		VerifyTx(ctx sdk.Context, bidTx sdk.Tx, bidInfo *buildertypes.BidInfo) error
+
+		// PrepareAuction runs the auction over the given bid transactions and returns the
+		// transactions of the winning bundles and the hashes of the bid transactions that
+		// failed verification.
+		PrepareAuction(ctx sdk.Context, proposal blockbuster.BlockProposal, maxTxBytes int64, bidTxs []sdk.Tx) ([][]byte, [][]byte, error)
+	}
+
+	// ValidateVoteExtensionsFn validates the vote extensions of the extended commit info of the
+	// previous block, e.g. their signatures and the voting power of the validators that
+	// submitted them.
+	ValidateVoteExtensionsFn func(ctx sdk.Context, height int64, extCommit abci.ExtendedCommitInfo) error
+
+	// VoteExtensionHandler is a wrapper around the ABCI++ ExtendVote and VerifyVoteExtension
+	// handlers. Validators extend their votes with the top bids in their local top-of-block
+	// auction mempool so that the proposer of the next block must run the auction over the
+	// bids seen by all validators.
+	VoteExtensionHandler struct {
+		logger    log.Logger
+		lane      AuctionLane
+		txDecoder sdk.TxDecoder
+		txEncoder sdk.TxEncoder
+
+		// maxBids is the maximum number of bids that can be included in a vote extension.
+		maxBids int
+	}
+)
+
+// NewVoteExtensionHandler returns a new vote extension handler that extends votes with at
+// most maxBids of the top bids in the given lane.
+func NewVoteExtensionHandler(
+	logger log.Logger,
+	lane AuctionLane,
+	txDecoder sdk.TxDecoder,
+	txEncoder sdk.TxEncoder,
+	maxBids int,
+) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:    logger,
+		lane:      lane,
+		txDecoder: txDecoder,
+		txEncoder: txEncoder,
+		maxBids:   maxBids,
+	}
+}
+
+// NewDefaultValidateVoteExtensionsFn returns a ValidateVoteExtensionsFn that verifies the
+// signatures of the vote extensions and ensures that validators with at least 2/3 of the
+// voting power submitted them.
+func NewDefaultValidateVoteExtensionsFn(valStore baseapp.ValidatorStore) ValidateVoteExtensionsFn {
+	return func(ctx sdk.Context, height int64, extCommit abci.ExtendedCommitInfo) error {
+		return baseapp.ValidateVoteExtensions(ctx, valStore, height, ctx.ChainID(), extCommit)
+	}
+}
+
+// ExtendVoteHandler extends the vote with the top bids in the local top-of-block auction
+// mempool that are valid against the current state. If the bids cannot be retrieved, the
+// vote is extended with an empty vote extension.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (resp *abci.ResponseExtendVote, err error) {
+		// In the case where there is a panic, we recover here and return an empty vote extension.
+		defer func() {
+			if rec := recover(); rec != nil {
+				h.logger.Error("failed to extend vote", "err", rec)
+				resp, err = &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+			}
+		}()
+
+		info := VoteExtensionInfo{Registry: make(map[string][]byte)}
+		for iterator := h.lane.Select(ctx, nil); iterator != nil && len(info.Registry) < h.maxBids; iterator = iterator.Next() {
+			bidTx := iterator.Tx()
+
+			bidInfo, err := h.lane.GetAuctionBidInfo(bidTx)
+			if err != nil || bidInfo == nil {
+				continue
+			}
+
+			// Bids that are not valid against the current state would not win the auction.
+			cacheCtx, _ := ctx.CacheContext()
+			if err := h.lane.VerifyTx(cacheCtx, bidTx, bidInfo); err != nil {
+				continue
+			}
+
+			bidTxBz, hash, err := utils.GetTxHashStr(h.txEncoder, bidTx)
+			if err != nil {
+				continue
+			}
+
+			info.Registry[hash] = bidTxBz
+		}
+
+		voteExtension, err := info.Marshal()
+		if err != nil {
+			h.logger.Error("failed to marshal vote extension", "err", err)
+			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		}
+
+		h.logger.Info("extended vote", "num_bids", len(info.Registry), "height", req.Height)
+
+		return &abci.ResponseExtendVote{VoteExtension: voteExtension}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler verifies that the vote extension includes at most maxBids bid
+// transactions, each of which is keyed by its hash. The bids are not verified against state
+// since validators may verify the vote extension against a different state than the one it
+// was created against.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		if err := h.verifyVoteExtension(req.VoteExtension); err != nil {
+			h.logger.Error(
+				"failed to verify vote extension",
+				"height", req.Height,
+				"validator", hex.EncodeToString(req.ValidatorAddress),
+				"err", err,
+			)
+
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, err
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// verifyVoteExtension verifies the structure of the vote extension.
+func (h *VoteExtensionHandler) verifyVoteExtension(voteExtension []byte) error {
+	info := VoteExtensionInfo{}
+	if err := info.Unmarshal(voteExtension); err != nil {
+		return fmt.Errorf("failed to decode vote extension: %w", err)
+	}
+
+	if len(info.Registry) > h.maxBids {
+		return fmt.Errorf("vote extension includes too many bids; expected at most %d, got %d", h.maxBids, len(info.Registry))
+	}
+
+	for hash, bidTxBz := range info.Registry {
+		txHash := sha256.Sum256(bidTxBz)
+		if hex.EncodeToString(txHash[:]) != hash {
+			return fmt.Errorf("bid tx does not match its hash %s", hash)
+		}
+
+		bidTx, err := h.txDecoder(bidTxBz)
+		if err != nil {
+			return fmt.Errorf("failed to decode bid tx %s: %w", hash, err)
+		}
+
+		bidInfo, err := h.lane.GetAuctionBidInfo(bidTx)
+		if err != nil {
+			return fmt.Errorf("failed to get bid info of bid tx %s: %w", hash, err)
+		}
+
+		if bidInfo == nil {
+			return fmt.Errorf("tx %s is not a bid tx", hash)
+		}
+	}
+
+	return nil
+}
+
+// getBidTxsFromVoteExtensions returns the bytes of all of the bid transactions included in the
+// vote extensions, deduplicated and sorted by their hash. Malformed vote extensions and bids
+// are skipped.
+func getBidTxsFromVoteExtensions(txDecoder sdk.TxDecoder, extCommit abci.ExtendedCommitInfo) [][]byte {
+	bidTxsBz := make(map[string][]byte)
+	for _, vote := range extCommit.Votes {
+		info := VoteExtensionInfo{}
+		if err := info.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+
+		for _, bidTxBz := range info.Registry {
+			if _, err := txDecoder(bidTxBz); err != nil {
+				continue
+			}
+
+			// The hash is recomputed so that a vote extension cannot shadow the bid of another.
+			txHash := sha256.Sum256(bidTxBz)
+			bidTxsBz[hex.EncodeToString(txHash[:])] = bidTxBz
+		}
+	}
+
+	hashes := make([]string, 0, len(bidTxsBz))
+	for hash := range bidTxsBz {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	bidTxs := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		bidTxs = append(bidTxs, bidTxsBz[hash])
+	}
+
+	return bidTxs
+}
@@ -0,0 +1,443 @@
+package abci_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/abci"
+	"github.com/skip-mev/pob/blockbuster/utils"
+	testutils "github.com/skip-mev/pob/testutils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+func (s *ProposalsTestSuite) TestExtendVote() {
+	highBidTx, highBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		0,
+		0,
+		s.accounts[0:1],
+	)
+	s.Require().NoError(err)
+
+	lowBidTx, lowBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[1:2],
+	)
+	s.Require().NoError(err)
+
+	expectedExecution := map[sdk.Tx]bool{
+		highBidTx:     true,
+		highBundle[0]: true,
+		lowBidTx:      false,
+		lowBundle[0]:  true,
+	}
+
+	extendVote := func(maxBids int) abci.VoteExtensionInfo {
+		tobLane := s.setUpTOBLane(math.LegacyZeroDec(), expectedExecution)
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, highBidTx))
+		s.Require().NoError(tobLane.Insert(sdk.Context{}, lowBidTx))
+
+		handler := s.setUpVoteExtensionHandler(tobLane, maxBids).ExtendVoteHandler()
+		resp, err := handler(s.ctx, &cometabci.RequestExtendVote{Height: 2})
+		s.Require().NoError(err)
+
+		info := abci.VoteExtensionInfo{}
+		s.Require().NoError(info.Unmarshal(resp.VoteExtension))
+
+		return info
+	}
+
+	s.Run("vote is extended with the valid bids", func() {
+		info := extendVote(5)
+
+		bidTxBz, hash, err := utils.GetTxHashStr(s.encodingConfig.TxConfig.TxEncoder(), highBidTx)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string][]byte{hash: bidTxBz}, info.Registry)
+	})
+
+	s.Run("vote is extended with at most max bids", func() {
+		info := extendVote(0)
+		s.Require().Empty(info.Registry)
+	})
+}
+
+func (s *ProposalsTestSuite) TestVerifyVoteExtension() {
+	bidTx, bundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[0:1],
+	)
+	s.Require().NoError(err)
+
+	otherBidTx, _, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[1:2],
+	)
+	s.Require().NoError(err)
+
+	bidTxBz, hash, err := utils.GetTxHashStr(s.encodingConfig.TxConfig.TxEncoder(), bidTx)
+	s.Require().NoError(err)
+
+	otherBidTxBz, otherHash, err := utils.GetTxHashStr(s.encodingConfig.TxConfig.TxEncoder(), otherBidTx)
+	s.Require().NoError(err)
+
+	bundledTxBz, bundledHash, err := utils.GetTxHashStr(s.encodingConfig.TxConfig.TxEncoder(), bundle[0])
+	s.Require().NoError(err)
+
+	encode := func(registry map[string][]byte) []byte {
+		info := abci.VoteExtensionInfo{Registry: registry}
+		bz, err := info.Marshal()
+		s.Require().NoError(err)
+
+		return bz
+	}
+
+	testCases := []struct {
+		name          string
+		voteExtension []byte
+		expected      cometabci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{
+			"empty vote extension",
+			nil,
+			cometabci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+		{
+			"valid vote extension",
+			encode(map[string][]byte{hash: bidTxBz}),
+			cometabci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+		{
+			"vote extension that cannot be decoded",
+			[]byte{0xFF, 0xFF},
+			cometabci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			"vote extension with too many bids",
+			encode(map[string][]byte{hash: bidTxBz, otherHash: otherBidTxBz}),
+			cometabci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			"bid tx that does not match its hash",
+			encode(map[string][]byte{otherHash: bidTxBz}),
+			cometabci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			"tx that is not a bid tx",
+			encode(map[string][]byte{bundledHash: bundledTxBz}),
+			cometabci.ResponseVerifyVoteExtension_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tobLane := s.setUpTOBLane(math.LegacyZeroDec(), nil)
+			handler := s.setUpVoteExtensionHandler(tobLane, 1).VerifyVoteExtensionHandler()
+
+			resp, err := handler(s.ctx, &cometabci.RequestVerifyVoteExtension{Height: 2, VoteExtension: tc.voteExtension})
+			if tc.expected == cometabci.ResponseVerifyVoteExtension_ACCEPT {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+			s.Require().Equal(tc.expected, resp.Status)
+		})
+	}
+}
+
+func (s *ProposalsTestSuite) TestVoteExtensionAuction() {
+	highBidTx, highBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		0,
+		0,
+		s.accounts[0:1],
+	)
+	s.Require().NoError(err)
+
+	lowBidTx, lowBundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[1:2],
+	)
+	s.Require().NoError(err)
+
+	expectedExecution := map[sdk.Tx]bool{
+		highBidTx:     true,
+		highBundle[0]: true,
+		lowBidTx:      true,
+		lowBundle[0]:  true,
+	}
+
+	// The bids are only included in the vote extensions of other validators, so the proposer
+	// must include the winning bundle even though its local mempool is empty.
+	extCommit := cometabci.ExtendedCommitInfo{
+		Votes: []cometabci.ExtendedVoteInfo{
+			{VoteExtension: s.getVoteExtension(lowBidTx)},
+			{VoteExtension: s.getVoteExtension(highBidTx)},
+			{VoteExtension: []byte{0xFF, 0xFF}},
+		},
+	}
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+	})
+
+	setUpProposalHandler := func(validateErr error) *abci.ProposalHandler {
+		tobLane := s.setUpTOBLane(math.LegacyZeroDec(), expectedExecution)
+		defaultLane := s.setUpDefaultLane(math.LegacyZeroDec(), expectedExecution)
+		mempool := blockbuster.NewMempool(log.NewTestLogger(s.T()), true, tobLane, defaultLane)
+
+		proposalHandler := abci.NewProposalHandler(log.NewTestLogger(s.T()), tobLane.TxDecoder(), mempool.Registry())
+		proposalHandler.SetVoteExtensionAuction(
+			tobLane,
+			func(_ sdk.Context, _ int64, _ cometabci.ExtendedCommitInfo) error { return validateErr },
+		)
+
+		return proposalHandler
+	}
+
+	prepare := func(maxTxBytes int64) [][]byte {
+		proposalHandler := setUpProposalHandler(nil).PrepareProposalHandler()
+
+		resp, err := proposalHandler(ctx, &cometabci.RequestPrepareProposal{
+			Height:          2,
+			MaxTxBytes:      maxTxBytes,
+			LocalLastCommit: extCommit,
+		})
+		s.Require().NoError(err)
+
+		return resp.Txs
+	}
+
+	winningBundle := s.getTxBytes(highBidTx, highBundle[0])
+
+	s.Run("auction is run over the bids in the vote extensions", func() {
+		txs := prepare(10000000000)
+		s.Require().Len(txs, 3)
+		s.Require().Equal(winningBundle, txs[1:])
+
+		info, err := buildertypes.DecodeAuctionInfo(txs[0])
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), info.NumTxs)
+
+		// Only the deduplicated bids are included in the auction info.
+		s.Require().ElementsMatch(s.getTxBytes(lowBidTx, highBidTx), info.BidTxs)
+
+		extCommitBz, err := extCommit.Marshal()
+		s.Require().NoError(err)
+		s.Require().Equal(extCommitBz, info.ExtendedCommitInfo)
+	})
+
+	s.Run("auction block space does not depend on the max tx bytes given by cometbft", func() {
+		info, err := buildertypes.DecodeAuctionInfo(prepare(10000000000)[0])
+		s.Require().NoError(err)
+
+		limitedInfo, err := buildertypes.DecodeAuctionInfo(prepare(1000000)[0])
+		s.Require().NoError(err)
+		s.Require().Equal(info.MaxTxBytes, limitedInfo.MaxTxBytes)
+	})
+
+	s.Run("proposal is not prepared if the vote extensions are invalid", func() {
+		proposalHandler := setUpProposalHandler(fmt.Errorf("invalid vote extensions")).PrepareProposalHandler()
+
+		_, err := proposalHandler(ctx, &cometabci.RequestPrepareProposal{
+			Height:          2,
+			MaxTxBytes:      10000000000,
+			LocalLastCommit: extCommit,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("auction is run over the local mempool before vote extensions are enabled", func() {
+		proposalHandler := setUpProposalHandler(nil).PrepareProposalHandler()
+
+		resp, err := proposalHandler(ctx, &cometabci.RequestPrepareProposal{
+			Height:          1,
+			MaxTxBytes:      10000000000,
+			LocalLastCommit: extCommit,
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Txs)
+	})
+
+	txs := prepare(10000000000)
+
+	encodeAuctionInfo := func(update func(info *buildertypes.AuctionInfo)) []byte {
+		info, err := buildertypes.DecodeAuctionInfo(txs[0])
+		s.Require().NoError(err)
+		update(&info)
+
+		bz, err := buildertypes.EncodeAuctionInfo(info)
+		s.Require().NoError(err)
+
+		return bz
+	}
+
+	testCases := []struct {
+		name        string
+		validateErr error
+		txs         [][]byte
+		expected    cometabci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			"valid auction",
+			nil,
+			txs,
+			cometabci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			"auction info with invalid vote extensions",
+			fmt.Errorf("invalid vote extensions"),
+			txs,
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info with vote extensions that do not include its bids",
+			nil,
+			append([][]byte{encodeAuctionInfo(func(info *buildertypes.AuctionInfo) {
+				votes := cometabci.ExtendedCommitInfo{
+					Votes: []cometabci.ExtendedVoteInfo{{VoteExtension: s.getVoteExtension(lowBidTx)}},
+				}
+
+				bz, err := votes.Marshal()
+				s.Require().NoError(err)
+				info.ExtendedCommitInfo = bz
+			})}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info with a different max tx bytes",
+			nil,
+			append([][]byte{encodeAuctionInfo(func(info *buildertypes.AuctionInfo) { info.MaxTxBytes++ })}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"empty proposal without auction info",
+			nil,
+			[][]byte{},
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"proposal without auction info",
+			nil,
+			winningBundle,
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info that omits the winning bundle",
+			nil,
+			[][]byte{encodeAuctionInfo(func(info *buildertypes.AuctionInfo) { info.NumTxs = 0 })},
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info with more txs than the proposal",
+			nil,
+			append([][]byte{encodeAuctionInfo(func(info *buildertypes.AuctionInfo) { info.NumTxs = 3 })}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info without the winning bid",
+			nil,
+			append([][]byte{txs[0]}, s.getTxBytes(lowBidTx, lowBundle[0])...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info with different bids",
+			nil,
+			append([][]byte{encodeAuctionInfo(func(info *buildertypes.AuctionInfo) {
+				info.BidTxs = s.getTxBytes(lowBidTx)
+			})}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info with unsorted bids",
+			nil,
+			append([][]byte{encodeAuctionInfo(func(info *buildertypes.AuctionInfo) {
+				info.BidTxs[0], info.BidTxs[1] = info.BidTxs[1], info.BidTxs[0]
+			})}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"auction info with a bid that cannot be decoded",
+			nil,
+			append([][]byte{encodeAuctionInfo(func(info *buildertypes.AuctionInfo) {
+				info.BidTxs = [][]byte{{0xFF, 0xFF}}
+			})}, winningBundle...),
+			cometabci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	s.Run("auction lane must be the first lane", func() {
+		tobLane := s.setUpTOBLane(math.LegacyZeroDec(), expectedExecution)
+		defaultLane := s.setUpDefaultLane(math.LegacyZeroDec(), expectedExecution)
+		mempool := blockbuster.NewMempool(log.NewTestLogger(s.T()), true, defaultLane, tobLane)
+
+		proposalHandler := abci.NewProposalHandler(log.NewTestLogger(s.T()), tobLane.TxDecoder(), mempool.Registry())
+		s.Require().Panics(func() {
+			proposalHandler.SetVoteExtensionAuction(tobLane, nil)
+		})
+	})
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			proposalHandler := setUpProposalHandler(tc.validateErr).ProcessProposalHandler()
+
+			resp, err := proposalHandler(ctx, &cometabci.RequestProcessProposal{Height: 2, Txs: tc.txs})
+			if tc.expected == cometabci.ResponseProcessProposal_ACCEPT {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+			s.Require().Equal(tc.expected, resp.Status)
+		})
+	}
+}
+
+func (s *ProposalsTestSuite) setUpVoteExtensionHandler(lane abci.AuctionLane, maxBids int) *abci.VoteExtensionHandler {
+	return abci.NewVoteExtensionHandler(
+		log.NewTestLogger(s.T()),
+		lane,
+		s.encodingConfig.TxConfig.TxDecoder(),
+		s.encodingConfig.TxConfig.TxEncoder(),
+		maxBids,
+	)
+}
+
+// getVoteExtension returns a vote extension that includes the given bid transactions.
+func (s *ProposalsTestSuite) getVoteExtension(bidTxs ...sdk.Tx) []byte {
+	info := abci.VoteExtensionInfo{Registry: make(map[string][]byte)}
+	for _, bidTx := range bidTxs {
+		bidTxBz, hash, err := utils.GetTxHashStr(s.encodingConfig.TxConfig.TxEncoder(), bidTx)
+		s.Require().NoError(err)
+
+		info.Registry[hash] = bidTxBz
+	}
+
+	bz, err := info.Marshal()
+	s.Require().NoError(err)
+
+	return bz
+}
@@ -0,0 +1,335 @@
+package fees
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/service/types"
+	"github.com/skip-mev/pob/blockbuster/utils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+const (
+	// DefaultNumBlocks is the default number of recent blocks that fee percentiles
+	// are computed over.
+	DefaultNumBlocks = 20
+)
+
+// DefaultPercentiles are the fee percentiles returned when none are requested.
+var DefaultPercentiles = []uint32{25, 50, 75}
+
+type (
+	// bundleLane is implemented by lanes whose transactions bundle other transactions of
+	// the block, e.g. the top of block lane.
+	bundleLane interface {
+		GetAuctionBidInfo(tx sdk.Tx) (*buildertypes.BidInfo, error)
+	}
+
+	// FeeHandler returns the fee a transaction pays for inclusion in a lane. Lanes
+	// that do not order transactions by their transaction fee (e.g. the top of block
+	// lane orders by bid) can register a custom fee handler with the estimator.
+	FeeHandler func(tx sdk.Tx) (sdk.Coins, error)
+
+	// blockFees defines the fees paid by the transactions included in each lane
+	// at a given height.
+	blockFees struct {
+		height int64
+		fees   map[string][]sdk.Coins
+	}
+
+	// Estimator estimates the fee required for a transaction to be included in each
+	// lane of the BlockBuster mempool. Estimates are derived from the current contents
+	// of each lane as well as the fees paid by transactions included in recent blocks.
+	Estimator struct {
+		mempool   blockbuster.Mempool
+		txEncoder sdk.TxEncoder
+		numBlocks int
+
+		// feeHandlers maps lane names to custom fee handlers.
+		feeHandlers map[string]FeeHandler
+
+		mtx     sync.RWMutex
+		history []blockFees
+	}
+)
+
+// NewEstimator returns a new fee estimator for the given mempool that computes fee
+// percentiles over the last numBlocks blocks.
+func NewEstimator(mempool blockbuster.Mempool, txEncoder sdk.TxEncoder, numBlocks int) *Estimator {
+	if numBlocks <= 0 {
+		numBlocks = DefaultNumBlocks
+	}
+
+	return &Estimator{
+		mempool:     mempool,
+		txEncoder:   txEncoder,
+		numBlocks:   numBlocks,
+		feeHandlers: make(map[string]FeeHandler),
+		history:     make([]blockFees, 0, numBlocks),
+	}
+}
+
+// SetFeeHandler sets a custom fee handler for the lane with the given name.
+func (e *Estimator) SetFeeHandler(lane string, handler FeeHandler) {
+	e.feeHandlers[lane] = handler
+}
+
+// PreFinalizeBlockHook returns a hook that records the fees paid by the transactions of
+// each block as it is finalized. Only committed blocks are recorded, so proposals that
+// are accepted but never decided do not skew the estimates. Transactions that cannot be
+// decoded, e.g. the auction info injected by the proposer, are ignored.
+func (e *Estimator) PreFinalizeBlockHook(txDecoder sdk.TxDecoder) sdk.PreFinalizeBlockHook {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+		txs := make([]sdk.Tx, 0, len(req.Txs))
+		for _, txBz := range req.Txs {
+			tx, err := txDecoder(txBz)
+			if err != nil {
+				continue
+			}
+
+			txs = append(txs, tx)
+		}
+
+		e.RecordBlock(ctx, txs)
+
+		return nil
+	}
+}
+
+// RecordBlock records the fees paid by the transactions in a finalized block. Each
+// transaction is attributed to the first lane in the registry that matches it. The
+// bundled transactions of bids are skipped since their inclusion is paid for by the
+// bid. Recording a block at a height that was already recorded (e.g. when a block is
+// replayed) replaces the previous record.
+func (e *Estimator) RecordBlock(ctx sdk.Context, txs []sdk.Tx) {
+	record := blockFees{
+		height: ctx.BlockHeight(),
+		fees:   make(map[string][]sdk.Coins),
+	}
+
+	registry := e.mempool.Registry()
+	bundledTxs := e.getBundledTxs(txs)
+	for _, tx := range txs {
+		if len(bundledTxs) > 0 {
+			if _, txHash, err := utils.GetTxHashStr(e.txEncoder, tx); err == nil {
+				if _, ok := bundledTxs[txHash]; ok {
+					continue
+				}
+			}
+		}
+
+		for _, lane := range registry {
+			if !lane.Match(ctx, tx) {
+				continue
+			}
+
+			fee, err := e.getFee(lane.Name(), tx)
+			if err == nil {
+				record.fees[lane.Name()] = append(record.fees[lane.Name()], fee)
+			}
+
+			break
+		}
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for index, block := range e.history {
+		if block.height == record.height {
+			e.history[index] = record
+			return
+		}
+	}
+
+	e.history = append(e.history, record)
+	if len(e.history) > e.numBlocks {
+		e.history = e.history[len(e.history)-e.numBlocks:]
+	}
+}
+
+// getBundledTxs returns the hashes of the bundled transactions of the bids in the given
+// transactions.
+func (e *Estimator) getBundledTxs(txs []sdk.Tx) map[string]struct{} {
+	bundledTxs := make(map[string]struct{})
+	for _, lane := range e.mempool.Registry() {
+		bundler, ok := lane.(bundleLane)
+		if !ok {
+			continue
+		}
+
+		for _, tx := range txs {
+			bidInfo, err := bundler.GetAuctionBidInfo(tx)
+			if err != nil || bidInfo == nil {
+				continue
+			}
+
+			for _, bundledTxBz := range bidInfo.Transactions {
+				txHash := sha256.Sum256(bundledTxBz)
+				bundledTxs[hex.EncodeToString(txHash[:])] = struct{}{}
+			}
+		}
+	}
+
+	return bundledTxs
+}
+
+// EstimateFees returns the fee estimates for the lane with the given name, or for
+// every lane in the registry if the name is empty. maxTxBytes is the maximum number
+// of bytes that can be included in a block.
+func (e *Estimator) EstimateFees(
+	ctx sdk.Context,
+	laneName string,
+	maxTxBytes int64,
+	percentiles []uint32,
+) ([]types.FeeEstimate, error) {
+	if len(percentiles) == 0 {
+		percentiles = DefaultPercentiles
+	}
+
+	for _, percentile := range percentiles {
+		if percentile == 0 || percentile > 100 {
+			return nil, fmt.Errorf("invalid percentile %d; must be in the range [1, 100]", percentile)
+		}
+	}
+
+	estimates := make([]types.FeeEstimate, 0)
+	for _, lane := range e.mempool.Registry() {
+		if laneName != "" && lane.Name() != laneName {
+			continue
+		}
+
+		estimate, err := e.estimateLane(ctx, lane, maxTxBytes, percentiles)
+		if err != nil {
+			return nil, err
+		}
+
+		estimates = append(estimates, estimate)
+	}
+
+	if laneName != "" && len(estimates) == 0 {
+		return nil, fmt.Errorf("lane %s not found", laneName)
+	}
+
+	return estimates, nil
+}
+
+// estimateLane returns the fee estimate for a single lane. The lane is full if the
+// transactions in the lane's mempool exceed the lane's portion of the block, in which
+// case the marginal fee is the fee of the lowest priority transaction that still fits.
+func (e *Estimator) estimateLane(
+	ctx sdk.Context,
+	lane blockbuster.Lane,
+	maxTxBytes int64,
+	percentiles []uint32,
+) (types.FeeEstimate, error) {
+	maxBlockSpace := lane.GetMaxBlockSpace()
+	if maxBlockSpace.IsNil() {
+		maxBlockSpace = math.LegacyZeroDec()
+	}
+
+	estimate := types.FeeEstimate{
+		Lane:        lane.Name(),
+		MaxTxBytes:  utils.GetMaxTxBytesForLane(maxTxBytes, 0, maxBlockSpace),
+		MarginalFee: sdk.NewCoins(),
+	}
+
+	var (
+		totalTxBytes int64
+		marginalFee  sdk.Coins
+	)
+
+	// Select iterates over a snapshot of the lane that is taken under the lane's lock, so
+	// the estimate is safe to compute while transactions are inserted and removed.
+	for iterator := lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+		tx := iterator.Tx()
+
+		txBz, err := e.txEncoder(tx)
+		if err != nil {
+			continue
+		}
+
+		if totalTxBytes+int64(len(txBz)) > estimate.MaxTxBytes {
+			estimate.LaneFull = true
+			break
+		}
+		totalTxBytes += int64(len(txBz))
+
+		if fee, err := e.getFee(lane.Name(), tx); err == nil {
+			marginalFee = fee
+		}
+	}
+
+	if estimate.LaneFull && marginalFee != nil {
+		estimate.MarginalFee = marginalFee
+	}
+
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	fees := make([]sdk.Coins, 0)
+	for _, block := range e.history {
+		fees = append(fees, block.fees[lane.Name()]...)
+	}
+
+	estimate.NumBlocks = uint64(len(e.history))
+	estimate.Percentiles = make([]types.FeePercentile, len(percentiles))
+	for index, percentile := range percentiles {
+		estimate.Percentiles[index] = types.FeePercentile{
+			Percentile: percentile,
+			Fee:        computePercentile(fees, percentile),
+		}
+	}
+
+	return estimate, nil
+}
+
+// getFee returns the fee paid by the transaction for inclusion in the given lane.
+func (e *Estimator) getFee(lane string, tx sdk.Tx) (sdk.Coins, error) {
+	if handler, ok := e.feeHandlers[lane]; ok {
+		return handler(tx)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("transaction does not implement FeeTx")
+	}
+
+	return feeTx.GetFee(), nil
+}
+
+// computePercentile returns the nearest-rank percentile of the given fees. The
+// percentile is computed independently for each denom.
+func computePercentile(fees []sdk.Coins, percentile uint32) sdk.Coins {
+	amounts := make(map[string][]math.Int)
+	for _, fee := range fees {
+		for _, coin := range fee {
+			amounts[coin.Denom] = append(amounts[coin.Denom], coin.Amount)
+		}
+	}
+
+	result := sdk.NewCoins()
+	for denom, values := range amounts {
+		sort.Slice(values, func(i, j int) bool {
+			return values[i].LT(values[j])
+		})
+
+		// The nearest rank is ceil(percentile / 100 * n).
+		rank := (int(percentile)*len(values) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+
+		result = result.Add(sdk.NewCoin(denom, values[rank-1]))
+	}
+
+	return result
+}
@@ -0,0 +1,268 @@
+package fees_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/fees"
+	"github.com/skip-mev/pob/blockbuster/lanes/auction"
+	"github.com/skip-mev/pob/blockbuster/lanes/base"
+	"github.com/skip-mev/pob/blockbuster/lanes/free"
+	testutils "github.com/skip-mev/pob/testutils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type EstimatorTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+
+	encodingConfig testutils.EncodingConfig
+
+	freeLane *free.FreeLane
+	baseLane *base.DefaultLane
+
+	mempool   blockbuster.Mempool
+	estimator *fees.Estimator
+
+	accounts []testutils.Account
+	random   *rand.Rand
+}
+
+func TestEstimatorTestSuite(t *testing.T) {
+	suite.Run(t, new(EstimatorTestSuite))
+}
+
+func (suite *EstimatorTestSuite) SetupTest() {
+	suite.encodingConfig = testutils.CreateTestEncodingConfig()
+	suite.random = rand.New(rand.NewSource(time.Now().Unix()))
+	key := storetypes.NewKVStoreKey(buildertypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	suite.ctx = testCtx.Ctx.WithBlockHeight(1)
+
+	suite.freeLane = free.NewFreeLane(
+		blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.2"),
+		},
+		blockbuster.DefaultTxPriority(),
+		free.DefaultMatchHandler(),
+	)
+
+	suite.baseLane = base.NewDefaultLane(
+		blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyZeroDec(),
+		},
+	)
+
+	suite.mempool = blockbuster.NewMempool(log.NewNopLogger(), true, suite.freeLane, suite.baseLane)
+	suite.estimator = fees.NewEstimator(suite.mempool, suite.encodingConfig.TxConfig.TxEncoder(), 2)
+	suite.accounts = testutils.RandomAccounts(suite.random, 5)
+}
+
+func (suite *EstimatorTestSuite) createTx(account testutils.Account, fee int64) sdk.Tx {
+	tx, err := testutils.CreateRandomTx(
+		suite.encodingConfig.TxConfig,
+		account,
+		0,
+		1,
+		1000,
+		sdk.NewCoin("stake", math.NewInt(fee)),
+	)
+	suite.Require().NoError(err)
+
+	return tx
+}
+
+func (suite *EstimatorTestSuite) txSize(tx sdk.Tx) int64 {
+	txBz, err := suite.encodingConfig.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	return int64(len(txBz))
+}
+
+func (suite *EstimatorTestSuite) TestMarginalFee() {
+	txs := make([]sdk.Tx, 3)
+	for i := range txs {
+		txs[i] = suite.createTx(suite.accounts[i], int64(100*(i+1)))
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, txs[i]))
+	}
+
+	// All transactions have the same size since they only differ in their fee amount.
+	size := suite.txSize(txs[0])
+
+	suite.Run("lane is not full", func() {
+		estimates, err := suite.estimator.EstimateFees(suite.ctx, base.LaneName, 3*size, nil)
+		suite.Require().NoError(err)
+		suite.Require().Len(estimates, 1)
+
+		estimate := estimates[0]
+		suite.Require().Equal(base.LaneName, estimate.Lane)
+		suite.Require().Equal(3*size, estimate.MaxTxBytes)
+		suite.Require().False(estimate.LaneFull)
+		suite.Require().True(estimate.MarginalFee.IsZero())
+	})
+
+	suite.Run("lane is full", func() {
+		estimates, err := suite.estimator.EstimateFees(suite.ctx, base.LaneName, 2*size, nil)
+		suite.Require().NoError(err)
+		suite.Require().Len(estimates, 1)
+
+		// The two highest paying transactions fit in the block so a transaction must
+		// outbid the second highest fee.
+		estimate := estimates[0]
+		suite.Require().True(estimate.LaneFull)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(200))), estimate.MarginalFee)
+	})
+
+	suite.Run("lane budget is relative to max block space", func() {
+		estimates, err := suite.estimator.EstimateFees(suite.ctx, free.LaneName, 1000, nil)
+		suite.Require().NoError(err)
+		suite.Require().Len(estimates, 1)
+		suite.Require().Equal(int64(200), estimates[0].MaxTxBytes)
+		suite.Require().False(estimates[0].LaneFull)
+	})
+
+	suite.Run("all lanes", func() {
+		estimates, err := suite.estimator.EstimateFees(suite.ctx, "", 2*size, nil)
+		suite.Require().NoError(err)
+		suite.Require().Len(estimates, 2)
+		suite.Require().Equal(free.LaneName, estimates[0].Lane)
+		suite.Require().Equal(base.LaneName, estimates[1].Lane)
+	})
+
+	suite.Run("unknown lane", func() {
+		_, err := suite.estimator.EstimateFees(suite.ctx, "unknown", 2*size, nil)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("invalid percentile", func() {
+		_, err := suite.estimator.EstimateFees(suite.ctx, base.LaneName, 2*size, []uint32{101})
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *EstimatorTestSuite) TestPercentiles() {
+	block := make([]sdk.Tx, 4)
+	for i := range block {
+		block[i] = suite.createTx(suite.accounts[i], int64(100*(i+1)))
+	}
+
+	suite.estimator.RecordBlock(suite.ctx, block)
+
+	estimates, err := suite.estimator.EstimateFees(suite.ctx, base.LaneName, 1000, []uint32{25, 50, 100})
+	suite.Require().NoError(err)
+	suite.Require().Len(estimates, 1)
+
+	estimate := estimates[0]
+	suite.Require().Equal(uint64(1), estimate.NumBlocks)
+	suite.Require().Len(estimate.Percentiles, 3)
+	suite.Require().Equal(uint32(25), estimate.Percentiles[0].Percentile)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))), estimate.Percentiles[0].Fee)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(200))), estimate.Percentiles[1].Fee)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(400))), estimate.Percentiles[2].Fee)
+
+	suite.Run("recording the same height replaces the block", func() {
+		suite.estimator.RecordBlock(suite.ctx, block[:1])
+
+		estimates, err := suite.estimator.EstimateFees(suite.ctx, base.LaneName, 1000, []uint32{100})
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(1), estimates[0].NumBlocks)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))), estimates[0].Percentiles[0].Fee)
+	})
+
+	suite.Run("only the most recent blocks are retained", func() {
+		suite.estimator.RecordBlock(suite.ctx.WithBlockHeight(2), block[1:2])
+		suite.estimator.RecordBlock(suite.ctx.WithBlockHeight(3), block[2:3])
+
+		estimates, err := suite.estimator.EstimateFees(suite.ctx, base.LaneName, 1000, []uint32{1})
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(2), estimates[0].NumBlocks)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(200))), estimates[0].Percentiles[0].Fee)
+	})
+
+	suite.Run("transactions are attributed to the matching lane", func() {
+		freeTx, err := testutils.CreateFreeTx(
+			suite.encodingConfig.TxConfig,
+			suite.accounts[4],
+			0,
+			1000,
+			"val1",
+			sdk.NewCoin("stake", math.NewInt(100)),
+			sdk.NewCoin("stake", math.NewInt(5)),
+		)
+		suite.Require().NoError(err)
+
+		suite.estimator.RecordBlock(suite.ctx.WithBlockHeight(4), []sdk.Tx{freeTx})
+
+		estimates, err := suite.estimator.EstimateFees(suite.ctx, free.LaneName, 1000, []uint32{50})
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(5))), estimates[0].Percentiles[0].Fee)
+	})
+}
+
+func (suite *EstimatorTestSuite) TestBundledTxsAreSkipped() {
+	tobLane := auction.NewTOBLane(
+		blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyZeroDec(),
+		},
+		auction.NewDefaultAuctionFactory(suite.encodingConfig.TxConfig.TxDecoder()),
+	)
+	mempool := blockbuster.NewMempool(log.NewNopLogger(), true, tobLane, suite.baseLane)
+	estimator := fees.NewEstimator(mempool, suite.encodingConfig.TxConfig.TxEncoder(), 2)
+
+	bidTx, bundledTxs, err := testutils.CreateAuctionTx(
+		suite.encodingConfig.TxConfig,
+		suite.accounts[0],
+		sdk.NewCoin("stake", math.NewInt(1000)),
+		0,
+		1000,
+		suite.accounts[1:3],
+	)
+	suite.Require().NoError(err)
+
+	// The bundled transactions do not pay a fee, so they would lower the fees paid in the
+	// default lane if they were recorded.
+	block := []sdk.Tx{bidTx, bundledTxs[0], bundledTxs[1], suite.createTx(suite.accounts[3], 300)}
+	estimator.RecordBlock(suite.ctx, block)
+
+	estimates, err := estimator.EstimateFees(suite.ctx, base.LaneName, 1000, []uint32{1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(300))), estimates[0].Percentiles[0].Fee)
+}
+
+func (suite *EstimatorTestSuite) TestPreFinalizeBlockHook() {
+	tx := suite.createTx(suite.accounts[0], 300)
+	txBz, err := suite.encodingConfig.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	hook := suite.estimator.PreFinalizeBlockHook(suite.encodingConfig.TxConfig.TxDecoder())
+
+	// Transactions that cannot be decoded are ignored.
+	req := &abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    [][]byte{[]byte("auction info"), txBz},
+	}
+	suite.Require().NoError(hook(suite.ctx, req))
+
+	estimates, err := suite.estimator.EstimateFees(suite.ctx, base.LaneName, 1000, []uint32{50})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), estimates[0].NumBlocks)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(300))), estimates[0].Percentiles[0].Fee)
+}
@@ -1,7 +1,10 @@
 package blockbuster
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster/metrics"
 	"github.com/skip-mev/pob/blockbuster/utils"
 )
 
@@ -15,7 +18,9 @@ func (l *LaneConstructor) PrepareLane(
 	maxTxBytes int64,
 	next PrepareLanesHandler,
 ) (BlockProposal, error) {
+	start := time.Now()
 	txs, txsToRemove, err := l.prepareLaneHandler(ctx, proposal, maxTxBytes)
+	metrics.MeasurePrepareLane(l.Name(), start)
 	if err != nil {
 		return proposal, err
 	}
@@ -29,11 +34,19 @@ func (l *LaneConstructor) PrepareLane(
 		)
 	}
 
+	metrics.SetLaneNumTxs(l.Name(), l.CountTx())
+
 	// Update the proposal with the selected transactions.
 	if err := proposal.UpdateProposal(l, txs); err != nil {
 		return proposal, err
 	}
 
+	var numBytes int64
+	for _, txBz := range txs {
+		numBytes += int64(len(txBz))
+	}
+	metrics.RecordProposalLane(l.Name(), len(txs), numBytes)
+
 	return next(ctx, proposal)
 }
 
@@ -48,8 +61,11 @@ func (l *LaneConstructor) CheckOrder(ctx sdk.Context, txs []sdk.Tx) error {
 // return the transactions that do not belong to this lane to the next lane. If the transactions
 // are invalid, we return an error.
 func (l *LaneConstructor) ProcessLane(ctx sdk.Context, txs []sdk.Tx, next ProcessLanesHandler) (sdk.Context, error) {
+	start := time.Now()
 	remainingTxs, err := l.processLaneHandler(ctx, txs)
+	metrics.MeasureProcessLane(l.Name(), start)
 	if err != nil {
+		metrics.IncrProposalRejected(l.Name(), metrics.ReasonInvalidTx)
 		return ctx, err
 	}
 
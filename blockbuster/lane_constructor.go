@@ -196,3 +196,29 @@ func (l *LaneConstructor) TxEncoder() sdk.TxEncoder {
 func (l *LaneConstructor) GetMaxBlockSpace() math.LegacyDec {
 	return l.cfg.MaxBlockSpace
 }
+
+// GetMinBlockSpace returns the amount of block space that is reserved for the lane
+// as a percentage of the total block space.
+func (l *LaneConstructor) GetMinBlockSpace() math.LegacyDec {
+	if l.cfg.MinBlockSpace.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return l.cfg.MinBlockSpace
+}
+
+// GetMaxBlockGas returns the maximum amount of block gas that the lane is allowed
+// to consume as a percentage of the block gas limit.
+func (l *LaneConstructor) GetMaxBlockGas() math.LegacyDec {
+	if l.cfg.MaxBlockGas.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return l.cfg.MaxBlockGas
+}
+
+// GetMaxTxs returns the maximum number of transactions that the lane's mempool
+// is allowed to store.
+func (l *LaneConstructor) GetMaxTxs() int {
+	return l.cfg.MaxTxs
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster/metrics"
 	"github.com/skip-mev/pob/blockbuster/utils"
 )
 
@@ -14,11 +15,15 @@ import (
 func (l *LaneConstructor) DefaultPrepareLaneHandler() PrepareLaneHandler {
 	return func(ctx sdk.Context, proposal BlockProposal, maxTxBytes int64) ([][]byte, []sdk.Tx, error) {
 		var (
-			totalSize   int64
-			txs         [][]byte
-			txsToRemove []sdk.Tx
+			totalSize     int64
+			totalGasLimit uint64
+			txs           [][]byte
+			txsToRemove   []sdk.Tx
 		)
 
+		// Get the maximum gas limit that can be included in the proposal for this lane.
+		maxGasLimit := proposal.GetMaxGasLimitForLane(l)
+
 		// Select all transactions in the mempool that are valid and not already in the
 		// partial proposal.
 		for iterator := l.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
@@ -28,6 +33,7 @@ func (l *LaneConstructor) DefaultPrepareLaneHandler() PrepareLaneHandler {
 			if err != nil {
 				l.Logger().Info("failed to get hash of tx", "err", err)
 
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonEncodingFailure)
 				txsToRemove = append(txsToRemove, tx)
 				continue
 			}
@@ -40,6 +46,7 @@ func (l *LaneConstructor) DefaultPrepareLaneHandler() PrepareLaneHandler {
 					"lane", l.Name(),
 				)
 
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonLaneMismatch)
 				txsToRemove = append(txsToRemove, tx)
 				continue
 			}
@@ -70,6 +77,21 @@ func (l *LaneConstructor) DefaultPrepareLaneHandler() PrepareLaneHandler {
 				break
 			}
 
+			// If the transaction exceeds the gas limit of the lane, we break and do not attempt to include more txs.
+			txGasLimit := utils.GetTxGasLimit(tx)
+			if txGasLimit > maxGasLimit-totalGasLimit {
+				l.Logger().Info(
+					"tx gas limit above the maximum allowed",
+					"lane", l.Name(),
+					"tx_gas_limit", txGasLimit,
+					"total_gas_limit", totalGasLimit,
+					"max_gas_limit", maxGasLimit,
+					"tx_hash", hash,
+				)
+
+				break
+			}
+
 			// Verify the transaction.
 			if ctx, err = l.AnteVerifyTx(ctx, tx, false); err != nil {
 				l.Logger().Info(
@@ -78,11 +100,13 @@ func (l *LaneConstructor) DefaultPrepareLaneHandler() PrepareLaneHandler {
 					"err", err,
 				)
 
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonAnteFailure)
 				txsToRemove = append(txsToRemove, tx)
 				continue
 			}
 
 			totalSize += txSize
+			totalGasLimit += txGasLimit
 			txs = append(txs, txBytes)
 		}
 
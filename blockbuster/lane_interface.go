@@ -22,6 +22,10 @@ type LaneMempool interface {
 
 	// Contains returns true if the transaction is contained in the mempool.
 	Contains(tx sdk.Tx) bool
+
+	// ContainsTxHash returns true if a transaction with the given hex-encoded hash
+	// is contained in the mempool.
+	ContainsTxHash(hash string) bool
 }
 
 // Lane defines an interface used for matching transactions to lanes, storing transactions,
@@ -54,12 +58,24 @@ type Lane interface {
 	// GetMaxBlockSpace returns the max block space for the lane as a relative percentage.
 	GetMaxBlockSpace() math.LegacyDec
 
+	// GetMinBlockSpace returns the block space reserved for the lane as a relative percentage.
+	GetMinBlockSpace() math.LegacyDec
+
+	// GetMaxBlockGas returns the max block gas for the lane as a relative percentage.
+	GetMaxBlockGas() math.LegacyDec
+
+	// GetMaxTxs returns the maximum number of transactions the lane's mempool can store.
+	GetMaxTxs() int
+
 	// Logger returns the lane's logger.
 	Logger() log.Logger
 
 	// Name returns the name of the lane.
 	Name() string
 
+	// TxDecoder returns the lane's transaction decoder.
+	TxDecoder() sdk.TxDecoder
+
 	// SetAnteHandler sets the lane's antehandler.
 	SetAnteHandler(antehander sdk.AnteHandler)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
@@ -15,8 +16,12 @@ type (
 	// txPriority. The mempool is a wrapper on top of the SDK's Priority Nonce mempool.
 	// It include's additional helper functions that allow users to determine if a
 	// transaction is already in the mempool and to compare the priority of two
-	// transactions.
+	// transactions. The mempool is safe for concurrent use.
 	ConstructorMempool[C comparable] struct {
+		// mtx guards the index and the txCache. CheckTx, the proposal handlers and
+		// the mempool query service may all access the mempool concurrently.
+		mtx sync.RWMutex
+
 		// index defines an index of transactions.
 		index sdkmempool.Mempool
 
@@ -96,13 +101,16 @@ func NewConstructorMempool[C comparable](txPriority TxPriority[C], txEncoder sdk
 
 // Insert inserts a transaction into the mempool.
 func (cm *ConstructorMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
 	if err := cm.index.Insert(ctx, tx); err != nil {
 		return fmt.Errorf("failed to insert tx into auction index: %w", err)
 	}
 
 	_, txHashStr, err := utils.GetTxHashStr(cm.txEncoder, tx)
 	if err != nil {
-		cm.Remove(tx)
+		cm.remove(tx)
 		return err
 	}
 
@@ -113,6 +121,14 @@ func (cm *ConstructorMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 
 // Remove removes a transaction from the mempool.
 func (cm *ConstructorMempool[C]) Remove(tx sdk.Tx) error {
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
+	return cm.remove(tx)
+}
+
+// remove removes a transaction from the mempool. The caller must hold the write lock.
+func (cm *ConstructorMempool[C]) remove(tx sdk.Tx) error {
 	if err := cm.index.Remove(tx); err != nil && !errors.Is(err, sdkmempool.ErrTxNotFound) {
 		return fmt.Errorf("failed to remove transaction from the mempool: %w", err)
 	}
@@ -127,16 +143,44 @@ func (cm *ConstructorMempool[C]) Remove(tx sdk.Tx) error {
 	return nil
 }
 
-// Select returns an iterator of all transactions in the mempool. NOTE: If you
-// remove a transaction from the mempool while iterating over the transactions,
-// the iterator will not be aware of the removal and will continue to iterate
-// over the removed transaction. Be sure to reset the iterator if you remove a transaction.
+// Select returns an iterator of all transactions in the mempool. The iterator is
+// over a snapshot of the mempool taken under the lock, so it is safe to use while
+// transactions are concurrently inserted or removed. NOTE: If you remove a transaction
+// from the mempool while iterating over the transactions, the iterator will not be
+// aware of the removal and will continue to iterate over the removed transaction.
+// Be sure to reset the iterator if you remove a transaction. Use SelectTop if only
+// the highest priority transaction is needed.
 func (cm *ConstructorMempool[C]) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
-	return cm.index.Select(ctx, txs)
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	snapshot := make([]sdk.Tx, 0, cm.index.CountTx())
+	for iterator := cm.index.Select(ctx, txs); iterator != nil; iterator = iterator.Next() {
+		snapshot = append(snapshot, iterator.Tx())
+	}
+
+	return newSnapshotIterator(snapshot)
+}
+
+// SelectTop returns the highest priority transaction in the mempool or nil if the
+// mempool is empty. Unlike Select, it does not copy the mempool.
+func (cm *ConstructorMempool[C]) SelectTop(ctx context.Context) sdk.Tx {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	iterator := cm.index.Select(ctx, nil)
+	if iterator == nil {
+		return nil
+	}
+
+	return iterator.Tx()
 }
 
 // CountTx returns the number of transactions in the mempool.
 func (cm *ConstructorMempool[C]) CountTx() int {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
 	return cm.index.CountTx()
 }
 
@@ -147,7 +191,16 @@ func (cm *ConstructorMempool[C]) Contains(tx sdk.Tx) bool {
 		return false
 	}
 
-	_, ok := cm.txCache[txHashStr]
+	return cm.ContainsTxHash(txHashStr)
+}
+
+// ContainsTxHash returns true if a transaction with the given hex-encoded hash is
+// contained in the mempool.
+func (cm *ConstructorMempool[C]) ContainsTxHash(hash string) bool {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	_, ok := cm.txCache[hash]
 	return ok
 }
 
@@ -157,3 +210,28 @@ func (cm *ConstructorMempool[C]) Compare(ctx sdk.Context, this sdk.Tx, other sdk
 	secondPriority := cm.txPriority.GetTxPriority(ctx, other)
 	return cm.txPriority.Compare(firstPriority, secondPriority)
 }
+
+// snapshotIterator iterates over a snapshot of the transactions in the mempool.
+type snapshotIterator struct {
+	txs []sdk.Tx
+}
+
+// newSnapshotIterator returns an iterator over the given transactions or nil if
+// there are none, in line with the SDK's mempool iterators.
+func newSnapshotIterator(txs []sdk.Tx) sdkmempool.Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	return &snapshotIterator{txs: txs}
+}
+
+// Next returns the next transaction in the snapshot.
+func (i *snapshotIterator) Next() sdkmempool.Iterator {
+	return newSnapshotIterator(i.txs[1:])
+}
+
+// Tx returns the current transaction.
+func (i *snapshotIterator) Tx() sdk.Tx {
+	return i.txs[0]
+}
@@ -2,44 +2,263 @@ package auction
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/metrics"
 	"github.com/skip-mev/pob/blockbuster/utils"
 	"github.com/skip-mev/pob/x/builder/types"
 )
 
-// PrepareLaneHandler will attempt to select the highest bid transaction that is valid
-// and whose bundled transactions are valid and include them in the proposal. It
-// will return no transactions if no valid bids are found. If any of the bids are invalid,
-// it will return them and will only remove the bids and not the bundled transactions.
+// PrepareLaneHandler will greedily select the highest bid transactions that are valid, whose
+// bundled transactions are valid and whose access sets do not conflict with any of the previously
+// selected bundles, and include them in the proposal. At most MaxBundlesPerBlock bundles are selected.
+// It will return no transactions if no valid bids are found. If any of the bids are invalid,
+// it will return them and will only remove the bids and not the bundled transactions. Under the
+// second price pricing rule or with sealed bids, no transactions are returned since the auction
+// must be run over the bids of the vote extensions with PrepareAuction.
 func (l *TOBLane) PrepareLaneHandler() blockbuster.PrepareLaneHandler {
 	return func(ctx sdk.Context, proposal blockbuster.BlockProposal, maxTxBytes int64) ([][]byte, []sdk.Tx, error) {
-		// Define all of the info we need to select transactions for the partial proposal.
-		var (
-			txs         [][]byte
-			txsToRemove []sdk.Tx
-		)
+		required, err := l.VoteExtensionAuctionRequired(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if required {
+			l.Logger().Info("skipping auction over the local mempool; the auction must be run over vote extensions")
+			return nil, nil, nil
+		}
+
+		txs, txsToRemove, _, err := l.prepareLane(ctx, proposal, maxTxBytes, l)
+		return txs, txsToRemove, err
+	}
+}
+
+// PrepareAuction runs the auction over the given bid transactions rather than the bids in the
+// lane's mempool. The bids are ranked and selected in the same way as in PrepareLaneHandler, so
+// all validators that run the auction over the same bids against the same state select the same
+// bundles. Invalid bids are skipped. Along with the transactions of the winning bundles, it
+// returns the hashes of the bid transactions that failed verification, which are the same for
+// all validators and can therefore be recorded against the bidders.
+func (l *TOBLane) PrepareAuction(
+	ctx sdk.Context,
+	proposal blockbuster.BlockProposal,
+	maxTxBytes int64,
+	bidTxs []sdk.Tx,
+) ([][]byte, [][]byte, error) {
+	bids := blockbuster.NewConstructorMempool[string](l.txPriority, l.TxEncoder(), 0)
+	for _, bidTx := range bidTxs {
+		if !l.Match(ctx, bidTx) {
+			continue
+		}
+
+		if err := bids.Insert(ctx, bidTx); err != nil {
+			l.Logger().Info("failed to insert bid tx into auction", "err", err)
+		}
+	}
+
+	txs, _, failedBidTxHashes, err := l.prepareLane(ctx, proposal, maxTxBytes, bids)
+	return txs, failedBidTxHashes, err
+}
+
+// prepareLane selects the winning bundles from the bids in the given mempool. It returns the
+// transactions of the winning bundles, the bid transactions that should be removed from the
+// mempool and the hashes of the bid transactions whose bundles failed verification.
+func (l *TOBLane) prepareLane(
+	ctx sdk.Context,
+	proposal blockbuster.BlockProposal,
+	maxTxBytes int64,
+	bids sdkmempool.Mempool,
+) ([][]byte, []sdk.Tx, [][]byte, error) {
+	// Define all of the info we need to select transactions for the partial proposal.
+	var (
+		totalSize         int64
+		totalGasLimit     uint64
+		txs               [][]byte
+		txsToRemove       []sdk.Tx
+		failedBidTxHashes [][]byte
+
+		// numBundles is the number of bundles that have been selected thus far.
+		numBundles int
+
+		// numBids is the number of bids that have been considered thus far.
+		numBids int
+
+		// selectedTxs tracks the transactions of all of the bundles that have been selected
+		// thus far and conflictGraph tracks the state they touch so that conflicting bundles
+		// are skipped.
+		selectedTxs   = make(map[string]struct{})
+		conflictGraph = NewConflictGraph()
+
+		// winningBids tracks the bids of all of the bundles that have been selected.
+		winningBids []sdk.Coin
+
+		// selectedBidTxs tracks the bid transactions that have been selected so that they
+		// are not considered when determining the runner-up bid.
+		selectedBidTxs = make(map[string]struct{})
+	)
+
+	maxBundles, err := l.GetMaxBundlesPerBlock(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	secondPrice, err := l.SecondPriceEnabled(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Under the second price pricing rule, an auction settlement always follows the winning
+	// bundles, so block space is reserved for it.
+	if secondPrice {
+		maxTxBytes -= maxAuctionSettlementSize
+	}
+
+	// Get the maximum gas limit that can be included in the proposal for this lane.
+	maxGasLimit := proposal.GetMaxGasLimitForLane(l)
+
+	// Attempt to select the highest bid transactions that are valid and whose
+	// bundled transactions are valid.
+	bidTxIterator := bids.Select(ctx, nil)
+selectBidTxLoop:
+	for ; bidTxIterator != nil && numBundles < maxBundles; bidTxIterator = bidTxIterator.Next() {
+		cacheCtx, write := ctx.CacheContext()
+		tmpBidTx := bidTxIterator.Tx()
+		numBids++
+
+		bidTxBz, hash, err := utils.GetTxHashStr(l.TxEncoder(), tmpBidTx)
+		if err != nil {
+			l.Logger().Info("failed to get hash of auction bid tx", "err", err)
+
+			metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonEncodingFailure)
+			txsToRemove = append(txsToRemove, tmpBidTx)
+			continue selectBidTxLoop
+		}
+
+		// if the transaction is already in the (partial) block proposal, we skip it.
+		if proposal.Contains(bidTxBz) {
+			l.Logger().Info(
+				"failed to select auction bid tx for lane; tx is already in proposal",
+				"tx_hash", hash,
+			)
+
+			continue selectBidTxLoop
+		}
+
+		bidTxSize := int64(len(bidTxBz))
+		if totalSize+bidTxSize > maxTxBytes {
+			l.Logger().Info(
+				"failed to select auction bid tx for lane; tx size is too large",
+				"tx_size", bidTxSize,
+				"total_size", totalSize,
+				"max_size", maxTxBytes,
+			)
+
+			continue selectBidTxLoop
+		}
+
+		// Build the partial proposal by selecting the bid transaction and all of
+		// its bundled transactions.
+		bidInfo, err := l.GetAuctionBidInfo(tmpBidTx)
+		if err != nil {
+			l.Logger().Info(
+				"failed to get auction bid info",
+				"tx_hash", hash,
+				"err", err,
+			)
+
+			// Some transactions in the bundle may be malformed or invalid, so we
+			// remove the bid transaction and try the next top bid.
+			metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonInvalidBid)
+			txsToRemove = append(txsToRemove, tmpBidTx)
+			continue selectBidTxLoop
+		}
 
-		// Attempt to select the highest bid transaction that is valid and whose
-		// bundled transactions are valid.
-		bidTxIterator := l.Select(ctx, nil)
-	selectBidTxLoop:
-		for ; bidTxIterator != nil; bidTxIterator = bidTxIterator.Next() {
-			cacheCtx, write := ctx.CacheContext()
-			tmpBidTx := bidTxIterator.Tx()
+		// Decrypt the bundle now that the bid is being considered for inclusion. The
+		// decrypted transactions are included in the proposal.
+		bidInfo, err = l.decryptBidInfo(ctx, bidInfo)
+		if err != nil {
+			l.Logger().Info(
+				"failed to decrypt auction bid tx",
+				"tx_hash", hash,
+				"err", err,
+			)
+
+			metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonInvalidBundle)
+			txsToRemove = append(txsToRemove, tmpBidTx)
+			bidTxHash := sha256.Sum256(bidTxBz)
+			failedBidTxHashes = append(failedBidTxHashes, bidTxHash[:])
+			continue selectBidTxLoop
+		}
+
+		// Skip bundles that conflict with a bundle that has already been selected.
+		accessSet := getBundleAccessSet(bidInfo)
+		if conflicts := conflictGraph.Conflicts(accessSet); len(conflicts) > 0 {
+			l.Logger().Info(
+				"failed to select auction bid tx for lane; bundle conflicts with a selected bundle",
+				"tx_hash", hash,
+				"num_conflicts", len(conflicts),
+			)
+
+			continue selectBidTxLoop
+		}
+
+		// Verify the bid transaction and all of its bundled transactions.
+		if err := l.VerifyTx(cacheCtx, tmpBidTx, bidInfo); err != nil {
+			l.Logger().Info(
+				"failed to verify auction bid tx",
+				"tx_hash", hash,
+				"err", err,
+			)
+
+			metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonAnteFailure)
+			txsToRemove = append(txsToRemove, tmpBidTx)
+			bidTxHash := sha256.Sum256(bidTxBz)
+			failedBidTxHashes = append(failedBidTxHashes, bidTxHash[:])
+			continue selectBidTxLoop
+		}
+
+		// store the bytes of each ref tx as sdk.Tx bytes in order to build a valid proposal
+		bundleSize := bidTxSize
+		bundleGasLimit := utils.GetTxGasLimit(tmpBidTx)
+		bundledTxBz := make([][]byte, len(bidInfo.Transactions))
+		for index, rawRefTx := range bidInfo.Transactions {
+			sdkTx, err := l.WrapBundleTransaction(rawRefTx)
+			if err != nil {
+				l.Logger().Info(
+					"failed to wrap bundled tx",
+					"tx_hash", hash,
+					"err", err,
+				)
+
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonInvalidBundle)
+				txsToRemove = append(txsToRemove, tmpBidTx)
+				bidTxHash := sha256.Sum256(bidTxBz)
+				failedBidTxHashes = append(failedBidTxHashes, bidTxHash[:])
+				continue selectBidTxLoop
+			}
 
-			bidTxBz, hash, err := utils.GetTxHashStr(l.TxEncoder(), tmpBidTx)
+			sdkTxBz, _, err := utils.GetTxHashStr(l.TxEncoder(), sdkTx)
 			if err != nil {
-				l.Logger().Info("failed to get hash of auction bid tx", "err", err)
+				l.Logger().Info(
+					"failed to get hash of bundled tx",
+					"tx_hash", hash,
+					"err", err,
+				)
 
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonInvalidBundle)
 				txsToRemove = append(txsToRemove, tmpBidTx)
+				bidTxHash := sha256.Sum256(bidTxBz)
+				failedBidTxHashes = append(failedBidTxHashes, bidTxHash[:])
 				continue selectBidTxLoop
 			}
 
-			// if the transaction is already in the (partial) block proposal, we skip it.
-			if proposal.Contains(bidTxBz) {
+			// if the transaction is already in the (partial) block proposal or in a selected
+			// bundle, we skip it.
+			if _, ok := selectedTxs[string(sdkTxBz)]; ok || proposal.Contains(sdkTxBz) {
 				l.Logger().Info(
 					"failed to select auction bid tx for lane; tx is already in proposal",
 					"tx_hash", hash,
@@ -48,189 +267,249 @@ func (l *TOBLane) PrepareLaneHandler() blockbuster.PrepareLaneHandler {
 				continue selectBidTxLoop
 			}
 
-			bidTxSize := int64(len(bidTxBz))
-			if bidTxSize <= maxTxBytes {
-				// Build the partial proposal by selecting the bid transaction and all of
-				// its bundled transactions.
-				bidInfo, err := l.GetAuctionBidInfo(tmpBidTx)
-				if err != nil {
-					l.Logger().Info(
-						"failed to get auction bid info",
-						"tx_hash", hash,
-						"err", err,
-					)
-
-					// Some transactions in the bundle may be malformed or invalid, so we
-					// remove the bid transaction and try the next top bid.
-					txsToRemove = append(txsToRemove, tmpBidTx)
-					continue selectBidTxLoop
-				}
+			bundledTxBz[index] = sdkTxBz
+			bundleSize += int64(len(sdkTxBz))
+			bundleGasLimit += utils.GetTxGasLimit(sdkTx)
+		}
 
-				// Verify the bid transaction and all of its bundled transactions.
-				if err := l.VerifyTx(cacheCtx, tmpBidTx, bidInfo); err != nil {
-					l.Logger().Info(
-						"failed to verify auction bid tx",
-						"tx_hash", hash,
-						"err", err,
-					)
+		if totalSize+bundleSize > maxTxBytes {
+			l.Logger().Info(
+				"failed to select auction bid tx for lane; bundle size is too large",
+				"tx_hash", hash,
+				"bundle_size", bundleSize,
+				"total_size", totalSize,
+				"max_size", maxTxBytes,
+			)
 
-					txsToRemove = append(txsToRemove, tmpBidTx)
-					continue selectBidTxLoop
-				}
+			continue selectBidTxLoop
+		}
 
-				// store the bytes of each ref tx as sdk.Tx bytes in order to build a valid proposal
-				bundledTxBz := make([][]byte, len(bidInfo.Transactions))
-				for index, rawRefTx := range bidInfo.Transactions {
-					sdkTx, err := l.WrapBundleTransaction(rawRefTx)
-					if err != nil {
-						l.Logger().Info(
-							"failed to wrap bundled tx",
-							"tx_hash", hash,
-							"err", err,
-						)
-
-						txsToRemove = append(txsToRemove, tmpBidTx)
-						continue selectBidTxLoop
-					}
-
-					sdkTxBz, _, err := utils.GetTxHashStr(l.TxEncoder(), sdkTx)
-					if err != nil {
-						l.Logger().Info(
-							"failed to get hash of bundled tx",
-							"tx_hash", hash,
-							"err", err,
-						)
-
-						txsToRemove = append(txsToRemove, tmpBidTx)
-						continue selectBidTxLoop
-					}
-
-					// if the transaction is already in the (partial) block proposal, we skip it.
-					if proposal.Contains(sdkTxBz) {
-						l.Logger().Info(
-							"failed to select auction bid tx for lane; tx is already in proposal",
-							"tx_hash", hash,
-						)
-
-						continue selectBidTxLoop
-					}
-
-					bundleTxBz := make([]byte, len(sdkTxBz))
-					copy(bundleTxBz, sdkTxBz)
-					bundledTxBz[index] = sdkTxBz
-				}
+		if bundleGasLimit > maxGasLimit-totalGasLimit {
+			l.Logger().Info(
+				"failed to select auction bid tx for lane; bundle gas limit is too large",
+				"tx_hash", hash,
+				"bundle_gas_limit", bundleGasLimit,
+				"total_gas_limit", totalGasLimit,
+				"max_gas_limit", maxGasLimit,
+			)
 
-				// At this point, both the bid transaction itself and all the bundled
-				// transactions are valid. So we select the bid transaction along with
-				// all the bundled transactions. We also mark these transactions as seen and
-				// update the total size selected thus far.
-				txs = append(txs, bidTxBz)
-				txs = append(txs, bundledTxBz...)
+			continue selectBidTxLoop
+		}
 
-				// Write the cache context to the original context when we know we have a
-				// valid top of block bundle.
-				write()
+		// At this point, both the bid transaction itself and all the bundled
+		// transactions are valid. So we select the bid transaction along with
+		// all the bundled transactions. We also mark these transactions as seen and
+		// update the total size selected thus far.
+		txs = append(txs, bidTxBz)
+		txs = append(txs, bundledTxBz...)
+		totalSize += bundleSize
+		totalGasLimit += bundleGasLimit
+		numBundles++
+		winningBids = append(winningBids, bidInfo.Bid)
+		selectedBidTxs[string(bidTxBz)] = struct{}{}
+
+		for _, txBz := range bundledTxBz {
+			selectedTxs[string(txBz)] = struct{}{}
+		}
 
-				break selectBidTxLoop
-			}
+		conflictGraph.AddBundle(accessSet)
 
-			l.Logger().Info(
-				"failed to select auction bid tx for lane; tx size is too large",
-				"tx_size", bidTxSize,
-				"max_size", maxTxBytes,
-			)
+		// Write the cache context to the original context when we know we have a
+		// valid top of block bundle so that subsequent bundles are verified against
+		// the updated state.
+		write()
+	}
+
+	// When the second price pricing rule is used, the runner-up bid determines the price
+	// paid by the winners, so an auction settlement always follows the winning bundles.
+	if secondPrice && numBundles > 0 {
+		settlementBz, err := l.getAuctionSettlement(ctx, bids, selectedBidTxs)
+		if err != nil {
+			return nil, nil, nil, err
 		}
 
-		return txs, txsToRemove, nil
+		txs = append(txs, settlementBz)
 	}
+
+	metrics.RecordAuction(l.Name(), numBids, winningBids)
+
+	return txs, txsToRemove, failedBidTxHashes, nil
 }
 
 // ProcessLaneHandler will ensure that block proposals that include transactions from
-// the top-of-block auction lane are valid.
+// the top-of-block auction lane are valid. Each bid transaction and its bundled
+// transactions are verified in the order they are included in the proposal. Under the
+// second price pricing rule or with sealed bids, bid transactions are only accepted once the
+// auction is run over vote extensions. Under the second price pricing rule, the winning bundles
+// must be followed by an auction settlement.
 func (l *TOBLane) ProcessLaneHandler() blockbuster.ProcessLaneHandler {
 	return func(ctx sdk.Context, txs []sdk.Tx) ([]sdk.Tx, error) {
-		if len(txs) == 0 {
-			return txs, nil
+		secondPrice, err := l.SecondPriceEnabled(ctx)
+		if err != nil {
+			return nil, err
 		}
 
-		bidTx := txs[0]
-		if !l.Match(ctx, bidTx) {
-			return txs, nil
+		voteExtensionAuctionRequired, err := l.VoteExtensionAuctionRequired(ctx)
+		if err != nil {
+			return nil, err
 		}
 
-		bidInfo, err := l.GetAuctionBidInfo(bidTx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get bid info for lane %s: %w", l.Name(), err)
+		winningBidTxs := make([]sdk.Tx, 0)
+
+		for len(txs) > 0 {
+			bidTx := txs[0]
+
+			if settlementTx, ok := bidTx.(*SettlementTx); ok {
+				if err := l.verifyAuctionSettlement(ctx, settlementTx, winningBidTxs); err != nil {
+					return nil, fmt.Errorf("invalid auction settlement: %w", err)
+				}
+
+				return txs[1:], nil
+			}
+
+			if !l.Match(ctx, bidTx) {
+				break
+			}
+
+			if voteExtensionAuctionRequired && !voteExtensionsEnabled(ctx) {
+				return nil, fmt.Errorf("bid txs require the vote extension auction")
+			}
+
+			bidInfo, err := l.GetAuctionBidInfo(bidTx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get bid info for lane %s: %w", l.Name(), err)
+			}
+
+			bidInfo, err = l.decryptBidInfo(ctx, bidInfo)
+			if err != nil {
+				return nil, fmt.Errorf("invalid bid tx: %w", err)
+			}
+
+			if err := l.VerifyTx(ctx, bidTx, bidInfo); err != nil {
+				return nil, fmt.Errorf("invalid bid tx: %w", err)
+			}
+
+			winningBidTxs = append(winningBidTxs, bidTx)
+			txs = txs[len(bidInfo.Transactions)+1:]
 		}
 
-		if err := l.VerifyTx(ctx, bidTx, bidInfo); err != nil {
-			return nil, fmt.Errorf("invalid bid tx: %w", err)
+		if secondPrice && len(winningBidTxs) > 0 {
+			return nil, fmt.Errorf("winning bundles must be followed by an auction settlement")
 		}
 
-		return txs[len(bidInfo.Transactions)+1:], nil
+		return txs, nil
 	}
 }
 
-// CheckOrderHandler ensures that if a bid transaction is present in a proposal,
-//   - it is the first transaction in the partial proposal
-//   - all of the bundled transactions are included after the bid transaction in the order
-//     they were included in the bid transaction.
-//   - there are no other bid transactions in the proposal
+// CheckOrderHandler ensures that if bid transactions are present in a proposal,
+//   - they are the first transactions in the partial proposal
+//   - all of the bundled transactions are included after their bid transaction in the order
+//     they were included in the bid transaction. Encrypted bundled transactions are included
+//     as their decrypted transactions.
+//   - there are at most MaxBundlesPerBlock bid transactions in the proposal
+//   - the bid transactions are ordered by the lane's bid ranking and their bundles do not
+//     conflict
 //   - transactions from other lanes are not interleaved with transactions from the bid
-//     transaction.
+//     transactions.
+//   - an auction settlement is only included directly after the winning bundles.
 func (l *TOBLane) CheckOrderHandler() blockbuster.CheckOrderHandler {
 	return func(ctx sdk.Context, txs []sdk.Tx) error {
-		if len(txs) == 0 {
-			return nil
+		maxBundles, err := l.GetMaxBundlesPerBlock(ctx)
+		if err != nil {
+			return err
 		}
 
-		bidTx := txs[0]
+		var (
+			index      int
+			numBundles int
+			prevBidTx  sdk.Tx
 
-		// If there is a bid transaction, it must be the first transaction in the block proposal.
-		if !l.Match(ctx, bidTx) {
-			for _, tx := range txs[1:] {
-				if l.Match(ctx, tx) {
-					return fmt.Errorf("misplaced bid transactions in lane %s", l.Name())
-				}
-			}
+			// conflictGraph tracks the state touched by all of the bundles thus far so
+			// that conflicting bundles can be detected.
+			conflictGraph = NewConflictGraph()
+		)
 
-			return nil
-		}
+		// If there are bid transactions, they must be the first transactions in the block proposal.
+		for index < len(txs) && l.Match(ctx, txs[index]) {
+			bidTx := txs[index]
 
-		bidInfo, err := l.GetAuctionBidInfo(bidTx)
-		if err != nil {
-			return fmt.Errorf("failed to get bid info for lane %s: %w", l.Name(), err)
-		}
+			numBundles++
+			if numBundles > maxBundles {
+				return fmt.Errorf(
+					"too many bid transactions in lane %s; expected at most %d",
+					l.Name(),
+					maxBundles,
+				)
+			}
 
-		if len(txs) < len(bidInfo.Transactions)+1 {
-			return fmt.Errorf(
-				"invalid number of transactions in lane %s; expected at least %d, got %d",
-				l.Name(),
-				len(bidInfo.Transactions)+1,
-				len(txs),
-			)
-		}
+			// Bids must be included in order of their priority as determined by the lane's
+			// bid ranking, i.e. the same order in which the mempool selects them.
+			if prevBidTx != nil && l.Compare(ctx, prevBidTx, bidTx) == -1 {
+				return fmt.Errorf("bid transactions in lane %s are not ordered by bid", l.Name())
+			}
 
-		// Ensure that the order of transactions in the bundle is preserved.
-		for i, bundleTx := range txs[1 : len(bidInfo.Transactions)+1] {
-			if l.Match(ctx, bundleTx) {
-				return fmt.Errorf("multiple bid transactions in lane %s", l.Name())
+			bidInfo, err := l.GetAuctionBidInfo(bidTx)
+			if err != nil {
+				return fmt.Errorf("failed to get bid info for lane %s: %w", l.Name(), err)
 			}
 
-			txBz, err := l.TxEncoder()(bundleTx)
+			// Encrypted bundles are included in the proposal as their decrypted transactions.
+			bidInfo, err = l.decryptBidInfo(ctx, bidInfo)
 			if err != nil {
-				return fmt.Errorf("failed to encode bundled tx in lane %s: %w", l.Name(), err)
+				return fmt.Errorf("invalid bid tx in lane %s: %w", l.Name(), err)
 			}
 
-			if !bytes.Equal(txBz, bidInfo.Transactions[i]) {
-				return fmt.Errorf("invalid order of transactions in lane %s", l.Name())
+			if len(txs)-index < len(bidInfo.Transactions)+1 {
+				return fmt.Errorf(
+					"invalid number of transactions in lane %s; expected at least %d, got %d",
+					l.Name(),
+					len(bidInfo.Transactions)+1,
+					len(txs)-index,
+				)
+			}
+
+			accessSet := getBundleAccessSet(bidInfo)
+			if len(conflictGraph.Conflicts(accessSet)) > 0 {
+				return fmt.Errorf("conflicting bundles in lane %s", l.Name())
+			}
+
+			conflictGraph.AddBundle(accessSet)
+
+			// Ensure that the order of transactions in the bundle is preserved.
+			for i, bundleTx := range txs[index+1 : index+len(bidInfo.Transactions)+1] {
+				if l.Match(ctx, bundleTx) {
+					return fmt.Errorf("bid transaction included in a bundle in lane %s", l.Name())
+				}
+
+				txBz, err := l.TxEncoder()(bundleTx)
+				if err != nil {
+					return fmt.Errorf("failed to encode bundled tx in lane %s: %w", l.Name(), err)
+				}
+
+				if !bytes.Equal(txBz, bidInfo.Transactions[i]) {
+					return fmt.Errorf("invalid order of transactions in lane %s", l.Name())
+				}
+			}
+
+			prevBidTx = bidTx
+			index += len(bidInfo.Transactions) + 1
+		}
+
+		// An auction settlement may only follow the winning bundles.
+		if index < len(txs) && numBundles > 0 {
+			if _, ok := txs[index].(*SettlementTx); ok {
+				index++
 			}
 		}
 
-		// Ensure that there are no more bid transactions in the block proposal.
-		for _, tx := range txs[len(bidInfo.Transactions)+1:] {
+		// Ensure that there are no more bid transactions or auction settlements in the block proposal.
+		for _, tx := range txs[index:] {
 			if l.Match(ctx, tx) {
-				return fmt.Errorf("multiple bid transactions in lane %s", l.Name())
+				return fmt.Errorf("misplaced bid transactions in lane %s", l.Name())
+			}
+
+			if _, ok := tx.(*SettlementTx); ok {
+				return fmt.Errorf("misplaced auction settlement in lane %s", l.Name())
 			}
 		}
 
@@ -240,7 +519,7 @@ func (l *TOBLane) CheckOrderHandler() blockbuster.CheckOrderHandler {
 
 // VerifyTx will verify that the bid transaction and all of its bundled
 // transactions are valid. It will return an error if any of the transactions
-// are invalid.
+// are invalid. The bundled transactions must have been decrypted.
 func (l *TOBLane) VerifyTx(ctx sdk.Context, bidTx sdk.Tx, bidInfo *types.BidInfo) (err error) {
 	if bidInfo == nil {
 		return fmt.Errorf("bid info is nil")
@@ -258,6 +537,10 @@ func (l *TOBLane) VerifyTx(ctx sdk.Context, bidTx sdk.Tx, bidInfo *types.BidInfo
 			return fmt.Errorf("invalid bid tx; failed to decode bundled tx: %w", err)
 		}
 
+		if _, ok := bundledTx.(*EncryptedBundleTx); ok {
+			return fmt.Errorf("invalid bid tx; bundled tx is encrypted")
+		}
+
 		if ctx, err = l.AnteVerifyTx(ctx, bundledTx, false); err != nil {
 			return fmt.Errorf("invalid bid tx; failed to execute bundled transaction: %w", err)
 		}
@@ -265,3 +548,78 @@ func (l *TOBLane) VerifyTx(ctx sdk.Context, bidTx sdk.Tx, bidInfo *types.BidInfo
 
 	return nil
 }
+
+// getAuctionSettlement returns the encoded auction settlement of the highest bid that was not
+// selected and is valid given the state after the winning bundles. The settlement does not
+// include a runner-up bid if there is no such bid.
+func (l *TOBLane) getAuctionSettlement(ctx sdk.Context, bids sdkmempool.Mempool, selectedBidTxs map[string]struct{}) ([]byte, error) {
+	for bidTxIterator := bids.Select(ctx, nil); bidTxIterator != nil; bidTxIterator = bidTxIterator.Next() {
+		bidTx := bidTxIterator.Tx()
+
+		bidTxBz, err := l.TxEncoder()(bidTx)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := selectedBidTxs[string(bidTxBz)]; ok {
+			continue
+		}
+
+		bidInfo, err := l.GetAuctionBidInfo(bidTx)
+		if err != nil || bidInfo == nil {
+			continue
+		}
+
+		bidInfo, err = l.decryptBidInfo(ctx, bidInfo)
+		if err != nil {
+			continue
+		}
+
+		// The runner-up bid is never executed, so any state changes are discarded.
+		cacheCtx, _ := ctx.CacheContext()
+		if err := l.VerifyTx(cacheCtx, bidTx, bidInfo); err != nil {
+			continue
+		}
+
+		hash := sha256.Sum256(bidTxBz)
+		return types.EncodeAuctionSettlement(types.AuctionSettlement{
+			RunnerUpBid:       bidInfo.Bid,
+			RunnerUpBidTxHash: hash[:],
+		})
+	}
+
+	return types.EncodeAuctionSettlement(types.AuctionSettlement{})
+}
+
+// verifyAuctionSettlement verifies that the auction settlement follows the winning bundles and
+// that its runner-up bid did not win the auction. The runner-up bid is one of the bids of the
+// auction info, so whether it is the highest valid bid that did not win the auction is verified
+// when the auction info is verified.
+func (l *TOBLane) verifyAuctionSettlement(ctx sdk.Context, settlementTx *SettlementTx, winningBidTxs []sdk.Tx) error {
+	secondPrice, err := l.SecondPriceEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !secondPrice {
+		return fmt.Errorf("second price pricing rule is not enabled")
+	}
+
+	if len(winningBidTxs) == 0 {
+		return fmt.Errorf("auction settlement must follow the winning bundles")
+	}
+
+	for _, winningBidTx := range winningBidTxs {
+		winningBidTxBz, err := l.TxEncoder()(winningBidTx)
+		if err != nil {
+			return err
+		}
+
+		hash := sha256.Sum256(winningBidTxBz)
+		if bytes.Equal(hash[:], settlementTx.RunnerUpBidTxHash) {
+			return fmt.Errorf("runner-up bid tx is a winning bid tx")
+		}
+	}
+
+	return nil
+}
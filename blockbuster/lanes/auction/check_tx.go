@@ -33,6 +33,10 @@ type (
 		// anteHandler is utilized to verify the bid transaction against the latest
 		// committed state.
 		anteHandler sdk.AnteHandler
+
+		// msgRouter is utilized to execute the messages of transactions when bundles are
+		// simulated.
+		msgRouter MsgRouter
 	}
 
 	// CheckTx is baseapp's CheckTx method that checks the validity of a
@@ -217,6 +221,12 @@ func (handler *CheckTxHandler) ValidateBidTx(ctx sdk.Context, bidTx sdk.Tx, bidI
 			return gasInfo, fmt.Errorf("invalid bid tx; failed to decode bundled tx: %w", err)
 		}
 
+		// Encrypted bundled transactions can only be verified once they are decrypted by the
+		// proposal handlers.
+		if _, ok := bundledTx.(*EncryptedBundleTx); ok {
+			continue
+		}
+
 		// bid txs cannot be included in bundled txs
 		bidInfo, _ := handler.tobLane.GetAuctionBidInfo(bundledTx)
 		if bidInfo != nil {
@@ -0,0 +1,69 @@
+package auction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// ConflictGraph tracks the access sets of the bundles of a block so that the bundles that
+// conflict with them can be detected. Two bundles conflict if their access sets conflict,
+// i.e. one of them writes state that the other reads or writes. Only bundles that do not
+// conflict with the bundles in the graph are added to it.
+type ConflictGraph struct {
+	accessSets []*types.AccessSet
+}
+
+// NewConflictGraph returns an empty conflict graph.
+func NewConflictGraph() *ConflictGraph {
+	return &ConflictGraph{}
+}
+
+// Len returns the number of bundles in the graph.
+func (g *ConflictGraph) Len() int {
+	return len(g.accessSets)
+}
+
+// Conflicts returns the indices of the bundles in the graph that conflict with a bundle with
+// the given access set.
+func (g *ConflictGraph) Conflicts(accessSet *types.AccessSet) []int {
+	var conflicts []int
+	for index, other := range g.accessSets {
+		if accessSet.ConflictsWith(other) {
+			conflicts = append(conflicts, index)
+		}
+	}
+
+	return conflicts
+}
+
+// AddBundle adds a bundle with the given access set to the graph. It returns the index of the
+// bundle in the graph.
+func (g *ConflictGraph) AddBundle(accessSet *types.AccessSet) int {
+	g.accessSets = append(g.accessSets, accessSet)
+
+	return len(g.accessSets) - 1
+}
+
+// getBundleAccessSet returns the access set of the bundle. Factories that do not build access
+// sets are assumed to write the accounts of the bidder and of all signers of the bundle.
+func getBundleAccessSet(bidInfo *types.BidInfo) *types.AccessSet {
+	if bidInfo.AccessSet != nil {
+		return bidInfo.AccessSet
+	}
+
+	accessSet := types.NewAccessSet()
+	accessSet.AddWrite(types.AccountAccessKey(bidInfo.Bidder))
+
+	for _, txSigners := range bidInfo.Signers {
+		for signer := range txSigners {
+			signerAddress, err := sdk.AccAddressFromBech32(signer)
+			if err != nil {
+				continue
+			}
+
+			accessSet.AddWrite(types.AccountAccessKey(signerAddress))
+		}
+	}
+
+	return accessSet
+}
@@ -0,0 +1,49 @@
+package auction_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster/lanes/auction"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConflictGraph(t *testing.T) {
+	newAccessSet := func(reads, writes []string) *buildertypes.AccessSet {
+		accessSet := buildertypes.NewAccessSet()
+		for _, key := range reads {
+			accessSet.AddRead(key)
+		}
+
+		for _, key := range writes {
+			accessSet.AddWrite(key)
+		}
+
+		return accessSet
+	}
+
+	pool := buildertypes.PoolAccessKey(1)
+	denom := buildertypes.DenomAccessKey("stake")
+	account := buildertypes.AccountAccessKey(sdk.AccAddress([]byte("account")))
+
+	graph := auction.NewConflictGraph()
+
+	// Bundles that only read the same state do not conflict.
+	require.Equal(t, 0, graph.AddBundle(newAccessSet([]string{pool}, []string{account})))
+	require.Equal(t, 1, graph.AddBundle(newAccessSet([]string{pool}, []string{denom})))
+
+	// A bundle that writes state read by another bundle conflicts with it.
+	writer := newAccessSet(nil, []string{pool})
+	require.Equal(t, []int{0, 1}, graph.Conflicts(writer))
+
+	// A bundle that reads state written by another bundle conflicts with it.
+	reader := newAccessSet([]string{denom}, nil)
+	require.Equal(t, []int{1}, graph.Conflicts(reader))
+
+	require.Equal(t, 2, graph.AddBundle(newAccessSet(nil, []string{buildertypes.PoolAccessKey(2)})))
+	require.Equal(t, 3, graph.Len())
+
+	// Bundles that touch unrelated state do not conflict.
+	require.Empty(t, graph.Conflicts(newAccessSet(nil, []string{buildertypes.PoolAccessKey(3)})))
+}
@@ -0,0 +1,89 @@
+package auction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+var _ sdk.Tx = (*EncryptedBundleTx)(nil)
+
+type (
+	// Decrypter defines the interface that is used to decrypt encrypted bundled transactions.
+	// It is only invoked by the proposal handlers once a bid has been selected, so the contents
+	// of encrypted bundles are never revealed while they are in the mempool. Implementations
+	// are typically backed by a threshold decryption scheme in which the validators jointly
+	// hold the decryption key.
+	Decrypter interface {
+		// Decrypt returns the plaintext bundled transaction of the given ciphertext. The
+		// plaintext must be the raw bytes of an sdk.Tx.
+		Decrypt(ctx sdk.Context, ciphertext []byte) ([]byte, error)
+	}
+
+	// BundleValidator defines the interface that is used to validate the bundles of encrypted
+	// bids once they are decrypted. The signers of encrypted bundled transactions are not known
+	// when the bid is validated by the ante handler, so front-running protection can only be
+	// enforced after decryption. This is typically the x/builder keeper.
+	BundleValidator interface {
+		ValidateDecryptedBundle(ctx sdk.Context, bidInfo *types.BidInfo) error
+	}
+
+	// EncryptedBundleTx wraps an encrypted bundled transaction. It contains no messages and
+	// cannot be verified or executed until it is decrypted.
+	EncryptedBundleTx struct {
+		// GasLimit is the gas limit declared by the bidder. The decrypted transaction cannot
+		// want more gas than declared.
+		GasLimit uint64
+
+		Ciphertext []byte
+	}
+)
+
+// NewEncryptedBundleTx returns the encrypted bundled transaction encoded in the given bytes.
+func NewEncryptedBundleTx(bz []byte) (*EncryptedBundleTx, error) {
+	gasLimit, ciphertext, err := types.DecodeEncryptedBundleTx(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptedBundleTx{GasLimit: gasLimit, Ciphertext: ciphertext}, nil
+}
+
+// GetMsgs implements sdk.Tx. The messages of encrypted bundled transactions are not known.
+func (tx *EncryptedBundleTx) GetMsgs() []sdk.Msg {
+	return nil
+}
+
+// GetMsgsV2 implements sdk.Tx. The messages of encrypted bundled transactions are not known.
+func (tx *EncryptedBundleTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+// SetBundleValidator sets the validator of the bundles of encrypted bids.
+func (l *TOBLane) SetBundleValidator(bundleValidator BundleValidator) {
+	l.bundleValidator = bundleValidator
+}
+
+// decryptBidInfo returns the bid info with its bundled transactions decrypted. Bids without
+// encrypted bundled transactions are returned as is. The decrypted bundle is validated by the
+// bundle validator if one is set.
+func (l *TOBLane) decryptBidInfo(ctx sdk.Context, bidInfo *types.BidInfo) (*types.BidInfo, error) {
+	if !types.IsEncryptedBundle(bidInfo.Transactions) {
+		return bidInfo, nil
+	}
+
+	decryptedBidInfo, err := l.DecryptAuctionBidInfo(ctx, bidInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt bundle: %w", err)
+	}
+
+	if l.bundleValidator != nil {
+		if err := l.bundleValidator.ValidateDecryptedBundle(ctx, decryptedBidInfo); err != nil {
+			return nil, fmt.Errorf("invalid decrypted bundle: %w", err)
+		}
+	}
+
+	return decryptedBidInfo, nil
+}
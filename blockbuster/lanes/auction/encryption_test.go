@@ -0,0 +1,189 @@
+package auction_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster/lanes/auction"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/prices"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *IntegrationTestSuite) TestKeyShareDecrypter() {
+	shares, err := auction.NewKeyShares([]byte("seed"), 2, 3)
+	suite.Require().NoError(err)
+	suite.Require().Len(shares, 3)
+
+	txBz, err := testutils.CreateRandomTxBz(suite.encCfg.TxConfig, suite.accounts[0], 0, 1, 0)
+	suite.Require().NoError(err)
+
+	decrypter, err := auction.NewKeyShareDecrypter(shares[:2], 2)
+	suite.Require().NoError(err)
+
+	encryptedTx, err := decrypter.Encrypt(txBz, 100)
+	suite.Require().NoError(err)
+	suite.Require().True(buildertypes.IsEncryptedBundleTx(encryptedTx))
+
+	gasLimit, ciphertext, err := buildertypes.DecodeEncryptedBundleTx(encryptedTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(100), gasLimit)
+
+	suite.Run("any threshold of shares decrypts the bundled tx", func() {
+		for _, subset := range [][]auction.KeyShare{
+			{shares[0], shares[1]},
+			{shares[1], shares[2]},
+			{shares[2], shares[0]},
+		} {
+			decrypter, err := auction.NewKeyShareDecrypter(subset, 2)
+			suite.Require().NoError(err)
+
+			plaintext, err := decrypter.Decrypt(suite.ctx, ciphertext)
+			suite.Require().NoError(err)
+			suite.Require().Equal(txBz, plaintext)
+		}
+	})
+
+	suite.Run("encryption uses a random nonce", func() {
+		otherEncryptedTx, err := decrypter.Encrypt(txBz, 100)
+		suite.Require().NoError(err)
+		suite.Require().NotEqual(encryptedTx, otherEncryptedTx)
+	})
+
+	suite.Run("shares are deterministic", func() {
+		sameShares, err := auction.NewKeyShares([]byte("seed"), 2, 3)
+		suite.Require().NoError(err)
+		suite.Require().Equal(shares, sameShares)
+	})
+
+	suite.Run("fewer shares than the threshold", func() {
+		_, err := auction.NewKeyShareDecrypter(shares[:1], 2)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("duplicate shares", func() {
+		_, err := auction.NewKeyShareDecrypter([]auction.KeyShare{shares[0], shares[0]}, 2)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("shares of a different key", func() {
+		otherShares, err := auction.NewKeyShares([]byte("other seed"), 2, 3)
+		suite.Require().NoError(err)
+
+		decrypter, err := auction.NewKeyShareDecrypter(otherShares, 2)
+		suite.Require().NoError(err)
+
+		_, err = decrypter.Decrypt(suite.ctx, ciphertext)
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *IntegrationTestSuite) TestEncryptedBundleFactory() {
+	shares, err := auction.NewKeyShares([]byte("seed"), 2, 3)
+	suite.Require().NoError(err)
+
+	decrypter, err := auction.NewKeyShareDecrypter(shares, 2)
+	suite.Require().NoError(err)
+
+	factory := auction.NewDefaultAuctionFactoryWithDecrypter(
+		suite.encCfg.TxConfig.TxDecoder(),
+		auction.DefaultAccessSetHook,
+		decrypter,
+	)
+
+	bidder := suite.accounts[0]
+	bundledTxBz, err := testutils.CreateRandomTxBz(suite.encCfg.TxConfig, suite.accounts[1], 0, 1, 0)
+	suite.Require().NoError(err)
+
+	createBidTx := func(transactions [][]byte) sdk.Tx {
+		msg := buildertypes.NewMsgAuctionBid(bidder.Address, sdk.NewInt64Coin("stake", 100), transactions)
+		tx, err := testutils.CreateTx(suite.encCfg.TxConfig, bidder, 0, 1, []sdk.Msg{msg})
+		suite.Require().NoError(err)
+
+		return tx
+	}
+
+	encrypt := func(tx []byte, gasLimit uint64) []byte {
+		encryptedTx, err := decrypter.Encrypt(tx, gasLimit)
+		suite.Require().NoError(err)
+
+		return encryptedTx
+	}
+
+	bidTx := createBidTx([][]byte{encrypt(bundledTxBz, 0)})
+
+	suite.Run("encrypted bundled txs are opaque until decrypted", func() {
+		bidInfo, err := factory.GetAuctionBidInfo(bidTx)
+		suite.Require().NoError(err)
+		suite.Require().Nil(bidInfo.Signers)
+		suite.Require().NotContains(bidInfo.AccessSet.Writes, buildertypes.AccountAccessKey(suite.accounts[1].Address))
+
+		wrappedTx, err := factory.WrapBundleTransaction(bidInfo.Transactions[0])
+		suite.Require().NoError(err)
+		suite.Require().IsType(&auction.EncryptedBundleTx{}, wrappedTx)
+	})
+
+	suite.Run("decrypted bid info includes the plaintext bundle", func() {
+		bidInfo, err := factory.GetAuctionBidInfo(bidTx)
+		suite.Require().NoError(err)
+
+		decryptedBidInfo, err := factory.DecryptAuctionBidInfo(suite.ctx, bidInfo)
+		suite.Require().NoError(err)
+		suite.Require().Equal([][]byte{bundledTxBz}, decryptedBidInfo.Transactions)
+		suite.Require().Equal([]map[string]struct{}{{suite.accounts[1].Address.String(): {}}}, decryptedBidInfo.Signers)
+		suite.Require().Contains(decryptedBidInfo.AccessSet.Writes, buildertypes.AccountAccessKey(suite.accounts[1].Address))
+	})
+
+	suite.Run("bundled bid txs cannot be encrypted", func() {
+		innerBidTxBz, err := suite.encCfg.TxConfig.TxEncoder()(createBidTx([][]byte{bundledTxBz}))
+		suite.Require().NoError(err)
+
+		bidInfo, err := factory.GetAuctionBidInfo(createBidTx([][]byte{encrypt(innerBidTxBz, 0)}))
+		suite.Require().NoError(err)
+
+		_, err = factory.DecryptAuctionBidInfo(suite.ctx, bidInfo)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("decrypted bundled txs cannot want more gas than declared", func() {
+		bundledTx, err := testutils.CreateRandomTx(suite.encCfg.TxConfig, suite.accounts[1], 0, 1, 0)
+		suite.Require().NoError(err)
+
+		txBuilder, err := suite.encCfg.TxConfig.WrapTxBuilder(bundledTx)
+		suite.Require().NoError(err)
+		txBuilder.SetGasLimit(1000)
+
+		txBz, err := suite.encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		suite.Require().NoError(err)
+
+		bidInfo, err := factory.GetAuctionBidInfo(createBidTx([][]byte{encrypt(txBz, 999)}))
+		suite.Require().NoError(err)
+
+		_, err = factory.DecryptAuctionBidInfo(suite.ctx, bidInfo)
+		suite.Require().Error(err)
+
+		bidInfo, err = factory.GetAuctionBidInfo(createBidTx([][]byte{encrypt(txBz, 1000)}))
+		suite.Require().NoError(err)
+
+		_, err = factory.DecryptAuctionBidInfo(suite.ctx, bidInfo)
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("encrypted bundled txs are ranked by their declared gas limit", func() {
+		txPriority := auction.BidPerGasTxPriority(factory, prices.NewDefaultPriceConverter())
+
+		lowGasBid := createBidTx([][]byte{encrypt(bundledTxBz, 1000)})
+		highGasBid := createBidTx([][]byte{encrypt(bundledTxBz, 1_000_000)})
+
+		suite.Require().Equal(1, txPriority.Compare(
+			txPriority.GetTxPriority(suite.ctx, lowGasBid),
+			txPriority.GetTxPriority(suite.ctx, highGasBid),
+		))
+	})
+
+	suite.Run("encrypted bundled txs are rejected without a decrypter", func() {
+		_, err := suite.config.GetAuctionBidInfo(bidTx)
+		suite.Require().Error(err)
+
+		_, err = suite.config.WrapBundleTransaction(encrypt(bundledTxBz, 0))
+		suite.Require().Error(err)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/utils"
 	"github.com/skip-mev/pob/x/builder/types"
 )
 
@@ -17,9 +18,15 @@ type (
 		// WrapBundleTransaction defines a function that wraps a bundle transaction into a sdk.Tx. Since
 		// this is a potentially expensive operation, we allow each application chain to define how
 		// they want to wrap the transaction such that it is only called when necessary (i.e. when the
-		// transaction is being considered in the proposal handlers).
+		// transaction is being considered in the proposal handlers). Encrypted bundled transactions
+		// are wrapped into an EncryptedBundleTx if the factory supports them.
 		WrapBundleTransaction(tx []byte) (sdk.Tx, error)
 
+		// DecryptAuctionBidInfo defines a function that returns the bid info with all of its encrypted
+		// bundled transactions decrypted. It is only called by the proposal handlers once a bid has
+		// been selected.
+		DecryptAuctionBidInfo(ctx sdk.Context, bidInfo *types.BidInfo) (*types.BidInfo, error)
+
 		// GetAuctionBidInfo defines a function that returns the bid info from an auction transaction.
 		GetAuctionBidInfo(tx sdk.Tx) (*types.BidInfo, error)
 
@@ -29,9 +36,17 @@ type (
 
 	// DefaultAuctionFactory defines a default implmentation for the auction factory interface for processing auction transactions.
 	DefaultAuctionFactory struct {
-		txDecoder sdk.TxDecoder
+		txDecoder     sdk.TxDecoder
+		accessSetHook AccessSetHook
+		decrypter     Decrypter
 	}
 
+	// AccessSetHook defines a function that adds the state that is read or written by a
+	// bundled transaction to the access set of its bundle. Chains can use it to declare the
+	// state touched by their messages, e.g. the denoms or the pools that a swap touches, so
+	// that bundles touching the same state are not included in the same block.
+	AccessSetHook func(tx sdk.Tx, accessSet *types.AccessSet) error
+
 	// TxWithTimeoutHeight is used to extract timeouts from sdk.Tx transactions. In the case where,
 	// timeouts are explicitly set on the sdk.Tx, we can use this interface to extract the timeout.
 	TxWithTimeoutHeight interface {
@@ -45,35 +60,176 @@ var _ Factory = (*DefaultAuctionFactory)(nil)
 
 // NewDefaultAuctionFactory returns a default auction factory interface implementation.
 func NewDefaultAuctionFactory(txDecoder sdk.TxDecoder) Factory {
+	return NewDefaultAuctionFactoryWithAccessSetHook(txDecoder, DefaultAccessSetHook)
+}
+
+// NewDefaultAuctionFactoryWithAccessSetHook returns a default auction factory interface
+// implementation that uses the given hook to build the access sets of bundles.
+func NewDefaultAuctionFactoryWithAccessSetHook(txDecoder sdk.TxDecoder, accessSetHook AccessSetHook) Factory {
+	return NewDefaultAuctionFactoryWithDecrypter(txDecoder, accessSetHook, nil)
+}
+
+// NewDefaultAuctionFactoryWithDecrypter returns a default auction factory interface implementation
+// that accepts bids with encrypted bundled transactions and decrypts them using the given
+// decrypter. Bids with encrypted bundled transactions are rejected if the decrypter is nil.
+func NewDefaultAuctionFactoryWithDecrypter(
+	txDecoder sdk.TxDecoder,
+	accessSetHook AccessSetHook,
+	decrypter Decrypter,
+) Factory {
 	return &DefaultAuctionFactory{
-		txDecoder: txDecoder,
+		txDecoder:     txDecoder,
+		accessSetHook: accessSetHook,
+		decrypter:     decrypter,
 	}
 }
 
+// DefaultAccessSetHook marks the accounts of all signers of the bundled transaction as
+// written.
+func DefaultAccessSetHook(tx sdk.Tx, accessSet *types.AccessSet) error {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return fmt.Errorf("transaction is not valid")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+
+	for _, signer := range signers {
+		accessSet.AddWrite(types.AccountAccessKey(signer))
+	}
+
+	return nil
+}
+
 // WrapBundleTransaction defines a default function that wraps a transaction
 // that is included in the bundle into a sdk.Tx. In the default case, the transaction
 // that is included in the bundle will be the raw bytes of an sdk.Tx so we can just
-// decode it.
+// decode it. Encrypted bundled transactions are wrapped as is if the factory has a decrypter.
 func (config *DefaultAuctionFactory) WrapBundleTransaction(tx []byte) (sdk.Tx, error) {
+	if types.IsEncryptedBundleTx(tx) {
+		if config.decrypter == nil {
+			return nil, fmt.Errorf("encrypted bundled transactions are not supported")
+		}
+
+		return NewEncryptedBundleTx(tx)
+	}
+
 	return config.txDecoder(tx)
 }
 
+// DecryptAuctionBidInfo defines a default function that decrypts the encrypted bundled transactions
+// of the bid using the factory's decrypter. The signers and the access set of the bundle are
+// recomputed from the decrypted transactions. Decrypted transactions cannot be bid transactions.
+func (config *DefaultAuctionFactory) DecryptAuctionBidInfo(ctx sdk.Context, bidInfo *types.BidInfo) (*types.BidInfo, error) {
+	if !types.IsEncryptedBundle(bidInfo.Transactions) {
+		return bidInfo, nil
+	}
+
+	if config.decrypter == nil {
+		return nil, fmt.Errorf("encrypted bundled transactions are not supported")
+	}
+
+	transactions := make([][]byte, len(bidInfo.Transactions))
+	for index, tx := range bidInfo.Transactions {
+		if !types.IsEncryptedBundleTx(tx) {
+			transactions[index] = tx
+			continue
+		}
+
+		gasLimit, ciphertext, err := types.DecodeEncryptedBundleTx(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		plaintext, err := config.decrypter.Decrypt(ctx, ciphertext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt bundled tx %d: %w", index, err)
+		}
+
+		if types.IsEncryptedBundleTx(plaintext) {
+			return nil, fmt.Errorf("decrypted bundled tx %d is encrypted", index)
+		}
+
+		sdkTx, err := config.txDecoder(plaintext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode decrypted bundled tx %d: %w", index, err)
+		}
+
+		if bundledBidInfo, _ := config.GetAuctionBidInfo(sdkTx); bundledBidInfo != nil {
+			return nil, fmt.Errorf("decrypted bundled tx %d cannot be a bid tx", index)
+		}
+
+		// Bids are ranked by the declared gas limit before they are decrypted, so the decrypted
+		// transaction cannot want more gas than declared.
+		if txGasLimit := utils.GetTxGasLimit(sdkTx); txGasLimit > gasLimit {
+			return nil, fmt.Errorf(
+				"decrypted bundled tx %d wants more gas than declared; declared %d, got %d",
+				index,
+				gasLimit,
+				txGasLimit,
+			)
+		}
+
+		transactions[index] = plaintext
+	}
+
+	signers, accessSet, err := config.getBundleState(bidInfo.Bidder, transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	decryptedBidInfo := *bidInfo
+	decryptedBidInfo.Transactions = transactions
+	decryptedBidInfo.Signers = signers
+	decryptedBidInfo.AccessSet = accessSet
+
+	return &decryptedBidInfo, nil
+}
+
 // GetAuctionBidInfo defines a default function that returns the auction bid info from
 // an auction transaction. In the default case, the auction bid info is stored in the
-// MsgAuctionBid message.
+// MsgAuctionBid message or, if sealed bids are used, in the MsgRevealBid message.
 func (config *DefaultAuctionFactory) GetAuctionBidInfo(tx sdk.Tx) (*types.BidInfo, error) {
 	msg, err := GetMsgAuctionBidFromTx(tx)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg == nil {
+	if msg != nil {
+		return config.getBidInfo(tx, msg.Bidder, msg.Bid, msg.Transactions, nil)
+	}
+
+	revealMsg, err := GetMsgRevealBidFromTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if revealMsg == nil {
 		return nil, nil
 	}
 
-	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	commitment, err := revealMsg.GetCommitment()
 	if err != nil {
-		return nil, fmt.Errorf("invalid bidder address (%s): %w", msg.Bidder, err)
+		return nil, err
+	}
+
+	return config.getBidInfo(tx, revealMsg.Bidder, revealMsg.Bid, revealMsg.Transactions, commitment)
+}
+
+// getBidInfo returns the auction bid info of an auction transaction with the given bid.
+func (config *DefaultAuctionFactory) getBidInfo(
+	tx sdk.Tx,
+	bidderAddress string,
+	bid sdk.Coin,
+	transactions [][]byte,
+	commitment []byte,
+) (*types.BidInfo, error) {
+	bidder, err := sdk.AccAddressFromBech32(bidderAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bidder address (%s): %w", bidderAddress, err)
 	}
 
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
@@ -81,17 +237,19 @@ func (config *DefaultAuctionFactory) GetAuctionBidInfo(tx sdk.Tx) (*types.BidInf
 		return nil, fmt.Errorf("cannot extract timeout; transaction does not implement TxWithTimeoutHeight")
 	}
 
-	signers, err := config.getBundleSigners(msg.Transactions)
+	signers, accessSet, err := config.getBundleState(bidder, transactions)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.BidInfo{
-		Bid:          msg.Bid,
+		Bid:          bid,
 		Bidder:       bidder,
-		Transactions: msg.Transactions,
+		Transactions: transactions,
 		Timeout:      timeoutTx.GetTimeoutHeight(),
 		Signers:      signers,
+		Commitment:   commitment,
+		AccessSet:    accessSet,
 	}, nil
 }
 
@@ -102,28 +260,48 @@ func (config *DefaultAuctionFactory) MatchHandler() blockbuster.MatchHandler {
 	}
 }
 
-// getBundleSigners defines a default function that returns the signers of all transactions in
-// a bundle. In the default case, each bundle transaction will be an sdk.Tx and the
-// signers are the signers of each sdk.Msg in the transaction.
-func (config *DefaultAuctionFactory) getBundleSigners(bundle [][]byte) ([]map[string]struct{}, error) {
+// getBundleState defines a default function that returns the signers of all transactions in
+// a bundle and the access set of the bundle. In the default case, each bundle transaction will
+// be an sdk.Tx and the signers are the signers of each sdk.Msg in the transaction. The access
+// set includes the bidder's account, which pays the bid, and the state added by the access set
+// hook for each bundled transaction. The signers and the state touched by encrypted bundled
+// transactions are not known until they are decrypted, so no signers are returned for bundles
+// with encrypted transactions and their access sets only include the plaintext transactions.
+func (config *DefaultAuctionFactory) getBundleState(
+	bidder sdk.AccAddress,
+	bundle [][]byte,
+) ([]map[string]struct{}, *types.AccessSet, error) {
 	bundleSigners := make([]map[string]struct{}, 0)
 
+	accessSet := types.NewAccessSet()
+	accessSet.AddWrite(types.AccountAccessKey(bidder))
+
+	encrypted := false
 	for _, tx := range bundle {
+		if types.IsEncryptedBundleTx(tx) {
+			if config.decrypter == nil {
+				return nil, nil, fmt.Errorf("encrypted bundled transactions are not supported")
+			}
+
+			encrypted = true
+			continue
+		}
+
 		sdkTx, err := config.txDecoder(tx)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		sigTx, ok := sdkTx.(signing.SigVerifiableTx)
 		if !ok {
-			return nil, fmt.Errorf("transaction is not valid")
+			return nil, nil, fmt.Errorf("transaction is not valid")
 		}
 
 		txSigners := make(map[string]struct{})
 
 		signers, err := sigTx.GetSigners()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		for _, signer := range signers {
@@ -131,7 +309,17 @@ func (config *DefaultAuctionFactory) getBundleSigners(bundle [][]byte) ([]map[st
 		}
 
 		bundleSigners = append(bundleSigners, txSigners)
+
+		if config.accessSetHook != nil {
+			if err := config.accessSetHook(sdkTx, accessSet); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if encrypted {
+		return nil, accessSet, nil
 	}
 
-	return bundleSigners, nil
+	return bundleSigners, accessSet, nil
 }
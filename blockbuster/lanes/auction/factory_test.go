@@ -3,9 +3,13 @@ package auction_test
 import (
 	"crypto/rand"
 
+	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/auction"
 	testutils "github.com/skip-mev/pob/testutils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
 )
 
 func (suite *IntegrationTestSuite) TestIsAuctionTx() {
@@ -56,6 +60,41 @@ func (suite *IntegrationTestSuite) TestIsAuctionTx() {
 			true,
 			false,
 		},
+		{
+			"valid reveal bid tx",
+			func() sdk.Tx {
+				msgAuctionBid, err := testutils.CreateMsgAuctionBid(suite.encCfg.TxConfig, suite.accounts[0], sdk.NewInt64Coin("stake", 100), 0, 2)
+				suite.Require().NoError(err)
+
+				msgs := []sdk.Msg{
+					buildertypes.NewMsgRevealBid(suite.accounts[0].Address, msgAuctionBid.Bid, msgAuctionBid.Transactions, []byte("0123456789abcdef")),
+				}
+
+				tx, err := testutils.CreateTx(suite.encCfg.TxConfig, suite.accounts[0], 0, 0, msgs)
+				suite.Require().NoError(err)
+				return tx
+			},
+			true,
+			false,
+		},
+		{
+			"tx with MsgAuctionBid and MsgRevealBid messages",
+			func() sdk.Tx {
+				msgAuctionBid, err := testutils.CreateMsgAuctionBid(suite.encCfg.TxConfig, suite.accounts[0], sdk.NewInt64Coin("stake", 100), 0, 2)
+				suite.Require().NoError(err)
+
+				msgs := []sdk.Msg{
+					msgAuctionBid,
+					buildertypes.NewMsgRevealBid(suite.accounts[0].Address, msgAuctionBid.Bid, msgAuctionBid.Transactions, []byte("0123456789abcdef")),
+				}
+
+				tx, err := testutils.CreateTx(suite.encCfg.TxConfig, suite.accounts[0], 0, 0, msgs)
+				suite.Require().NoError(err)
+				return tx
+			},
+			false,
+			true,
+		},
 		{
 			"tx with multiple MsgAuctionBid messages",
 			func() sdk.Tx {
@@ -524,3 +563,72 @@ func (suite *IntegrationTestSuite) TestGetTimeout() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestGetBundleAccessSet() {
+	pool := buildertypes.PoolAccessKey(1)
+
+	// The hook declares that every bundled transaction swaps in the same pool.
+	factory := auction.NewDefaultAuctionFactoryWithAccessSetHook(
+		suite.encCfg.TxConfig.TxDecoder(),
+		func(tx sdk.Tx, accessSet *buildertypes.AccessSet) error {
+			if err := auction.DefaultAccessSetHook(tx, accessSet); err != nil {
+				return err
+			}
+
+			accessSet.AddWrite(pool)
+			return nil
+		},
+	)
+
+	tx, err := testutils.CreateAuctionTxWithSigners(
+		suite.encCfg.TxConfig,
+		suite.accounts[0],
+		sdk.NewCoin("stake", math.NewInt(100)),
+		1,
+		0,
+		suite.accounts[1:3],
+	)
+	suite.Require().NoError(err)
+
+	bidInfo, err := factory.GetAuctionBidInfo(tx)
+	suite.Require().NoError(err)
+
+	expected := buildertypes.NewAccessSet()
+	expected.AddWrite(buildertypes.AccountAccessKey(suite.accounts[0].Address))
+	expected.AddWrite(buildertypes.AccountAccessKey(suite.accounts[1].Address))
+	expected.AddWrite(buildertypes.AccountAccessKey(suite.accounts[2].Address))
+	expected.AddWrite(pool)
+	suite.Require().Equal(expected, bidInfo.AccessSet)
+
+	// Bundles with distinct signers that touch the same pool conflict.
+	lane := auction.NewTOBLane(
+		blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encCfg.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encCfg.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyZeroDec(),
+		},
+		factory,
+	)
+	lane.SetBundleLimiter(maxBundlesPerBlock(2))
+
+	proposal := make([]sdk.Tx, 0)
+	for i := 3; i < 5; i++ {
+		bidTx, bundledTxs, err := testutils.CreateAuctionTx(
+			suite.encCfg.TxConfig,
+			suite.accounts[i],
+			sdk.NewCoin("stake", math.NewInt(100)),
+			0,
+			0,
+			suite.accounts[i+2:i+3],
+		)
+		suite.Require().NoError(err)
+
+		proposal = append(proposal, bidTx)
+		for _, bundledTx := range bundledTxs {
+			proposal = append(proposal, bundledTx)
+		}
+	}
+
+	suite.Require().ErrorContains(lane.CheckOrderHandler()(suite.ctx, proposal), "conflicting bundles")
+}
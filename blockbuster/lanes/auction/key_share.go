@@ -0,0 +1,189 @@
+package auction
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+var _ Decrypter = (*KeyShareDecrypter)(nil)
+
+type (
+	// KeyShare is a share of the key that encrypts bundled transactions. Each validator holds a
+	// single share and any threshold number of shares can be combined to recover the key.
+	KeyShare struct {
+		// Index is the non-zero x-coordinate of the share.
+		Index byte
+
+		// Value is the share of each byte of the key.
+		Value []byte
+	}
+
+	// KeyShareDecrypter is a local stand-in for threshold decryption that is intended for
+	// testing. The key is deterministically derived from a seed and split into shares using Shamir's
+	// secret sharing over GF(2^8), and bundled transactions are encrypted with AES-GCM. Unlike a
+	// real threshold scheme, the key is symmetric, so anyone that can decrypt can also encrypt.
+	KeyShareDecrypter struct {
+		aead cipher.AEAD
+	}
+)
+
+// NewKeyShares derives the key from the given seed and splits it into numShares shares, any
+// threshold of which recover the key. The same seed always results in the same shares.
+func NewKeyShares(seed []byte, threshold, numShares int) ([]KeyShare, error) {
+	if threshold < 1 || threshold > numShares || numShares > 255 {
+		return nil, fmt.Errorf("invalid threshold (%d) or number of shares (%d)", threshold, numShares)
+	}
+
+	key := sha256.Sum256(seed)
+
+	// coefficients[i] holds the (i+1)-th degree coefficient of the polynomial of each byte of
+	// the key. The constant coefficients are the bytes of the key.
+	coefficients := make([][sha256.Size]byte, threshold-1)
+	for i := range coefficients {
+		coefficients[i] = sha256.Sum256(append(key[:], byte(i+1)))
+	}
+
+	shares := make([]KeyShare, numShares)
+	for i := range shares {
+		x := byte(i + 1)
+
+		value := make([]byte, len(key))
+		for j := range key {
+			// Evaluate the polynomial at x using Horner's method.
+			var y byte
+			for k := len(coefficients) - 1; k >= 0; k-- {
+				y = gfMul(y^coefficients[k][j], x)
+			}
+
+			value[j] = y ^ key[j]
+		}
+
+		shares[i] = KeyShare{Index: x, Value: value}
+	}
+
+	return shares, nil
+}
+
+// NewKeyShareDecrypter returns a decrypter with the key recovered from the given shares. At
+// least threshold shares must be given; only the first threshold shares are used.
+func NewKeyShareDecrypter(shares []KeyShare, threshold int) (*KeyShareDecrypter, error) {
+	if threshold < 1 || len(shares) < threshold {
+		return nil, fmt.Errorf("insufficient key shares; expected at least %d, got %d", threshold, len(shares))
+	}
+
+	key, err := combineKeyShares(shares[:threshold])
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyShareDecrypter{aead: aead}, nil
+}
+
+// Encrypt encrypts the given bundled transaction and returns it encoded such that it can be
+// included in a bid alongside the declared gas limit of the transaction. A random nonce is
+// used for each encryption, so encrypting the same transaction twice yields different
+// ciphertexts and does not reveal that the bundled transactions are equal.
+func (d *KeyShareDecrypter) Encrypt(tx []byte, gasLimit uint64) ([]byte, error) {
+	nonce := make([]byte, d.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return types.EncodeEncryptedBundleTx(gasLimit, d.aead.Seal(nonce, nonce, tx, nil)), nil
+}
+
+// Decrypt implements Decrypter.
+func (d *KeyShareDecrypter) Decrypt(_ sdk.Context, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < d.aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:d.aead.NonceSize()], ciphertext[d.aead.NonceSize():]
+	return d.aead.Open(nil, nonce, sealed, nil)
+}
+
+// combineKeyShares recovers the key from the given shares using Lagrange interpolation at zero.
+func combineKeyShares(shares []KeyShare) ([]byte, error) {
+	seen := make(map[byte]struct{}, len(shares))
+	for _, share := range shares {
+		if share.Index == 0 {
+			return nil, fmt.Errorf("invalid key share index 0")
+		}
+
+		if _, ok := seen[share.Index]; ok {
+			return nil, fmt.Errorf("duplicate key share index %d", share.Index)
+		}
+
+		if len(share.Value) != sha256.Size {
+			return nil, fmt.Errorf("invalid key share length; expected %d, got %d", sha256.Size, len(share.Value))
+		}
+
+		seen[share.Index] = struct{}{}
+	}
+
+	key := make([]byte, sha256.Size)
+	for i, share := range shares {
+		// The Lagrange basis polynomial of the share evaluated at zero. Subtraction in GF(2^8)
+		// is XOR.
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+
+			basis = gfMul(basis, gfMul(other.Index, gfInv(other.Index^share.Index)))
+		}
+
+		for k := range key {
+			key[k] ^= gfMul(share.Value[k], basis)
+		}
+	}
+
+	return key, nil
+}
+
+// gfMul multiplies two elements of GF(2^8) with the AES reducing polynomial.
+func gfMul(a, b byte) byte {
+	var product byte
+	for b > 0 {
+		if b&1 == 1 {
+			product ^= a
+		}
+
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+
+		b >>= 1
+	}
+
+	return product
+}
+
+// gfInv returns the multiplicative inverse of a non-zero element of GF(2^8), i.e. a^254.
+func gfInv(a byte) byte {
+	inverse := byte(1)
+	for i := 0; i < 254; i++ {
+		inverse = gfMul(inverse, a)
+	}
+
+	return inverse
+}
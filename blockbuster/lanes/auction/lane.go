@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/x/builder/prices"
+	"github.com/skip-mev/pob/x/builder/types"
 )
 
 const (
@@ -27,6 +29,26 @@ type (
 		blockbuster.Lane
 		Factory
 		GetTopAuctionTx(ctx context.Context) sdk.Tx
+		GetBidRanking() blockbuster.BidRanking
+		TxEncoder() sdk.TxEncoder
+	}
+
+	// BundleLimiter defines the interface that is used to determine the maximum number of
+	// winning bundles that can be included in a block. This is typically the x/builder keeper.
+	BundleLimiter interface {
+		GetMaxBundlesPerBlock(ctx sdk.Context) (uint32, error)
+	}
+
+	// PricingRuleProvider defines the interface that is used to determine the pricing rule of
+	// the auction. This is typically the x/builder keeper.
+	PricingRuleProvider interface {
+		GetPricingRule(ctx sdk.Context) (types.PricingRule, error)
+	}
+
+	// SealedBidsProvider defines the interface that is used to determine whether bids must be
+	// committed to before they are revealed. This is typically the x/builder keeper.
+	SealedBidsProvider interface {
+		SealedBidsEnabled(ctx sdk.Context) (bool, error)
 	}
 
 	TOBLane struct {
@@ -37,26 +59,84 @@ type (
 		// if a transaction is a bid transaction and how to extract relevant
 		// information from the transaction (bid, timeout, bidder, etc.).
 		Factory
+
+		// bundleLimiter determines the maximum number of winning bundles that can be
+		// included in a block. If it is not set, a single bundle is included per block.
+		bundleLimiter BundleLimiter
+
+		// pricingRuleProvider determines the pricing rule of the auction. If it is not set,
+		// the first price pricing rule is used.
+		pricingRuleProvider PricingRuleProvider
+
+		// sealedBidsProvider determines whether sealed bids are enabled. If it is not set,
+		// sealed bids are treated as disabled.
+		sealedBidsProvider SealedBidsProvider
+
+		// bundleValidator validates the bundles of encrypted bids once they are decrypted. If
+		// it is not set, decrypted bundles are only verified by the ante handler.
+		bundleValidator BundleValidator
+
+		// txPriority ranks the bids of the lane. It is utilized to run auctions over bids
+		// that are not in the lane's mempool.
+		txPriority blockbuster.TxPriority[string]
+
+		// bidRanking is the bid ranking implemented by txPriority.
+		bidRanking blockbuster.BidRanking
+
+		// mempool is the lane's mempool. It is utilized to look up the top bid without
+		// selecting all bids.
+		mempool *blockbuster.ConstructorMempool[string]
 	}
 )
 
-// NewTOBLane returns a new TOB lane.
+// NewTOBLane returns a new TOB lane. Bids are ranked according to the bid ranking of the
+// lane config without any price conversion.
 func NewTOBLane(
 	cfg blockbuster.LaneConfig,
 	factory Factory,
 ) *TOBLane {
+	return NewTOBLaneWithPriceConverter(cfg, factory, prices.NewDefaultPriceConverter())
+}
+
+// NewTOBLaneWithPriceConverter returns a new TOB lane that ranks bids using the given
+// price converter. This allows bids denominated in different denoms to be compared. Chains
+// that value block space more than the absolute bid can set the bid ranking of the lane
+// config to rank bids by their value per unit of gas or per byte of the whole bundle. The
+// same ranking is used to order bids in the mempool, to validate bids in CheckTx and to
+// verify the order of bids in block proposals. It panics if the bid ranking is unknown.
+func NewTOBLaneWithPriceConverter(
+	cfg blockbuster.LaneConfig,
+	factory Factory,
+	converter types.PriceConverter,
+) *TOBLane {
+	txPriority, err := NewBidTxPriority(cfg.BidRanking, factory, converter, cfg.TxEncoder)
+	if err != nil {
+		panic(err)
+	}
+
+	// Auction settlements are included in the lane's portion of block proposals when the
+	// second price pricing rule is used, so the lane must be able to decode them.
+	if cfg.TxDecoder != nil {
+		cfg.TxDecoder = NewSettlementTxDecoder(cfg.TxDecoder)
+	}
+
+	mempool := blockbuster.NewConstructorMempool[string](
+		txPriority,
+		cfg.TxEncoder,
+		cfg.MaxTxs,
+	)
+
 	lane := &TOBLane{
 		LaneConstructor: blockbuster.NewLaneConstructor(
 			cfg,
 			LaneName,
-			blockbuster.NewConstructorMempool[string](
-				TxPriority(factory),
-				cfg.TxEncoder,
-				cfg.MaxTxs,
-			),
+			mempool,
 			factory.MatchHandler(),
 		),
-		Factory: factory,
+		Factory:    factory,
+		txPriority: txPriority,
+		bidRanking: cfg.BidRanking,
+		mempool:    mempool,
 	}
 
 	// Set the prepare lane handler to the TOB one
@@ -70,3 +150,76 @@ func NewTOBLane(
 
 	return lane
 }
+
+// GetBidRanking returns the bid ranking of the lane.
+func (l *TOBLane) GetBidRanking() blockbuster.BidRanking {
+	return l.bidRanking
+}
+
+// SetBundleLimiter sets the bundle limiter that determines the maximum number of winning
+// bundles that can be included in a block.
+func (l *TOBLane) SetBundleLimiter(bundleLimiter BundleLimiter) {
+	l.bundleLimiter = bundleLimiter
+}
+
+// GetMaxBundlesPerBlock returns the maximum number of winning bundles that can be included
+// in a block. A single bundle is included per block if no bundle limiter is set.
+func (l *TOBLane) GetMaxBundlesPerBlock(ctx sdk.Context) (int, error) {
+	if l.bundleLimiter == nil {
+		return 1, nil
+	}
+
+	maxBundles, err := l.bundleLimiter.GetMaxBundlesPerBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Chains that have not yet set the parameter include a single bundle per block.
+	if maxBundles == 0 {
+		return 1, nil
+	}
+
+	return int(maxBundles), nil
+}
+
+// SetPricingRuleProvider sets the provider that determines the pricing rule of the auction.
+func (l *TOBLane) SetPricingRuleProvider(pricingRuleProvider PricingRuleProvider) {
+	l.pricingRuleProvider = pricingRuleProvider
+}
+
+// SecondPriceEnabled returns true if the second price pricing rule is used, in which case
+// the lane includes an auction settlement with the runner-up bid in its partial proposal.
+func (l *TOBLane) SecondPriceEnabled(ctx sdk.Context) (bool, error) {
+	if l.pricingRuleProvider == nil {
+		return false, nil
+	}
+
+	pricingRule, err := l.pricingRuleProvider.GetPricingRule(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return pricingRule == types.PricingRule_PRICING_RULE_SECOND_PRICE, nil
+}
+
+// SetSealedBidsProvider sets the provider that determines whether sealed bids are enabled.
+func (l *TOBLane) SetSealedBidsProvider(sealedBidsProvider SealedBidsProvider) {
+	l.sealedBidsProvider = sealedBidsProvider
+}
+
+// VoteExtensionAuctionRequired returns true if the auction can only be run over the bids of the
+// vote extensions. This is the case under the second price pricing rule, where the runner-up
+// bid must be verifiable by all validators, and with sealed bids, where the losing reveals must
+// be included in the auction info so that their commitments are not slashed.
+func (l *TOBLane) VoteExtensionAuctionRequired(ctx sdk.Context) (bool, error) {
+	secondPrice, err := l.SecondPriceEnabled(ctx)
+	if err != nil || secondPrice {
+		return secondPrice, err
+	}
+
+	if l.sealedBidsProvider == nil {
+		return false, nil
+	}
+
+	return l.sealedBidsProvider.SealedBidsEnabled(ctx)
+}
@@ -2,14 +2,97 @@ package auction
 
 import (
 	"context"
+	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/utils"
+	"github.com/skip-mev/pob/x/builder/types"
 )
 
 // TxPriority returns a TxPriority over auction bid transactions only. It
-// is to be used in the auction index only.
-func TxPriority(config Factory) blockbuster.TxPriority[string] {
+// is to be used in the auction index only. Bids are ranked by their value
+// as determined by the price converter so that bids denominated in different
+// denoms can be compared.
+func TxPriority(config Factory, converter types.PriceConverter) blockbuster.TxPriority[string] {
+	return bidTxPriority(config, converter, nil)
+}
+
+// BidPerGasTxPriority returns a TxPriority over auction bid transactions only that ranks
+// bids by their value per unit of gas wanted by the whole bundle, i.e. the bid transaction
+// and all of its bundled transactions. Bundles that do not want any gas have the minimum
+// priority. Encrypted bundled transactions are charged the gas limit declared next to their
+// ciphertext.
+func BidPerGasTxPriority(config Factory, converter types.PriceConverter) blockbuster.TxPriority[string] {
+	return bidTxPriority(config, converter, func(tx sdk.Tx, bidInfo *types.BidInfo) (uint64, error) {
+		gasLimit := utils.GetTxGasLimit(tx)
+		for _, bundledTxBz := range bidInfo.Transactions {
+			bundledTx, err := config.WrapBundleTransaction(bundledTxBz)
+			if err != nil {
+				return 0, err
+			}
+
+			if encryptedTx, ok := bundledTx.(*EncryptedBundleTx); ok {
+				gasLimit += encryptedTx.GasLimit
+				continue
+			}
+
+			gasLimit += utils.GetTxGasLimit(bundledTx)
+		}
+
+		return gasLimit, nil
+	})
+}
+
+// BidPerByteTxPriority returns a TxPriority over auction bid transactions only that ranks
+// bids by their value per byte of the whole bundle, i.e. the bid transaction and all of its
+// bundled transactions.
+func BidPerByteTxPriority(config Factory, converter types.PriceConverter, txEncoder sdk.TxEncoder) blockbuster.TxPriority[string] {
+	return bidTxPriority(config, converter, func(tx sdk.Tx, bidInfo *types.BidInfo) (uint64, error) {
+		txBz, err := txEncoder(tx)
+		if err != nil {
+			return 0, err
+		}
+
+		size := uint64(len(txBz))
+		for _, bundledTxBz := range bidInfo.Transactions {
+			size += uint64(len(bundledTxBz))
+		}
+
+		return size, nil
+	})
+}
+
+// NewBidTxPriority returns the TxPriority that implements the given bid ranking.
+func NewBidTxPriority(
+	ranking blockbuster.BidRanking,
+	config Factory,
+	converter types.PriceConverter,
+	txEncoder sdk.TxEncoder,
+) (blockbuster.TxPriority[string], error) {
+	switch ranking {
+	case blockbuster.BidRankingTotal:
+		return TxPriority(config, converter), nil
+
+	case blockbuster.BidRankingPerGas:
+		return BidPerGasTxPriority(config, converter), nil
+
+	case blockbuster.BidRankingPerByte:
+		return BidPerByteTxPriority(config, converter, txEncoder), nil
+
+	default:
+		return blockbuster.TxPriority[string]{}, fmt.Errorf("unknown bid ranking %d", ranking)
+	}
+}
+
+// bidTxPriority returns a TxPriority that ranks bids by their value divided by the size
+// of the bundle. If size is nil, bids are ranked by their value alone.
+func bidTxPriority(
+	config Factory,
+	converter types.PriceConverter,
+	size func(tx sdk.Tx, bidInfo *types.BidInfo) (uint64, error),
+) blockbuster.TxPriority[string] {
 	return blockbuster.TxPriority[string]{
 		GetTxPriority: func(goCtx context.Context, tx sdk.Tx) string {
 			bidInfo, err := config.GetAuctionBidInfo(tx)
@@ -17,28 +100,42 @@ func TxPriority(config Factory) blockbuster.TxPriority[string] {
 				panic(err)
 			}
 
-			return bidInfo.Bid.String()
+			value, err := converter.ConvertBid(goCtx, bidInfo.Bid)
+			if err != nil {
+				return ""
+			}
+
+			if size == nil {
+				return value.String()
+			}
+
+			bundleSize, err := size(tx, bidInfo)
+			if err != nil || bundleSize == 0 {
+				return ""
+			}
+
+			return value.QuoInt(math.NewIntFromUint64(bundleSize)).String()
 		},
 		Compare: func(a, b string) int {
-			aCoins, _ := sdk.ParseCoinsNormalized(a)
-			bCoins, _ := sdk.ParseCoinsNormalized(b)
+			aValue, aErr := math.LegacyNewDecFromStr(a)
+			bValue, bErr := math.LegacyNewDecFromStr(b)
 
 			switch {
-			case aCoins == nil && bCoins == nil:
+			case aErr != nil && bErr != nil:
 				return 0
 
-			case aCoins == nil:
+			case aErr != nil:
 				return -1
 
-			case bCoins == nil:
+			case bErr != nil:
 				return 1
 
 			default:
 				switch {
-				case aCoins.IsAllGT(bCoins):
+				case aValue.GT(bValue):
 					return 1
 
-				case aCoins.IsAllLT(bCoins):
+				case aValue.LT(bValue):
 					return -1
 
 				default:
@@ -53,10 +150,5 @@ func TxPriority(config Factory) blockbuster.TxPriority[string] {
 // GetTopAuctionTx returns the highest bidding transaction in the auction mempool.
 // This is primarily a helper function for the x/builder module.
 func (l *TOBLane) GetTopAuctionTx(ctx context.Context) sdk.Tx {
-	iterator := l.Select(ctx, nil)
-	if iterator == nil {
-		return nil
-	}
-
-	return iterator.Tx()
+	return l.mempool.SelectTop(ctx)
 }
@@ -0,0 +1,172 @@
+package auction_test
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/auction"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/prices"
+)
+
+// maxBundlesPerBlock is a bundle limiter that allows a fixed number of bundles per block.
+type maxBundlesPerBlock uint32
+
+func (m maxBundlesPerBlock) GetMaxBundlesPerBlock(_ sdk.Context) (uint32, error) {
+	return uint32(m), nil
+}
+
+func (suite *IntegrationTestSuite) TestTxPriority() {
+	converter := prices.NewStaticPriceConverter(map[string]math.LegacyDec{
+		"stake": math.LegacyOneDec(),
+		"usdc":  math.LegacyNewDec(3),
+	})
+	txPriority := auction.TxPriority(suite.config, converter)
+
+	stakeBid, err := testutils.CreateAuctionTxWithSigners(suite.encCfg.TxConfig, suite.accounts[0], sdk.NewInt64Coin("stake", 200), 0, 100, nil)
+	suite.Require().NoError(err)
+
+	usdcBid, err := testutils.CreateAuctionTxWithSigners(suite.encCfg.TxConfig, suite.accounts[1], sdk.NewInt64Coin("usdc", 100), 0, 100, nil)
+	suite.Require().NoError(err)
+
+	unknownBid, err := testutils.CreateAuctionTxWithSigners(suite.encCfg.TxConfig, suite.accounts[2], sdk.NewInt64Coin("atom", 1000), 0, 100, nil)
+	suite.Require().NoError(err)
+
+	suite.Run("bids are converted into a common unit", func() {
+		suite.Require().Equal(math.LegacyNewDec(200).String(), txPriority.GetTxPriority(suite.ctx, stakeBid))
+		suite.Require().Equal(math.LegacyNewDec(300).String(), txPriority.GetTxPriority(suite.ctx, usdcBid))
+	})
+
+	suite.Run("bids without a price have the minimum priority", func() {
+		suite.Require().Equal(txPriority.MinValue, txPriority.GetTxPriority(suite.ctx, unknownBid))
+	})
+
+	suite.Run("bids in different denoms can be compared", func() {
+		stakePriority := txPriority.GetTxPriority(suite.ctx, stakeBid)
+		usdcPriority := txPriority.GetTxPriority(suite.ctx, usdcBid)
+		unknownPriority := txPriority.GetTxPriority(suite.ctx, unknownBid)
+
+		suite.Require().Equal(1, txPriority.Compare(usdcPriority, stakePriority))
+		suite.Require().Equal(-1, txPriority.Compare(stakePriority, usdcPriority))
+		suite.Require().Equal(0, txPriority.Compare(stakePriority, stakePriority))
+		suite.Require().Equal(-1, txPriority.Compare(unknownPriority, stakePriority))
+	})
+
+	suite.Run("the lane selects the highest valued bid", func() {
+		lane := auction.NewTOBLaneWithPriceConverter(
+			blockbuster.LaneConfig{
+				Logger:        log.NewNopLogger(),
+				TxEncoder:     suite.encCfg.TxConfig.TxEncoder(),
+				TxDecoder:     suite.encCfg.TxConfig.TxDecoder(),
+				MaxBlockSpace: math.LegacyZeroDec(),
+			},
+			suite.config,
+			converter,
+		)
+
+		suite.Require().NoError(lane.Insert(suite.ctx, stakeBid))
+		suite.Require().NoError(lane.Insert(suite.ctx, usdcBid))
+
+		suite.Require().Equal(usdcBid, lane.GetTopAuctionTx(suite.ctx))
+	})
+}
+
+func (suite *IntegrationTestSuite) TestBidRanking() {
+	converter := prices.NewDefaultPriceConverter()
+
+	// createBidTx creates a bid transaction without bundled transactions that wants the
+	// given amount of gas.
+	createBidTx := func(account testutils.Account, bid int64, gasLimit uint64) sdk.Tx {
+		tx, err := testutils.CreateAuctionTxWithSigners(suite.encCfg.TxConfig, account, sdk.NewInt64Coin("stake", bid), 0, 100, nil)
+		suite.Require().NoError(err)
+
+		txBuilder, err := suite.encCfg.TxConfig.WrapTxBuilder(tx)
+		suite.Require().NoError(err)
+		txBuilder.SetGasLimit(gasLimit)
+
+		return txBuilder.GetTx()
+	}
+
+	newLane := func(ranking blockbuster.BidRanking) *auction.TOBLane {
+		lane := auction.NewTOBLaneWithPriceConverter(
+			blockbuster.LaneConfig{
+				Logger:        log.NewNopLogger(),
+				TxEncoder:     suite.encCfg.TxConfig.TxEncoder(),
+				TxDecoder:     suite.encCfg.TxConfig.TxDecoder(),
+				MaxBlockSpace: math.LegacyZeroDec(),
+				BidRanking:    ranking,
+			},
+			suite.config,
+			converter,
+		)
+		lane.SetBundleLimiter(maxBundlesPerBlock(2))
+
+		return lane
+	}
+
+	// The large bundle bids more in total but much less per unit of gas.
+	largeBid := createBidTx(suite.accounts[0], 300, 1_000_000)
+	smallBid := createBidTx(suite.accounts[1], 200, 10_000)
+
+	suite.Run("bids are ranked by their value per unit of gas", func() {
+		txPriority := auction.BidPerGasTxPriority(suite.config, converter)
+
+		suite.Require().Equal(math.LegacyNewDec(200).QuoInt64(10_000).String(), txPriority.GetTxPriority(suite.ctx, smallBid))
+		suite.Require().Equal(1, txPriority.Compare(
+			txPriority.GetTxPriority(suite.ctx, smallBid),
+			txPriority.GetTxPriority(suite.ctx, largeBid),
+		))
+	})
+
+	suite.Run("bids without gas have the minimum priority", func() {
+		txPriority := auction.BidPerGasTxPriority(suite.config, converter)
+
+		noGasBid := createBidTx(suite.accounts[2], 1000, 0)
+		suite.Require().Equal(txPriority.MinValue, txPriority.GetTxPriority(suite.ctx, noGasBid))
+	})
+
+	suite.Run("bids are ranked by their value per byte of the bundle", func() {
+		txPriority := auction.BidPerByteTxPriority(suite.config, converter, suite.encCfg.TxConfig.TxEncoder())
+
+		// The bundled transactions count towards the size of the bundle.
+		bundleBid, err := testutils.CreateAuctionTxWithSigners(
+			suite.encCfg.TxConfig,
+			suite.accounts[3],
+			sdk.NewInt64Coin("stake", 300),
+			0,
+			100,
+			suite.accounts[4:9],
+		)
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(1, txPriority.Compare(
+			txPriority.GetTxPriority(suite.ctx, smallBid),
+			txPriority.GetTxPriority(suite.ctx, bundleBid),
+		))
+	})
+
+	suite.Run("the lane selects the highest bid per unit of gas", func() {
+		lane := newLane(blockbuster.BidRankingPerGas)
+
+		suite.Require().NoError(lane.Insert(suite.ctx, largeBid))
+		suite.Require().NoError(lane.Insert(suite.ctx, smallBid))
+
+		suite.Require().Equal(smallBid, lane.GetTopAuctionTx(suite.ctx))
+	})
+
+	suite.Run("the check order handler uses the bid ranking of the lane", func() {
+		lane := newLane(blockbuster.BidRankingPerGas)
+		suite.Require().NoError(lane.CheckOrderHandler()(suite.ctx, []sdk.Tx{smallBid, largeBid}))
+		suite.Require().Error(lane.CheckOrderHandler()(suite.ctx, []sdk.Tx{largeBid, smallBid}))
+
+		lane = newLane(blockbuster.BidRankingTotal)
+		suite.Require().NoError(lane.CheckOrderHandler()(suite.ctx, []sdk.Tx{largeBid, smallBid}))
+		suite.Require().Error(lane.CheckOrderHandler()(suite.ctx, []sdk.Tx{smallBid, largeBid}))
+	})
+
+	suite.Run("unknown bid rankings are rejected", func() {
+		_, err := auction.NewBidTxPriority(blockbuster.BidRanking(100), suite.config, converter, suite.encCfg.TxConfig.TxEncoder())
+		suite.Require().Error(err)
+	})
+}
@@ -0,0 +1,72 @@
+package auction
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+var _ sdk.Tx = (*SettlementTx)(nil)
+
+// maxAuctionSettlementSize is an upper bound of the size of an encoded auction settlement. It
+// is reserved in the partial proposal of the lane under the second price pricing rule.
+var maxAuctionSettlementSize = func() int64 {
+	maxAmount := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1))
+
+	bz, err := types.EncodeAuctionSettlement(types.AuctionSettlement{
+		RunnerUpBid:       sdk.NewCoin(strings.Repeat("a", 128), math.NewIntFromBigInt(maxAmount)),
+		RunnerUpBidTxHash: make([]byte, sha256.Size),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return int64(len(bz))
+}()
+
+// voteExtensionsEnabled returns true if the auction is run over the bids included in the vote
+// extensions of the previous block at the current height.
+func voteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && ctx.BlockHeight() > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// SettlementTx wraps an auction settlement that is included in a block proposal so that it
+// can be verified alongside the transactions of the top-of-block lane. It is not a
+// transaction, contains no messages and is never executed.
+type SettlementTx struct {
+	types.AuctionSettlement
+}
+
+// GetMsgs implements sdk.Tx. Auction settlements do not contain any messages.
+func (tx *SettlementTx) GetMsgs() []sdk.Msg {
+	return nil
+}
+
+// GetMsgsV2 implements sdk.Tx. Auction settlements do not contain any messages.
+func (tx *SettlementTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+// NewSettlementTxDecoder returns a tx decoder that decodes auction settlements into
+// SettlementTxs and all other proposal entries using the given tx decoder. Block proposals
+// must be decoded with this decoder when the second price pricing rule is used.
+func NewSettlementTxDecoder(txDecoder sdk.TxDecoder) sdk.TxDecoder {
+	return func(txBz []byte) (sdk.Tx, error) {
+		if !types.IsAuctionSettlement(txBz) {
+			return txDecoder(txBz)
+		}
+
+		settlement, err := types.DecodeAuctionSettlement(txBz)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SettlementTx{AuctionSettlement: settlement}, nil
+	}
+}
@@ -0,0 +1,249 @@
+package auction
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// MsgRouter defines the interface that is used to route messages to their handlers when
+	// bundles are simulated. This is typically baseapp's MsgServiceRouter.
+	MsgRouter interface {
+		Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+	}
+
+	// TxSimulation defines the outcome of simulating a single transaction of a bundle.
+	TxSimulation struct {
+		// Hash is the hex encoded SHA-256 hash of the transaction bytes.
+		Hash string
+
+		// GasWanted is the gas limit of the transaction and GasUsed is the gas consumed.
+		GasWanted uint64
+		GasUsed   uint64
+
+		// Err is the error returned by the transaction. It is nil if the transaction succeeded.
+		Err error
+
+		// Events are the events emitted by the transaction.
+		Events []cometabci.Event
+	}
+
+	// BundleSimulation defines the outcome of simulating a bid transaction and its bundle.
+	BundleSimulation struct {
+		BidTx      TxSimulation
+		BundledTxs []TxSimulation
+
+		// Err is the error returned when the bid is validated with ValidateBidTx, i.e. as in
+		// CheckTx. It is nil if the bid would be accepted into the mempool.
+		Err error
+
+		// WouldWin is true if the bundle is valid and the bid ranks ahead of the current top
+		// bid in the lane's mempool.
+		WouldWin bool
+	}
+)
+
+// SetMsgRouter sets the message router that is used to execute the messages of transactions
+// when bundles are simulated. Messages cannot be executed if it is not set.
+func (handler *CheckTxHandler) SetMsgRouter(msgRouter MsgRouter) {
+	handler.msgRouter = msgRouter
+}
+
+// Valid returns true if the bid passes CheckTx validation and the bid transaction and all of
+// its bundled transactions succeeded.
+func (s *BundleSimulation) Valid() bool {
+	if s.Err != nil || s.BidTx.Err != nil {
+		return false
+	}
+
+	for _, bundledTx := range s.BundledTxs {
+		if bundledTx.Err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SimulateBundle simulates the bid transaction and each of its bundled transactions in order
+// against the state of the given context, e.g. the latest committed state of a query. The bid is
+// first validated with ValidateBidTx, exactly as in CheckTx. The ante handler is then run over
+// each transaction and, if executeMsgs is true, the messages of each transaction are executed as
+// well. Unlike ValidateBidTx, the simulation continues after a transaction fails so that the
+// outcome of every transaction is returned. No state changes are applied to the given context.
+func (handler *CheckTxHandler) SimulateBundle(ctx sdk.Context, bidTxBz []byte, executeMsgs bool) (*BundleSimulation, error) {
+	if executeMsgs && handler.msgRouter == nil {
+		return nil, fmt.Errorf("message execution is not enabled")
+	}
+
+	bidTx, err := handler.txDecoder(bidTxBz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bid tx: %w", err)
+	}
+
+	bidInfo, err := handler.tobLane.GetAuctionBidInfo(bidTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auction bid info: %w", err)
+	}
+
+	if bidInfo == nil {
+		return nil, fmt.Errorf("transaction is not a bid transaction")
+	}
+
+	// Simulate the bundle as if it were submitted to CheckTx at the top of the next block.
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.
+		WithIsCheckTx(true).
+		WithConsensusParams(handler.baseApp.GetConsensusParams(ctx))
+
+	simulation := &BundleSimulation{
+		BundledTxs: make([]TxSimulation, len(bidInfo.Transactions)),
+	}
+
+	// Validate the bid as CheckTx would against a separate branch of the state so that the
+	// per-transaction simulation below starts from the same state.
+	validateCtx, _ := ctx.WithTxBytes(bidTxBz).CacheContext()
+	if _, err := handler.ValidateBidTx(validateCtx, bidTx, bidInfo); err != nil {
+		simulation.Err = err
+	}
+
+	ctx, simulation.BidTx = handler.simulateTx(ctx, bidTx, bidTxBz, executeMsgs)
+
+	for index, txBz := range bidInfo.Transactions {
+		bundledTx, err := handler.tobLane.WrapBundleTransaction(txBz)
+		if err != nil {
+			simulation.BundledTxs[index] = TxSimulation{
+				Hash: getTxHash(txBz),
+				Err:  fmt.Errorf("failed to decode bundled tx: %w", err),
+			}
+
+			continue
+		}
+
+		if _, ok := bundledTx.(*EncryptedBundleTx); ok {
+			simulation.BundledTxs[index] = TxSimulation{
+				Hash: getTxHash(txBz),
+				Err:  fmt.Errorf("encrypted bundled txs cannot be simulated"),
+			}
+
+			continue
+		}
+
+		if bundledBidInfo, _ := handler.tobLane.GetAuctionBidInfo(bundledTx); bundledBidInfo != nil {
+			simulation.BundledTxs[index] = TxSimulation{
+				Hash: getTxHash(txBz),
+				Err:  fmt.Errorf("bundled tx cannot be a bid tx"),
+			}
+
+			continue
+		}
+
+		ctx, simulation.BundledTxs[index] = handler.simulateTx(ctx, bundledTx, txBz, executeMsgs)
+	}
+
+	if simulation.Valid() {
+		simulation.WouldWin, err = handler.ranksFirst(ctx, bidTx, bidTxBz)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return simulation, nil
+}
+
+// simulateTx runs the ante handler and, if executeMsgs is true, the messages of the transaction.
+// As in DeliverTx, the state changes of the ante handler are kept even if a message fails. The
+// returned context includes the state changes of the transaction.
+func (handler *CheckTxHandler) simulateTx(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	txBz []byte,
+	executeMsgs bool,
+) (sdk.Context, TxSimulation) {
+	simulation := TxSimulation{Hash: getTxHash(txBz)}
+
+	anteCtx, writeAnte := ctx.WithTxBytes(txBz).CacheContext()
+	anteCtx, err := handler.anteHandler(anteCtx, tx, false)
+	if err != nil {
+		simulation.Err = fmt.Errorf("failed to execute ante handler: %w", err)
+		if anteCtx.GasMeter() != nil {
+			simulation.GasWanted = anteCtx.GasMeter().Limit()
+			simulation.GasUsed = anteCtx.GasMeter().GasConsumed()
+		}
+
+		return ctx, simulation
+	}
+
+	simulation.Events = anteCtx.EventManager().ABCIEvents()
+
+	if executeMsgs {
+		msgCtx, writeMsgs := anteCtx.CacheContext()
+
+		var events []cometabci.Event
+		events, simulation.Err = handler.executeMsgs(msgCtx, tx)
+		if simulation.Err == nil {
+			simulation.Events = append(simulation.Events, events...)
+			writeMsgs()
+		}
+	}
+
+	simulation.GasWanted = anteCtx.GasMeter().Limit()
+	simulation.GasUsed = anteCtx.GasMeter().GasConsumed()
+
+	writeAnte()
+
+	return ctx, simulation
+}
+
+// executeMsgs executes the messages of the transaction and returns the events they emitted.
+func (handler *CheckTxHandler) executeMsgs(ctx sdk.Context, tx sdk.Tx) ([]cometabci.Event, error) {
+	var events []cometabci.Event
+	for index, msg := range tx.GetMsgs() {
+		msgHandler := handler.msgRouter.Handler(msg)
+		if msgHandler == nil {
+			return nil, fmt.Errorf("no message handler found for %s", sdk.MsgTypeURL(msg))
+		}
+
+		result, err := msgHandler(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute message %d: %w", index, err)
+		}
+
+		events = append(events, result.Events...)
+	}
+
+	return events, nil
+}
+
+// ranksFirst returns true if the bid transaction is the top bid in the lane's mempool or
+// ranks ahead of it. The top bid is read from a snapshot of the lane's mempool taken under
+// the mempool's lock, and comparing priorities does not access the mempool.
+func (handler *CheckTxHandler) ranksFirst(ctx sdk.Context, bidTx sdk.Tx, bidTxBz []byte) (bool, error) {
+	topBidTx := handler.tobLane.GetTopAuctionTx(ctx)
+	if topBidTx == nil {
+		return true, nil
+	}
+
+	topBidTxBz, err := handler.tobLane.TxEncoder()(topBidTx)
+	if err != nil {
+		return false, err
+	}
+
+	if bytes.Equal(topBidTxBz, bidTxBz) {
+		return true, nil
+	}
+
+	return handler.tobLane.Compare(ctx, bidTx, topBidTx) == 1, nil
+}
+
+// getTxHash returns the hex encoded SHA-256 hash of the transaction bytes.
+func getTxHash(txBz []byte) string {
+	hash := sha256.Sum256(txBz)
+	return hex.EncodeToString(hash[:])
+}
@@ -33,3 +33,30 @@ func GetMsgAuctionBidFromTx(tx sdk.Tx) (*buildertypes.MsgAuctionBid, error) {
 		return nil, errors.New("invalid MsgAuctionBid transaction")
 	}
 }
+
+// GetMsgRevealBidFromTx attempts to retrieve a MsgRevealBid from an sdk.Tx if
+// one exists. If a MsgRevealBid does exist and other messages are also present,
+// an error is returned. If no MsgRevealBid is present, <nil, nil> is returned.
+func GetMsgRevealBidFromTx(tx sdk.Tx) (*buildertypes.MsgRevealBid, error) {
+	revealBidMsgs := make([]*buildertypes.MsgRevealBid, 0)
+	for _, msg := range tx.GetMsgs() {
+		t, ok := msg.(*buildertypes.MsgRevealBid)
+		if ok {
+			revealBidMsgs = append(revealBidMsgs, t)
+		}
+	}
+
+	switch {
+	case len(revealBidMsgs) == 0:
+		// a normal transaction without a MsgRevealBid message
+		return nil, nil
+
+	case len(revealBidMsgs) == 1 && len(tx.GetMsgs()) == 1:
+		// a single MsgRevealBid message transaction
+		return revealBidMsgs[0], nil //nolint
+
+	default:
+		// a transaction with at at least one MsgRevealBid message
+		return nil, errors.New("invalid MsgRevealBid transaction")
+	}
+}
@@ -1,6 +1,8 @@
 package base_test
 
 import (
+	"sync"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/pob/blockbuster"
@@ -238,3 +240,54 @@ func (s *BaseTestSuite) TestSelect() {
 		s.Require().Nil(iterator)
 	})
 }
+
+func (s *BaseTestSuite) TestConcurrentAccess() {
+	s.Run("should be able to query the mempool while transactions are inserted and removed", func() {
+		mempool := blockbuster.NewConstructorMempool[string](blockbuster.DefaultTxPriority(), s.encodingConfig.TxConfig.TxEncoder(), 0)
+
+		txs := make([]sdk.Tx, len(s.accounts))
+		for index, account := range s.accounts {
+			tx, err := testutils.CreateRandomTx(
+				s.encodingConfig.TxConfig,
+				account,
+				0,
+				0,
+				0,
+				sdk.NewCoin(s.gasTokenDenom, math.NewInt(int64(100+index))),
+			)
+			s.Require().NoError(err)
+
+			txs[index] = tx
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for _, tx := range txs {
+				s.Require().NoError(mempool.Insert(sdk.Context{}, tx))
+			}
+
+			for _, tx := range txs {
+				s.Require().NoError(mempool.Remove(tx))
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for range txs {
+				for iterator := mempool.Select(sdk.Context{}, nil); iterator != nil; iterator = iterator.Next() {
+					mempool.Contains(iterator.Tx())
+				}
+
+				mempool.CountTx()
+			}
+		}()
+
+		wg.Wait()
+		s.Require().Equal(0, mempool.CountTx())
+	})
+}
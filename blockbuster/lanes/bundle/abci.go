@@ -0,0 +1,250 @@
+package bundle
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/metrics"
+	"github.com/skip-mev/pob/blockbuster/utils"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// PrepareLaneHandler will greedily select the bundles with the highest combined fees whose
+// transactions are all valid and include the transactions that submit them in the proposal.
+// The bundled transactions are executed when the transaction that submits them is executed,
+// so they are not included in the proposal themselves. Bundles are verified against the state
+// after all previously selected bundles. If any transaction of a bundle is invalid, the
+// transaction that submits the bundle is removed.
+func (l *BundleLane) PrepareLaneHandler() blockbuster.PrepareLaneHandler {
+	return func(ctx sdk.Context, proposal blockbuster.BlockProposal, maxTxBytes int64) ([][]byte, []sdk.Tx, error) {
+		var (
+			totalSize     int64
+			totalGasLimit uint64
+			txs           [][]byte
+			txsToRemove   []sdk.Tx
+
+			// selectedTxs tracks the transactions of all of the bundles that have been
+			// selected thus far.
+			selectedTxs = make(map[string]struct{})
+		)
+
+		// Get the maximum gas limit that can be included in the proposal for this lane.
+		maxGasLimit := proposal.GetMaxGasLimitForLane(l)
+
+	selectBundleLoop:
+		for iterator := l.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+			cacheCtx, write := ctx.CacheContext()
+			tx := iterator.Tx()
+
+			txBz, hash, err := utils.GetTxHashStr(l.TxEncoder(), tx)
+			if err != nil {
+				l.Logger().Info("failed to get hash of bundle tx", "err", err)
+
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonEncodingFailure)
+				txsToRemove = append(txsToRemove, tx)
+				continue selectBundleLoop
+			}
+
+			// if the transaction is already in the (partial) block proposal, we skip it.
+			if proposal.Contains(txBz) {
+				l.Logger().Info(
+					"failed to select bundle tx for lane; tx is already in proposal",
+					"tx_hash", hash,
+				)
+
+				continue selectBundleLoop
+			}
+
+			bundleInfo, bundledTxs, err := getBundle(l.TxDecoder(), tx)
+			if err != nil || bundleInfo == nil {
+				l.Logger().Info(
+					"failed to get bundle info",
+					"tx_hash", hash,
+					"err", err,
+				)
+
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonInvalidBundle)
+				txsToRemove = append(txsToRemove, tx)
+				continue selectBundleLoop
+			}
+
+			// The bundled transactions are part of the transaction that submits them, but each of
+			// them consumes its own gas limit when the bundle is executed.
+			bundleSize := int64(len(txBz))
+			bundleGasLimit := utils.GetTxGasLimit(tx)
+			bundledTxBz := make([][]byte, len(bundledTxs))
+			for index, bundledTx := range bundledTxs {
+				sdkTxBz, err := l.TxEncoder()(bundledTx)
+				if err != nil {
+					l.Logger().Info(
+						"failed to encode bundled tx",
+						"tx_hash", hash,
+						"err", err,
+					)
+
+					metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonInvalidBundle)
+					txsToRemove = append(txsToRemove, tx)
+					continue selectBundleLoop
+				}
+
+				// if the transaction is in a selected bundle, we skip the bundle.
+				if _, ok := selectedTxs[string(sdkTxBz)]; ok {
+					l.Logger().Info(
+						"failed to select bundle tx for lane; bundled tx is already in proposal",
+						"tx_hash", hash,
+					)
+
+					continue selectBundleLoop
+				}
+
+				bundledTxBz[index] = sdkTxBz
+				bundleGasLimit += utils.GetTxGasLimit(bundledTx)
+			}
+
+			if totalSize+bundleSize > maxTxBytes {
+				l.Logger().Info(
+					"failed to select bundle tx for lane; bundle size is too large",
+					"tx_hash", hash,
+					"bundle_size", bundleSize,
+					"total_size", totalSize,
+					"max_size", maxTxBytes,
+				)
+
+				continue selectBundleLoop
+			}
+
+			if bundleGasLimit > maxGasLimit-totalGasLimit {
+				l.Logger().Info(
+					"failed to select bundle tx for lane; bundle gas limit is too large",
+					"tx_hash", hash,
+					"bundle_gas_limit", bundleGasLimit,
+					"total_gas_limit", totalGasLimit,
+					"max_gas_limit", maxGasLimit,
+				)
+
+				continue selectBundleLoop
+			}
+
+			// Verify the transaction that submits the bundle and all of the bundled transactions.
+			if err := l.VerifyTx(cacheCtx, tx, bundleInfo, bundledTxs); err != nil {
+				l.Logger().Info(
+					"failed to verify bundle tx",
+					"tx_hash", hash,
+					"err", err,
+				)
+
+				metrics.IncrLaneRemovedTxs(l.Name(), metrics.ReasonAnteFailure)
+				txsToRemove = append(txsToRemove, tx)
+				continue selectBundleLoop
+			}
+
+			txs = append(txs, txBz)
+			totalSize += bundleSize
+			totalGasLimit += bundleGasLimit
+
+			for _, txBz := range bundledTxBz {
+				selectedTxs[string(txBz)] = struct{}{}
+			}
+
+			// Write the cache context to the original context when we know we have a
+			// valid bundle so that subsequent bundles are verified against the updated state.
+			write()
+		}
+
+		return txs, txsToRemove, nil
+	}
+}
+
+// ProcessLaneHandler will ensure that block proposals that include bundles from the bundle
+// lane are valid. Each transaction that submits a bundle and its bundled transactions are
+// verified in the order the bundles are included in the proposal.
+func (l *BundleLane) ProcessLaneHandler() blockbuster.ProcessLaneHandler {
+	return func(ctx sdk.Context, txs []sdk.Tx) ([]sdk.Tx, error) {
+		for len(txs) > 0 {
+			tx := txs[0]
+			if !l.Match(ctx, tx) {
+				return txs, nil
+			}
+
+			bundleInfo, bundledTxs, err := getBundle(l.TxDecoder(), tx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get bundle info for lane %s: %w", l.Name(), err)
+			}
+
+			if err := l.VerifyTx(ctx, tx, bundleInfo, bundledTxs); err != nil {
+				return nil, fmt.Errorf("invalid bundle tx: %w", err)
+			}
+
+			txs = txs[1:]
+		}
+
+		return txs, nil
+	}
+}
+
+// CheckOrderHandler ensures that if bundles are present in a proposal,
+//   - they are the first transactions in the partial proposal
+//   - the bundles are ordered by their combined fees
+//   - transactions from other lanes are not interleaved with the bundles.
+func (l *BundleLane) CheckOrderHandler() blockbuster.CheckOrderHandler {
+	return func(ctx sdk.Context, txs []sdk.Tx) error {
+		var (
+			index int
+			prev  sdk.Tx
+		)
+
+		for index < len(txs) && l.Match(ctx, txs[index]) {
+			tx := txs[index]
+
+			// Bundles must be included in order of their priority, i.e. the same order in
+			// which the mempool selects them.
+			if prev != nil && l.Compare(ctx, prev, tx) == -1 {
+				return fmt.Errorf("bundles in lane %s are not ordered by fees", l.Name())
+			}
+
+			prev = tx
+			index++
+		}
+
+		// Ensure that there are no more bundles in the block proposal.
+		for _, tx := range txs[index:] {
+			if l.Match(ctx, tx) {
+				return fmt.Errorf("misplaced bundle transactions in lane %s", l.Name())
+			}
+		}
+
+		return nil
+	}
+}
+
+// VerifyTx will verify that the transaction that submits the bundle and all of the bundled
+// transactions are valid. The bundled transactions are verified in order against the state
+// after the preceding transactions. It will return an error if any of the transactions are
+// invalid or if the bundle is rejected by the bundle validator.
+func (l *BundleLane) VerifyTx(ctx sdk.Context, tx sdk.Tx, bundleInfo *types.BundleInfo, bundledTxs []sdk.Tx) (err error) {
+	if bundleInfo == nil {
+		return fmt.Errorf("bundle info is nil")
+	}
+
+	if l.bundleValidator != nil {
+		if err := l.bundleValidator.ValidateBundleInfo(ctx, bundleInfo); err != nil {
+			return fmt.Errorf("invalid bundle: %w", err)
+		}
+	}
+
+	// verify the transaction that submits the bundle
+	if ctx, err = l.AnteVerifyTx(ctx, tx, false); err != nil {
+		return fmt.Errorf("invalid bundle tx; failed to execute ante handler: %w", err)
+	}
+
+	// verify all of the bundled transactions, which can only be executed as part of a bundle
+	ctx = types.WithBundleExecution(ctx)
+	for _, bundledTx := range bundledTxs {
+		if ctx, err = l.AnteVerifyTx(ctx, bundledTx, false); err != nil {
+			return fmt.Errorf("invalid bundle tx; failed to execute bundled transaction: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package bundle_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+// invalidBundle is a bundle validator that rejects all bundles.
+type invalidBundle struct{}
+
+func (invalidBundle) ValidateBundleInfo(_ sdk.Context, _ *buildertypes.BundleInfo) error {
+	return fmt.Errorf("invalid bundle")
+}
+
+func (s *BundleTestSuite) TestPrepareLane() {
+	sender := s.accounts[0]
+	other := s.accounts[1]
+
+	prepare := func(lane blockbuster.Lane, maxTxBytes int64) blockbuster.BlockProposal {
+		proposal, err := lane.PrepareLane(s.ctx, blockbuster.NewProposal(maxTxBytes), maxTxBytes, blockbuster.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		return proposal
+	}
+
+	s.Run("bundles are ordered by their combined fees", func() {
+		approveTx, swapTx := s.createBundledTx(sender, 1, 1), s.createBundledTx(sender, 2, 5)
+		bundleTx1 := s.createBundle(sender, 1, approveTx, swapTx)
+
+		otherTx := s.createBundledTx(other, 1, 1)
+		bundleTx2 := s.createBundle(other, 2, otherTx)
+
+		lane := s.initLane()
+		s.Require().NoError(lane.Insert(s.ctx, bundleTx2))
+		s.Require().NoError(lane.Insert(s.ctx, bundleTx1))
+
+		// The bundled transactions are executed as part of the transactions that submit them.
+		proposal := prepare(lane, 1000000)
+		s.Require().Equal([][]byte{s.encode(bundleTx1), s.encode(bundleTx2)}, proposal.GetTxs())
+	})
+
+	s.Run("no transactions of a bundle are included if any of them is invalid", func() {
+		approveTx, swapTx := s.createBundledTx(sender, 1, 1), s.createBundledTx(sender, 2, 5)
+		bundleTx := s.createBundle(sender, 1, approveTx, swapTx)
+
+		lane := s.initLane(swapTx)
+		s.Require().NoError(lane.Insert(s.ctx, bundleTx))
+
+		proposal := prepare(lane, 1000000)
+		s.Require().Empty(proposal.GetTxs())
+		s.Require().False(lane.Contains(bundleTx))
+	})
+
+	s.Run("bundles that do not fit are skipped", func() {
+		bundleTx := s.createBundle(sender, 1, s.createBundledTx(sender, 1, 1), s.createBundledTx(sender, 2, 5))
+
+		lane := s.initLane()
+		s.Require().NoError(lane.Insert(s.ctx, bundleTx))
+
+		proposal := prepare(lane, int64(len(s.encode(bundleTx)))-1)
+		s.Require().Empty(proposal.GetTxs())
+		s.Require().True(lane.Contains(bundleTx))
+	})
+
+	s.Run("bundles whose transactions are in a selected bundle are skipped", func() {
+		bundledTx := s.createBundledTx(sender, 1, 1)
+		bundleTx1 := s.createBundle(sender, 2, bundledTx)
+		bundleTx2 := s.createBundle(other, 1, bundledTx)
+
+		lane := s.initLane()
+		s.Require().NoError(lane.Insert(s.ctx, bundleTx1))
+		s.Require().NoError(lane.Insert(s.ctx, bundleTx2))
+
+		proposal := prepare(lane, 1000000)
+		s.Require().Equal([][]byte{s.encode(bundleTx1)}, proposal.GetTxs())
+	})
+
+	s.Run("bundles rejected by the bundle validator are removed", func() {
+		bundleTx := s.createBundle(sender, 1, s.createBundledTx(sender, 1, 1))
+
+		lane := s.initLane()
+		lane.SetBundleValidator(invalidBundle{})
+		s.Require().NoError(lane.Insert(s.ctx, bundleTx))
+
+		proposal := prepare(lane, 1000000)
+		s.Require().Empty(proposal.GetTxs())
+		s.Require().False(lane.Contains(bundleTx))
+	})
+}
+
+func (s *BundleTestSuite) TestProcessLane() {
+	sender := s.accounts[0]
+	other := s.accounts[1]
+
+	approveTx, swapTx := s.createBundledTx(sender, 1, 1), s.createBundledTx(sender, 2, 5)
+	bundleTx1 := s.createBundle(sender, 1, approveTx, swapTx)
+
+	otherTx := s.createBundledTx(other, 1, 1)
+	bundleTx2 := s.createBundle(other, 2, otherTx)
+
+	// defaultTx belongs to a subsequent lane.
+	defaultTx := s.createTx(s.accounts[2], 0, 1)
+
+	process := func(lane blockbuster.Lane, txs []sdk.Tx) error {
+		if err := lane.CheckOrder(s.ctx, txs); err != nil {
+			return err
+		}
+
+		_, err := lane.ProcessLane(s.ctx, txs, blockbuster.NoOpProcessLanesHandler())
+		return err
+	}
+
+	s.Run("valid proposal", func() {
+		lane := s.initLane()
+		txs := []sdk.Tx{bundleTx1, bundleTx2, defaultTx}
+		s.Require().NoError(process(lane, txs))
+	})
+
+	s.Run("bundles are not ordered by their combined fees", func() {
+		lane := s.initLane()
+		txs := []sdk.Tx{bundleTx2, bundleTx1}
+		s.Require().Error(process(lane, txs))
+	})
+
+	s.Run("bundles are interleaved with other transactions", func() {
+		lane := s.initLane()
+		txs := []sdk.Tx{bundleTx1, defaultTx, bundleTx2}
+		s.Require().Error(process(lane, txs))
+	})
+
+	s.Run("a bundled transaction is invalid", func() {
+		lane := s.initLane(swapTx)
+		txs := []sdk.Tx{bundleTx1}
+		s.Require().Error(process(lane, txs))
+	})
+
+	s.Run("a bundle is rejected by the bundle validator", func() {
+		lane := s.initLane()
+		lane.SetBundleValidator(invalidBundle{})
+
+		txs := []sdk.Tx{bundleTx1}
+		s.Require().Error(process(lane, txs))
+	})
+
+	s.Run("proposal without bundles", func() {
+		lane := s.initLane()
+		s.Require().NoError(process(lane, []sdk.Tx{defaultTx}))
+	})
+}
@@ -0,0 +1,206 @@
+package bundle_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/bundle"
+	testutils "github.com/skip-mev/pob/testutils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type BundleTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	encodingConfig testutils.EncodingConfig
+	random         *rand.Rand
+	accounts       []testutils.Account
+	gasTokenDenom  string
+}
+
+func TestBundleTestSuite(t *testing.T) {
+	suite.Run(t, new(BundleTestSuite))
+}
+
+func (s *BundleTestSuite) SetupTest() {
+	// Set up basic TX encoding config.
+	s.encodingConfig = testutils.CreateTestEncodingConfig()
+
+	key := storetypes.NewKVStoreKey(buildertypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// Create a few random accounts
+	s.random = rand.New(rand.NewSource(1))
+	s.accounts = testutils.RandomAccounts(s.random, 5)
+	s.gasTokenDenom = "stake"
+}
+
+// initLane returns a bundle lane whose ante handler fails the given transactions.
+func (s *BundleTestSuite) initLane(failingTxs ...sdk.Tx) *bundle.BundleLane {
+	failing := make(map[string]struct{}, len(failingTxs))
+	for _, tx := range failingTxs {
+		failing[s.getTxHash(tx)] = struct{}{}
+	}
+
+	anteHandler := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if _, ok := failing[s.getTxHash(tx)]; ok {
+			return ctx, fmt.Errorf("tx failed")
+		}
+
+		return ctx, nil
+	}
+
+	config := blockbuster.NewBaseLaneConfig(
+		log.NewTestLogger(s.T()),
+		s.encodingConfig.TxConfig.TxEncoder(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		anteHandler,
+		math.LegacyZeroDec(),
+	)
+
+	return bundle.NewBundleLane(config)
+}
+
+// createBundle returns a transaction submitted by the sender that bundles the given
+// transactions.
+func (s *BundleTestSuite) createBundle(sender testutils.Account, fee int64, bundledTxs ...sdk.Tx) sdk.Tx {
+	transactions := make([][]byte, len(bundledTxs))
+	for index, bundledTx := range bundledTxs {
+		transactions[index] = s.encode(bundledTx)
+	}
+
+	msg := buildertypes.NewMsgSubmitBundle(sender.Address, transactions)
+	tx, err := testutils.CreateTx(s.encodingConfig.TxConfig, sender, 0, 0, []sdk.Msg{msg}, sdk.NewInt64Coin(s.gasTokenDenom, fee))
+	s.Require().NoError(err)
+
+	return tx
+}
+
+// createTx returns a random transaction signed by the account with the given fee.
+func (s *BundleTestSuite) createTx(account testutils.Account, nonce uint64, fee int64) sdk.Tx {
+	tx, err := testutils.CreateRandomTx(s.encodingConfig.TxConfig, account, nonce, 1, 0, sdk.NewInt64Coin(s.gasTokenDenom, fee))
+	s.Require().NoError(err)
+
+	return tx
+}
+
+// createBundledTx returns a random transaction that can only be executed as part of a bundle,
+// signed by the account with the given fee.
+func (s *BundleTestSuite) createBundledTx(account testutils.Account, nonce uint64, fee int64) sdk.Tx {
+	tx, err := testutils.CreateBundledTx(s.encodingConfig.TxConfig, account, nonce, 1, 0, sdk.NewInt64Coin(s.gasTokenDenom, fee))
+	s.Require().NoError(err)
+
+	return tx
+}
+
+func (s *BundleTestSuite) encode(tx sdk.Tx) []byte {
+	txBz, err := s.encodingConfig.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	return txBz
+}
+
+func (s *BundleTestSuite) getTxHash(tx sdk.Tx) string {
+	hash := sha256.Sum256(s.encode(tx))
+	return hex.EncodeToString(hash[:])
+}
+
+func (s *BundleTestSuite) TestMatchHandler() {
+	lane := s.initLane()
+	sender := s.accounts[0]
+
+	s.Run("transaction that submits a bundle", func() {
+		tx := s.createBundle(sender, 1, s.createBundledTx(sender, 1, 1))
+		s.Require().True(lane.Match(s.ctx, tx))
+	})
+
+	s.Run("transaction that does not submit a bundle", func() {
+		s.Require().False(lane.Match(s.ctx, s.createTx(sender, 0, 1)))
+	})
+
+	s.Run("transaction that submits a bundle with other messages", func() {
+		msgs := []sdk.Msg{
+			buildertypes.NewMsgSubmitBundle(sender.Address, [][]byte{s.encode(s.createBundledTx(sender, 1, 1))}),
+		}
+		msgs = append(msgs, testutils.CreateRandomMsgs(sender.Address, 1)...)
+
+		tx, err := testutils.CreateTx(s.encodingConfig.TxConfig, sender, 0, 0, msgs)
+		s.Require().NoError(err)
+		s.Require().False(lane.Match(s.ctx, tx))
+	})
+}
+
+func (s *BundleTestSuite) TestGetBundleInfo() {
+	lane := s.initLane()
+	sender := s.accounts[0]
+
+	s.Run("bundle info includes the signers of the bundled transactions", func() {
+		bundledTxs := []sdk.Tx{s.createBundledTx(sender, 1, 1), s.createBundledTx(s.accounts[1], 0, 1)}
+		bundleInfo, err := lane.GetBundleInfo(s.createBundle(sender, 1, bundledTxs...))
+		s.Require().NoError(err)
+		s.Require().Equal(sender.Address, bundleInfo.Sender)
+		s.Require().Equal([][]byte{s.encode(bundledTxs[0]), s.encode(bundledTxs[1])}, bundleInfo.Transactions)
+		s.Require().Equal([]map[string]struct{}{
+			{sender.Address.String(): {}},
+			{s.accounts[1].Address.String(): {}},
+		}, bundleInfo.Signers)
+	})
+
+	s.Run("bundled transactions cannot submit bundles", func() {
+		nestedBundleTx := s.createBundle(sender, 1, s.createBundledTx(sender, 2, 1))
+		_, err := lane.GetBundleInfo(s.createBundle(sender, 1, nestedBundleTx))
+		s.Require().Error(err)
+	})
+
+	s.Run("bundled transactions cannot submit bids", func() {
+		bidTx, _, err := testutils.CreateAuctionTx(s.encodingConfig.TxConfig, sender, sdk.NewInt64Coin(s.gasTokenDenom, 100), 1, 0, []testutils.Account{sender})
+		s.Require().NoError(err)
+
+		_, err = lane.GetBundleInfo(s.createBundle(sender, 1, bidTx))
+		s.Require().Error(err)
+	})
+
+	s.Run("bundled transactions must have the bundled tx extension option", func() {
+		_, err := lane.GetBundleInfo(s.createBundle(sender, 1, s.createTx(sender, 1, 1)))
+		s.Require().Error(err)
+	})
+
+	s.Run("bundled transactions must be decodable", func() {
+		msg := buildertypes.NewMsgSubmitBundle(sender.Address, [][]byte{{0xFF}})
+		tx, err := testutils.CreateTx(s.encodingConfig.TxConfig, sender, 0, 0, []sdk.Msg{msg})
+		s.Require().NoError(err)
+
+		_, err = lane.GetBundleInfo(tx)
+		s.Require().Error(err)
+	})
+}
+
+func (s *BundleTestSuite) TestTxPriority() {
+	sender := s.accounts[0]
+	txPriority := bundle.TxPriority(s.encodingConfig.TxConfig.TxDecoder())
+
+	// The first bundle pays a higher fee for the transaction that submits it, but the second
+	// bundle pays higher combined fees.
+	bundleTx1 := s.createBundle(sender, 10, s.createBundledTx(sender, 1, 1))
+	bundleTx2 := s.createBundle(sender, 1, s.createBundledTx(sender, 1, 5), s.createBundledTx(sender, 2, 6))
+
+	fees, err := bundle.GetBundleFees(s.encodingConfig.TxConfig.TxDecoder(), bundleTx2)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.gasTokenDenom, 12)), fees)
+
+	priority1 := txPriority.GetTxPriority(s.ctx, bundleTx1)
+	priority2 := txPriority.GetTxPriority(s.ctx, bundleTx2)
+	s.Require().Equal(1, txPriority.Compare(priority2, priority1))
+}
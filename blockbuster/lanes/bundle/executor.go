@@ -0,0 +1,89 @@
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+var _ types.BundleExecutor = (*Executor)(nil)
+
+type (
+	// MsgRouter defines the interface that is used to route the messages of bundled transactions
+	// to their handlers. This is typically baseapp's MsgServiceRouter.
+	MsgRouter interface {
+		Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+	}
+
+	// Executor executes the bundled transactions of bundles that are submitted without a bid. It
+	// is used by the x/builder keeper when a MsgSubmitBundle is executed. The executor is provided
+	// to the keeper before the application's ante handler and message router are created, so
+	// they are set on the executor once the application is built.
+	Executor struct {
+		txDecoder   sdk.TxDecoder
+		anteHandler sdk.AnteHandler
+		msgRouter   MsgRouter
+	}
+)
+
+// NewExecutor returns a new executor. Bundles cannot be executed until SetUp is called.
+func NewExecutor() *Executor {
+	return &Executor{}
+}
+
+// SetUp sets the tx decoder, the ante handler and the message router that are used to execute
+// bundled transactions.
+func (e *Executor) SetUp(txDecoder sdk.TxDecoder, anteHandler sdk.AnteHandler, msgRouter MsgRouter) {
+	e.txDecoder = txDecoder
+	e.anteHandler = anteHandler
+	e.msgRouter = msgRouter
+}
+
+// ExecuteBundle executes the bundled transactions in order. Each transaction is run through the
+// ante handler, which verifies its signatures and deducts its fees, and its messages are then
+// executed. The state changes of each transaction are applied to the given context, so callers
+// must only write the context if no error is returned.
+func (e *Executor) ExecuteBundle(ctx sdk.Context, txs [][]byte) error {
+	if e.txDecoder == nil || e.anteHandler == nil || e.msgRouter == nil {
+		return fmt.Errorf("bundle executor is not set up")
+	}
+
+	ctx = types.WithBundleExecution(ctx)
+	for index, txBz := range txs {
+		tx, err := e.txDecoder(txBz)
+		if err != nil {
+			return fmt.Errorf("failed to decode bundled tx %d: %w", index, err)
+		}
+
+		if err := validateBundledTx(tx); err != nil {
+			return fmt.Errorf("invalid bundled tx %d: %w", index, err)
+		}
+
+		// The ante handler sets the gas meter of the bundled transaction, so the gas that it
+		// consumes is paid for by its own fees.
+		txCtx, err := e.anteHandler(ctx.WithTxBytes(txBz), tx, false)
+		if err != nil {
+			return fmt.Errorf("failed to execute ante handler of bundled tx %d: %w", index, err)
+		}
+
+		for msgIndex, msg := range tx.GetMsgs() {
+			handler := e.msgRouter.Handler(msg)
+			if handler == nil {
+				return fmt.Errorf("no message handler found for %s", sdk.MsgTypeURL(msg))
+			}
+
+			result, err := handler(txCtx, msg)
+			if err != nil {
+				return fmt.Errorf("failed to execute message %d of bundled tx %d: %w", msgIndex, index, err)
+			}
+
+			for _, event := range result.GetEvents() {
+				ctx.EventManager().EmitEvent(sdk.Event(event))
+			}
+		}
+	}
+
+	return nil
+}
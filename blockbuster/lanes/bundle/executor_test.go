@@ -0,0 +1,83 @@
+package bundle_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/skip-mev/pob/blockbuster/lanes/bundle"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+// msgRouter is a message router that records the signers of the executed messages and fails
+// the messages of the given signer.
+type msgRouter struct {
+	executed    *[]string
+	failingFrom string
+}
+
+func (r msgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		from := msg.(*banktypes.MsgSend).FromAddress
+		if from == r.failingFrom {
+			return nil, fmt.Errorf("msg failed")
+		}
+
+		*r.executed = append(*r.executed, from)
+		return &sdk.Result{}, nil
+	}
+}
+
+func (s *BundleTestSuite) TestExecuteBundle() {
+	sender := s.accounts[0]
+	other := s.accounts[1]
+
+	approveTx, swapTx := s.createBundledTx(sender, 1, 1), s.createBundledTx(other, 0, 5)
+
+	// anteHandler only accepts bundled transactions as part of a bundle.
+	anteHandler := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		if !buildertypes.IsBundleExecution(ctx) {
+			return ctx, fmt.Errorf("not executed as part of a bundle")
+		}
+
+		return ctx, nil
+	}
+
+	s.Run("executor is not set up", func() {
+		executor := bundle.NewExecutor()
+		s.Require().Error(executor.ExecuteBundle(s.ctx, [][]byte{s.encode(approveTx)}))
+	})
+
+	s.Run("bundled transactions are executed in order", func() {
+		var executed []string
+		executor := bundle.NewExecutor()
+		executor.SetUp(s.encodingConfig.TxConfig.TxDecoder(), anteHandler, msgRouter{executed: &executed})
+
+		err := executor.ExecuteBundle(s.ctx, [][]byte{s.encode(approveTx), s.encode(swapTx)})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{sender.Address.String(), other.Address.String()}, executed)
+	})
+
+	s.Run("bundle fails if any message fails", func() {
+		var executed []string
+		executor := bundle.NewExecutor()
+		executor.SetUp(
+			s.encodingConfig.TxConfig.TxDecoder(),
+			anteHandler,
+			msgRouter{executed: &executed, failingFrom: other.Address.String()},
+		)
+
+		err := executor.ExecuteBundle(s.ctx, [][]byte{s.encode(approveTx), s.encode(swapTx)})
+		s.Require().Error(err)
+	})
+
+	s.Run("bundle fails if a transaction is not a bundled transaction", func() {
+		var executed []string
+		executor := bundle.NewExecutor()
+		executor.SetUp(s.encodingConfig.TxConfig.TxDecoder(), anteHandler, msgRouter{executed: &executed})
+
+		err := executor.ExecuteBundle(s.ctx, [][]byte{s.encode(approveTx), s.encode(s.createTx(other, 0, 5))})
+		s.Require().Error(err)
+	})
+}
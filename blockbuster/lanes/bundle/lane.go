@@ -0,0 +1,154 @@
+package bundle
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+const (
+	// LaneName defines the name of the bundle lane.
+	LaneName = "bundle"
+)
+
+var _ blockbuster.Lane = (*BundleLane)(nil)
+
+type (
+	// BundleValidator defines the interface that is used to validate bundles before they are
+	// included in a proposal. This is typically the x/builder keeper.
+	BundleValidator interface {
+		ValidateBundleInfo(ctx sdk.Context, bundleInfo *types.BundleInfo) error
+	}
+
+	// BundleLane defines the lane that is responsible for including bundles that are submitted
+	// without a bid. Each bundle is submitted by a transaction with a single MsgSubmitBundle and
+	// its transactions are executed atomically and in order when the message is executed. A
+	// bundle is only included if all of its transactions are valid. Bundles are ranked by the
+	// combined fees of the submitting transaction and all of the bundled transactions.
+	BundleLane struct { //nolint
+		*blockbuster.LaneConstructor
+
+		// bundleValidator validates bundles before they are included in a proposal. If it is
+		// not set, bundles are only verified by the ante handler.
+		bundleValidator BundleValidator
+	}
+)
+
+// NewBundleLane returns a new bundle lane.
+func NewBundleLane(cfg blockbuster.LaneConfig) *BundleLane {
+	lane := &BundleLane{
+		LaneConstructor: blockbuster.NewLaneConstructor(
+			cfg,
+			LaneName,
+			blockbuster.NewConstructorMempool[string](
+				TxPriority(cfg.TxDecoder),
+				cfg.TxEncoder,
+				cfg.MaxTxs,
+			),
+			MatchHandler(),
+		),
+	}
+
+	// Set the prepare lane handler to the bundle one
+	lane.SetPrepareLaneHandler(lane.PrepareLaneHandler())
+
+	// Set the process lane handler to the bundle one
+	lane.SetProcessLaneHandler(lane.ProcessLaneHandler())
+
+	// Set the check order handler to the bundle one
+	lane.SetCheckOrderHandler(lane.CheckOrderHandler())
+
+	return lane
+}
+
+// SetBundleValidator sets the validator of the lane's bundles.
+func (l *BundleLane) SetBundleValidator(bundleValidator BundleValidator) {
+	l.bundleValidator = bundleValidator
+}
+
+// MatchHandler returns the match handler for the bundle lane. It matches transactions
+// that submit a bundle with a single MsgSubmitBundle.
+func MatchHandler() blockbuster.MatchHandler {
+	return func(ctx sdk.Context, tx sdk.Tx) bool {
+		msg, err := GetMsgSubmitBundleFromTx(tx)
+		return msg != nil && err == nil
+	}
+}
+
+// GetBundleInfo returns the bundle info of a transaction that submits a bundle. If the
+// transaction does not submit a bundle, <nil, nil> is returned.
+func (l *BundleLane) GetBundleInfo(tx sdk.Tx) (*types.BundleInfo, error) {
+	bundleInfo, _, err := getBundle(l.TxDecoder(), tx)
+	return bundleInfo, err
+}
+
+// getBundle returns the bundle info and the decoded bundled transactions of a transaction
+// that submits a bundle. Bundled transactions must carry the ExtensionOptionBundledTx option
+// and cannot submit bids or bundles themselves.
+func getBundle(txDecoder sdk.TxDecoder, tx sdk.Tx) (*types.BundleInfo, []sdk.Tx, error) {
+	msg, err := GetMsgSubmitBundleFromTx(tx)
+	if err != nil || msg == nil {
+		return nil, nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid sender address (%s): %w", msg.Sender, err)
+	}
+
+	bundledTxs := make([]sdk.Tx, len(msg.Transactions))
+	signers := make([]map[string]struct{}, len(msg.Transactions))
+	for index, txBz := range msg.Transactions {
+		bundledTx, err := txDecoder(txBz)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode bundled tx: %w", err)
+		}
+
+		if err := validateBundledTx(bundledTx); err != nil {
+			return nil, nil, err
+		}
+
+		sigTx, ok := bundledTx.(signing.SigVerifiableTx)
+		if !ok {
+			return nil, nil, fmt.Errorf("transaction is not valid")
+		}
+
+		txSigners, err := sigTx.GetSigners()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		signers[index] = make(map[string]struct{}, len(txSigners))
+		for _, signer := range txSigners {
+			signers[index][sdk.AccAddress(signer).String()] = struct{}{}
+		}
+
+		bundledTxs[index] = bundledTx
+	}
+
+	return &types.BundleInfo{
+		Sender:       sender,
+		Transactions: msg.Transactions,
+		Signers:      signers,
+	}, bundledTxs, nil
+}
+
+// validateBundledTx validates that the transaction can be bundled, i.e. that it carries the
+// ExtensionOptionBundledTx option and does not submit a bid or a bundle.
+func validateBundledTx(tx sdk.Tx) error {
+	if !types.IsBundledTx(tx) {
+		return fmt.Errorf("bundled tx does not have the bundled tx extension option")
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg.(type) {
+		case *types.MsgAuctionBid, *types.MsgRevealBid, *types.MsgSubmitBundle:
+			return fmt.Errorf("bundled tx cannot submit a bid or a bundle")
+		}
+	}
+
+	return nil
+}
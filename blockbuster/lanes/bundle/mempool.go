@@ -0,0 +1,50 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+)
+
+// TxPriority returns a TxPriority over transactions that submit bundles. Bundles are ranked
+// by their combined fees, which are compared in the same way as the fees of the default
+// lanes. Bundles whose transactions cannot be decoded have the minimum priority.
+func TxPriority(txDecoder sdk.TxDecoder) blockbuster.TxPriority[string] {
+	defaultPriority := blockbuster.DefaultTxPriority()
+
+	return blockbuster.TxPriority[string]{
+		GetTxPriority: func(goCtx context.Context, tx sdk.Tx) string {
+			fees, err := GetBundleFees(txDecoder, tx)
+			if err != nil {
+				return ""
+			}
+
+			return fees.String()
+		},
+		Compare:  defaultPriority.Compare,
+		MinValue: defaultPriority.MinValue,
+	}
+}
+
+// GetBundleFees returns the combined fees of the transaction that submits a bundle and all
+// of the bundled transactions.
+func GetBundleFees(txDecoder sdk.TxDecoder, tx sdk.Tx) (sdk.Coins, error) {
+	_, bundledTxs, err := getBundle(txDecoder, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := sdk.NewCoins()
+	for _, tx := range append([]sdk.Tx{tx}, bundledTxs...) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, fmt.Errorf("transaction is not a fee tx")
+		}
+
+		fees = fees.Add(feeTx.GetFee()...)
+	}
+
+	return fees, nil
+}
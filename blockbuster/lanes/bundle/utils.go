@@ -0,0 +1,35 @@
+package bundle
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+)
+
+// GetMsgSubmitBundleFromTx attempts to retrieve a MsgSubmitBundle from an sdk.Tx if
+// one exists. If a MsgSubmitBundle does exist and other messages are also present,
+// an error is returned. If no MsgSubmitBundle is present, <nil, nil> is returned.
+func GetMsgSubmitBundleFromTx(tx sdk.Tx) (*buildertypes.MsgSubmitBundle, error) {
+	submitBundleMsgs := make([]*buildertypes.MsgSubmitBundle, 0)
+	for _, msg := range tx.GetMsgs() {
+		t, ok := msg.(*buildertypes.MsgSubmitBundle)
+		if ok {
+			submitBundleMsgs = append(submitBundleMsgs, t)
+		}
+	}
+
+	switch {
+	case len(submitBundleMsgs) == 0:
+		// a normal transaction without a MsgSubmitBundle message
+		return nil, nil
+
+	case len(submitBundleMsgs) == 1 && len(tx.GetMsgs()) == 1:
+		// a single MsgSubmitBundle message transaction
+		return submitBundleMsgs[0], nil
+
+	default:
+		// a transaction with at least one MsgSubmitBundle message and other messages
+		return nil, errors.New("invalid MsgSubmitBundle transaction")
+	}
+}
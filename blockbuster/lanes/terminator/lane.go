@@ -58,6 +58,26 @@ func (t Terminator) GetMaxBlockSpace() math.LegacyDec {
 	return math.LegacyZeroDec()
 }
 
+// GetMinBlockSpace is a no-op
+func (t Terminator) GetMinBlockSpace() math.LegacyDec {
+	return math.LegacyZeroDec()
+}
+
+// GetMaxBlockGas is a no-op
+func (t Terminator) GetMaxBlockGas() math.LegacyDec {
+	return math.LegacyZeroDec()
+}
+
+// TxDecoder is a no-op
+func (t Terminator) TxDecoder() sdk.TxDecoder {
+	return nil
+}
+
+// GetMaxTxs is a no-op
+func (t Terminator) GetMaxTxs() int {
+	return 0
+}
+
 // Logger is a no-op
 func (t Terminator) Logger() log.Logger {
 	return log.NewNopLogger()
@@ -84,6 +104,11 @@ func (t Terminator) Contains(sdk.Tx) bool {
 	return false
 }
 
+// ContainsTxHash is a no-op
+func (t Terminator) ContainsTxHash(string) bool {
+	return false
+}
+
 // CountTx is a no-op
 func (t Terminator) CountTx() int {
 	return 0
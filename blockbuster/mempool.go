@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/skip-mev/pob/blockbuster/metrics"
 )
 
 var _ Mempool = (*BBMempool)(nil)
@@ -121,6 +122,8 @@ func (m *BBMempool) Insert(ctx context.Context, tx sdk.Tx) (err error) {
 			m.logger.Debug("failed to insert tx into lane", "lane", lane.Name(), "err", err)
 			errors = append(errors, fmt.Sprintf("failed to insert tx into lane %s: %s", lane.Name(), err.Error()))
 		}
+
+		metrics.SetLaneNumTxs(lane.Name(), lane.CountTx())
 	}
 
 	if len(errors) == 0 {
@@ -169,6 +172,8 @@ func (m *BBMempool) Remove(tx sdk.Tx) (err error) {
 				errors = append(errors, fmt.Sprintf("failed to remove tx from lane %s: %s;", lane.Name(), err.Error()))
 			}
 		}
+
+		metrics.SetLaneNumTxs(lane.Name(), lane.CountTx())
 	}
 
 	if len(errors) == 0 {
@@ -205,8 +210,12 @@ func (m *BBMempool) Registry() []Lane {
 // the following:
 // - The sum of the lane max block space percentages is less than or equal to 1.
 // - There is no unused block space.
+// - The sum of the lane min block space percentages is less than or equal to 1.
+// - The sum of the lane max block gas percentages is less than or equal to 1.
 func (m *BBMempool) ValidateBasic() error {
 	sum := math.LegacyZeroDec()
+	minSum := math.LegacyZeroDec()
+	gasSum := math.LegacyZeroDec()
 	seenZeroMaxBlockSpace := false
 
 	for _, lane := range m.registry {
@@ -216,6 +225,8 @@ func (m *BBMempool) ValidateBasic() error {
 		}
 
 		sum = sum.Add(lane.GetMaxBlockSpace())
+		minSum = minSum.Add(lane.GetMinBlockSpace())
+		gasSum = gasSum.Add(lane.GetMaxBlockGas())
 	}
 
 	switch {
@@ -226,6 +237,12 @@ func (m *BBMempool) ValidateBasic() error {
 	// Ensure that there is no unused block space.
 	case sum.LT(math.LegacyOneDec()) && !seenZeroMaxBlockSpace:
 		return fmt.Errorf("sum of total block space percentages will be less than 1")
+	// Ensure that the reserved block space does not exceed the block.
+	case minSum.GT(math.LegacyOneDec()):
+		return fmt.Errorf("sum of lane min block space percentages must be less than or equal to 1, got %s", minSum)
+	// Ensure that the sum of the lane max block gas percentages is less than or equal to 1.
+	case gasSum.GT(math.LegacyOneDec()):
+		return fmt.Errorf("sum of lane max block gas percentages must be less than or equal to 1, got %s", gasSum)
 	}
 
 	return nil
@@ -283,6 +283,9 @@ func (suite *BlockBusterTestSuite) TestRemove() {
 			for iterator := suite.tobLane.Select(suite.ctx, nil); iterator != nil; {
 				tx := iterator.Tx()
 
+				// Ensure that the top bid is the first transaction selected
+				suite.Require().Equal(tx, suite.tobLane.GetTopAuctionTx(suite.ctx))
+
 				// Remove the transaction from the mempool
 				suite.Require().NoError(suite.mempool.Remove(tx))
 
@@ -314,6 +317,38 @@ func (suite *BlockBusterTestSuite) TestRemove() {
 }
 
 // fillBaseLane fills the base lane with numTxs transactions that are randomly created.
+func (suite *BlockBusterTestSuite) TestValidateBasic() {
+	newLane := func(maxBlockSpace, minBlockSpace string) blockbuster.Lane {
+		cfg := blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyMustNewDecFromStr(maxBlockSpace),
+			MinBlockSpace: math.LegacyMustNewDecFromStr(minBlockSpace),
+		}
+
+		return base.NewDefaultLane(cfg)
+	}
+
+	suite.Run("min block space within the block", func() {
+		suite.Require().NotPanics(func() {
+			blockbuster.NewMempool(log.NewNopLogger(), true, newLane("0.5", "0.5"), newLane("0", "0.5"))
+		})
+	})
+
+	suite.Run("min block space exceeds the block", func() {
+		suite.Require().Panics(func() {
+			blockbuster.NewMempool(log.NewNopLogger(), true, newLane("0.5", "0.5"), newLane("0", "0.6"))
+		})
+	})
+
+	suite.Run("min block space exceeds max block space", func() {
+		suite.Require().Panics(func() {
+			newLane("0.2", "0.3")
+		})
+	})
+}
+
 func (suite *BlockBusterTestSuite) fillBaseLane(numTxs int) {
 	for i := 0; i < numTxs; i++ {
 		// randomly select an account to create the tx
@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gometrics "github.com/hashicorp/go-metrics"
+)
+
+// The metrics below are emitted through the application's telemetry sink. They are
+// exported by the Prometheus endpoint when telemetry is enabled in the application's
+// configuration (app.toml) and are discarded otherwise.
+const (
+	// LabelLane is the label used to denote the lane a metric belongs to.
+	LabelLane = "lane"
+
+	// LabelReason is the label used to denote why a transaction was removed or why
+	// a proposal was rejected.
+	LabelReason = "reason"
+
+	// LabelDenom is the label used to denote the denom of a bid.
+	LabelDenom = "denom"
+)
+
+// Reasons a transaction may be removed from a lane while a proposal is prepared.
+const (
+	ReasonEncodingFailure = "encoding_failure"
+	ReasonLaneMismatch    = "lane_mismatch"
+	ReasonAnteFailure     = "ante_failure"
+	ReasonInvalidBid      = "invalid_bid"
+	ReasonInvalidBundle   = "invalid_bundle"
+)
+
+// Reasons a proposal may be rejected by ProcessProposal.
+const (
+	ReasonDecodeFailure  = "decode_failure"
+	ReasonInvalidOrder   = "invalid_order"
+	ReasonInvalidTx      = "invalid_tx"
+	ReasonBlockSpace     = "block_space"
+	ReasonPanic          = "panic"
+	ReasonInvalidAuction = "invalid_auction"
+)
+
+var (
+	// KeyLaneNumTxs is the gauge of the number of transactions in each lane's mempool.
+	KeyLaneNumTxs = []string{"blockbuster", "lane", "num_txs"}
+
+	// KeyProposalLaneTxs is the gauge of the number of transactions each lane
+	// contributed to the latest proposal.
+	KeyProposalLaneTxs = []string{"blockbuster", "proposal", "lane_txs"}
+
+	// KeyProposalLaneBytes is the gauge of the number of bytes each lane
+	// contributed to the latest proposal.
+	KeyProposalLaneBytes = []string{"blockbuster", "proposal", "lane_bytes"}
+
+	// KeyLaneRemovedTxs is the counter of transactions removed from each lane while
+	// preparing a proposal.
+	KeyLaneRemovedTxs = []string{"blockbuster", "lane", "removed_txs"}
+
+	// KeyPrepareLaneLatency is the summary of the time taken by each lane to prepare
+	// its portion of a proposal. The name of the lane is appended to the key.
+	KeyPrepareLaneLatency = []string{"blockbuster", "lane", "prepare_latency"}
+
+	// KeyProcessLaneLatency is the summary of the time taken by each lane to verify
+	// its portion of a proposal. The name of the lane is appended to the key.
+	KeyProcessLaneLatency = []string{"blockbuster", "lane", "process_latency"}
+
+	// KeyProposalRejected is the counter of proposals rejected by ProcessProposal.
+	KeyProposalRejected = []string{"blockbuster", "proposal", "rejected"}
+
+	// KeyAuctionNumBids is the gauge of the number of bids that competed in the top
+	// of block auction of the latest proposal.
+	KeyAuctionNumBids = []string{"blockbuster", "auction", "num_bids"}
+
+	// KeyAuctionWinningBid is the counter of the total value of winning bids.
+	KeyAuctionWinningBid = []string{"blockbuster", "auction", "winning_bid"}
+)
+
+// SetLaneNumTxs sets the number of transactions currently in the lane's mempool.
+func SetLaneNumTxs(lane string, numTxs int) {
+	telemetry.SetGaugeWithLabels(
+		KeyLaneNumTxs,
+		float32(numTxs),
+		[]gometrics.Label{telemetry.NewLabel(LabelLane, lane)},
+	)
+}
+
+// RecordProposalLane records the number of transactions and bytes a lane contributed
+// to a proposal.
+func RecordProposalLane(lane string, numTxs int, numBytes int64) {
+	labels := []gometrics.Label{telemetry.NewLabel(LabelLane, lane)}
+
+	telemetry.SetGaugeWithLabels(KeyProposalLaneTxs, float32(numTxs), labels)
+	telemetry.SetGaugeWithLabels(KeyProposalLaneBytes, float32(numBytes), labels)
+}
+
+// IncrLaneRemovedTxs increments the number of transactions removed from the lane while
+// preparing a proposal for the given reason.
+func IncrLaneRemovedTxs(lane, reason string) {
+	telemetry.IncrCounterWithLabels(
+		KeyLaneRemovedTxs,
+		1,
+		[]gometrics.Label{
+			telemetry.NewLabel(LabelLane, lane),
+			telemetry.NewLabel(LabelReason, reason),
+		},
+	)
+}
+
+// MeasurePrepareLane records the time taken by the lane to prepare its portion of a
+// proposal since start.
+func MeasurePrepareLane(lane string, start time.Time) {
+	telemetry.MeasureSince(start, laneKey(KeyPrepareLaneLatency, lane)...)
+}
+
+// MeasureProcessLane records the time taken by the lane to verify its portion of a
+// proposal since start.
+func MeasureProcessLane(lane string, start time.Time) {
+	telemetry.MeasureSince(start, laneKey(KeyProcessLaneLatency, lane)...)
+}
+
+// IncrProposalRejected increments the number of proposals rejected for the given reason.
+// The lane is empty if the rejection cannot be attributed to a lane.
+func IncrProposalRejected(lane, reason string) {
+	telemetry.IncrCounterWithLabels(
+		KeyProposalRejected,
+		1,
+		[]gometrics.Label{
+			telemetry.NewLabel(LabelLane, lane),
+			telemetry.NewLabel(LabelReason, reason),
+		},
+	)
+}
+
+// RecordAuction records the number of bids that competed in the auction and adds the value
+// of each of the winning bids to the total value of winning bids.
+func RecordAuction(lane string, numBids int, winningBids []sdk.Coin) {
+	telemetry.SetGaugeWithLabels(
+		KeyAuctionNumBids,
+		float32(numBids),
+		[]gometrics.Label{telemetry.NewLabel(LabelLane, lane)},
+	)
+
+	for _, bid := range winningBids {
+		value, _ := math.LegacyNewDecFromInt(bid.Amount).Float64()
+
+		telemetry.IncrCounterWithLabels(
+			KeyAuctionWinningBid,
+			float32(value),
+			[]gometrics.Label{
+				telemetry.NewLabel(LabelLane, lane),
+				telemetry.NewLabel(LabelDenom, bid.Denom),
+			},
+		)
+	}
+}
+
+// laneKey returns the given metric key with the name of the lane appended to it. It is used
+// for metrics whose telemetry helpers do not support labels.
+func laneKey(key []string, lane string) []string {
+	return append(append(make([]string, 0, len(key)+1), key...), lane)
+}
@@ -0,0 +1,108 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gometrics "github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/skip-mev/pob/blockbuster/metrics"
+)
+
+type MetricsTestSuite struct {
+	suite.Suite
+
+	sink *gometrics.InmemSink
+}
+
+func TestMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(MetricsTestSuite))
+}
+
+func (suite *MetricsTestSuite) SetupTest() {
+	suite.sink = gometrics.NewInmemSink(time.Minute, time.Minute)
+
+	cfg := gometrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+
+	_, err := gometrics.NewGlobal(cfg, suite.sink)
+	suite.Require().NoError(err)
+}
+
+func (suite *MetricsTestSuite) TearDownTest() {
+	_, err := gometrics.NewGlobal(gometrics.DefaultConfig(""), &gometrics.BlackholeSink{})
+	suite.Require().NoError(err)
+}
+
+func (suite *MetricsTestSuite) data() *gometrics.IntervalMetrics {
+	data := suite.sink.Data()
+	suite.Require().NotEmpty(data)
+
+	return data[len(data)-1]
+}
+
+func (suite *MetricsTestSuite) TestLaneMetrics() {
+	metrics.SetLaneNumTxs("free", 3)
+	metrics.RecordProposalLane("free", 2, 100)
+	metrics.IncrLaneRemovedTxs("free", metrics.ReasonAnteFailure)
+	metrics.IncrLaneRemovedTxs("free", metrics.ReasonAnteFailure)
+	metrics.MeasurePrepareLane("free", time.Now())
+	metrics.MeasureProcessLane("free", time.Now())
+
+	data := suite.data()
+
+	gauge, ok := data.Gauges["blockbuster.lane.num_txs;lane=free"]
+	suite.Require().True(ok)
+	suite.Require().Equal(float32(3), gauge.Value)
+
+	txs, ok := data.Gauges["blockbuster.proposal.lane_txs;lane=free"]
+	suite.Require().True(ok)
+	suite.Require().Equal(float32(2), txs.Value)
+
+	bytes, ok := data.Gauges["blockbuster.proposal.lane_bytes;lane=free"]
+	suite.Require().True(ok)
+	suite.Require().Equal(float32(100), bytes.Value)
+
+	removed, ok := data.Counters["blockbuster.lane.removed_txs;lane=free;reason=ante_failure"]
+	suite.Require().True(ok)
+	suite.Require().Equal(2, removed.Count)
+
+	suite.Require().Contains(data.Samples, "blockbuster.lane.prepare_latency.free")
+	suite.Require().Contains(data.Samples, "blockbuster.lane.process_latency.free")
+}
+
+func (suite *MetricsTestSuite) TestProposalRejected() {
+	metrics.IncrProposalRejected("top-of-block", metrics.ReasonInvalidOrder)
+	metrics.IncrProposalRejected("", metrics.ReasonDecodeFailure)
+
+	data := suite.data()
+	suite.Require().Contains(data.Counters, "blockbuster.proposal.rejected;lane=top-of-block;reason=invalid_order")
+	suite.Require().Contains(data.Counters, "blockbuster.proposal.rejected;lane=;reason=decode_failure")
+}
+
+func (suite *MetricsTestSuite) TestRecordAuction() {
+	metrics.RecordAuction("top-of-block", 5, []sdk.Coin{
+		sdk.NewCoin("stake", math.NewInt(100)),
+		sdk.NewCoin("stake", math.NewInt(50)),
+		sdk.NewCoin("atom", math.NewInt(10)),
+	})
+
+	data := suite.data()
+
+	numBids, ok := data.Gauges["blockbuster.auction.num_bids;lane=top-of-block"]
+	suite.Require().True(ok)
+	suite.Require().Equal(float32(5), numBids.Value)
+
+	stake, ok := data.Counters["blockbuster.auction.winning_bid;lane=top-of-block;denom=stake"]
+	suite.Require().True(ok)
+	suite.Require().Equal(2, stake.Count)
+	suite.Require().Equal(float64(150), stake.Sum)
+
+	atom, ok := data.Counters["blockbuster.auction.winning_bid;lane=top-of-block;denom=atom"]
+	suite.Require().True(ok)
+	suite.Require().Equal(float64(10), atom.Sum)
+}
@@ -134,8 +134,8 @@ func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Compara
 		}
 
 		// Weight is used as a tiebreaker for transactions with the same priority.
-		// Weight is calculated in a single pass in .Select(...) and so will be 0
-		// on .Insert(...).
+		// Weight is calculated in a single pass after every .Insert(...) and
+		// .Remove(...) and so will be 0 until then.
 		res = txPriority.Compare(keyA.weight, keyB.weight)
 		if res != 0 {
 			return res
@@ -262,6 +262,8 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
 
+	mp.reorderPriorityTies()
+
 	return nil
 }
 
@@ -345,8 +347,6 @@ func (mp *PriorityNonceMempool[C]) Select(_ context.Context, _ [][]byte) sdkmemp
 		return nil
 	}
 
-	mp.reorderPriorityTies()
-
 	iterator := &PriorityNonceIterator[C]{
 		mempool:       mp,
 		senderCursors: make(map[string]*skiplist.Element),
@@ -361,6 +361,9 @@ type reorderKey[C comparable] struct {
 	tx        sdk.Tx
 }
 
+// reorderPriorityTies recomputes the weights of transactions with tied priorities.
+// It is run on every mutation of the mempool so that Select is read-only and may be
+// run concurrently.
 func (mp *PriorityNonceMempool[C]) reorderPriorityTies() {
 	node := mp.priorityIndex.Front()
 
@@ -444,6 +447,8 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
 
+	mp.reorderPriorityTies()
+
 	return nil
 }
 
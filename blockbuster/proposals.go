@@ -4,15 +4,31 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	gomath "math"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/skip-mev/pob/blockbuster/utils"
 )
 
 var _ BlockProposal = (*Proposal)(nil)
 
+const (
+	// AllocationModeFixed allocates each lane its relative percentage of the block space
+	// (MaxBlockSpace). Block space that a lane does not consume is only utilized by lanes
+	// that have no limit on the block space they can consume (MaxBlockSpace = 0).
+	AllocationModeFixed AllocationMode = iota
+
+	// AllocationModeRollover allocates each lane its relative percentage of the block space
+	// along with any block space that preceding lanes were allocated but did not consume.
+	AllocationModeRollover
+)
+
 type (
+	// AllocationMode defines how block space is allocated across lanes.
+	AllocationMode int
+
 	// LaneProposal defines the interface/APIs that are required for the proposal to interact
 	// with a lane.
 	LaneProposal interface {
@@ -22,8 +38,15 @@ type (
 		// GetMaxBlockSpace returns the maximum block space for the lane as a relative percentage.
 		GetMaxBlockSpace() math.LegacyDec
 
+		// GetMaxBlockGas returns the maximum block gas for the lane as a relative percentage.
+		GetMaxBlockGas() math.LegacyDec
+
 		// Name returns the name of the lane.
 		Name() string
+
+		// TxDecoder returns the lane's transaction decoder. It is utilized to determine the gas
+		// limit of the lane's transactions.
+		TxDecoder() sdk.TxDecoder
 	}
 
 	// BlockProposal is the interface/APIs that are required for proposal creation + interacting with
@@ -36,8 +59,27 @@ type (
 		//  1. The total size of the proposal must be less than the maximum number of bytes allowed.
 		//  2. The total size of the partial proposal must be less than the maximum number of bytes allowed for
 		//     the lane.
+		//  3. The total gas limit of the proposal must be less than the maximum gas limit allowed.
+		//  4. The total gas limit of the partial proposal must be less than the maximum gas limit allowed
+		//     for the lane.
 		UpdateProposal(lane LaneProposal, partialProposalTxs [][]byte) error
 
+		// GetMaxTxBytesForLane returns the maximum number of bytes the given lane can add to the
+		// proposal respecting the allocation mode of the proposal.
+		GetMaxTxBytesForLane(lane LaneProposal) int64
+
+		// GetMaxGasLimitForLane returns the maximum gas limit the given lane can add to the
+		// proposal.
+		GetMaxGasLimitForLane(lane LaneProposal) uint64
+
+		// GetMaxGasLimit returns the maximum gas limit that can be included in the proposal. A
+		// value of zero means there is no limit.
+		GetMaxGasLimit() uint64
+
+		// GetTotalGasLimit returns the total gas limit of the transactions currently included
+		// in the proposal.
+		GetTotalGasLimit() uint64
+
 		// GetMaxTxBytes returns the maximum number of bytes that can be included in the proposal.
 		GetMaxTxBytes() int64
 
@@ -80,16 +122,44 @@ type (
 
 		// maxTxBytes is the maximum number of bytes that can be included in the proposal.
 		maxTxBytes int64
+
+		// allocationMode determines how block space is allocated across lanes.
+		allocationMode AllocationMode
+
+		// rolloverTxBytes is the number of bytes that preceding lanes were allocated but did
+		// not consume. It is only utilized by the rollover allocation mode.
+		rolloverTxBytes int64
+
+		// totalGasLimit is the total gas limit of the transactions currently included in the proposal.
+		totalGasLimit uint64
+
+		// maxGasLimit is the maximum gas limit that can be included in the proposal. A value of
+		// zero means there is no limit.
+		maxGasLimit uint64
+
+		// skipLaneTxBytesLimits determines whether lanes may exceed the block space they are
+		// allocated as long as the proposal does not exceed its maximum size.
+		skipLaneTxBytesLimits bool
 	}
 )
 
-// NewProposal returns a new empty proposal.
+// NewProposal returns a new empty proposal that utilizes the fixed allocation mode and has
+// no gas limit.
 func NewProposal(maxTxBytes int64) *Proposal {
+	return NewProposalWithLimits(maxTxBytes, 0, AllocationModeFixed)
+}
+
+// NewProposalWithLimits returns a new empty proposal that can include at most maxTxBytes
+// bytes and maxGasLimit gas, where a maxGasLimit of zero means there is no gas limit. Block
+// space is allocated across lanes according to the given allocation mode.
+func NewProposalWithLimits(maxTxBytes int64, maxGasLimit uint64, mode AllocationMode) *Proposal {
 	return &Proposal{
 		txs:            make([][]byte, 0),
 		voteExtensions: make([][]byte, 0),
 		cache:          make(map[string]struct{}),
 		maxTxBytes:     maxTxBytes,
+		maxGasLimit:    maxGasLimit,
+		allocationMode: mode,
 	}
 }
 
@@ -98,19 +168,21 @@ func NewProposal(maxTxBytes int64) *Proposal {
 //  1. The total size of the proposal must be less than the maximum number of bytes allowed.
 //  2. The total size of the partial proposal must be less than the maximum number of bytes allowed for
 //     the lane.
+//  3. The total gas limit of the proposal must be less than the maximum gas limit allowed.
+//  4. The total gas limit of the partial proposal must be less than the maximum gas limit allowed
+//     for the lane.
+//
+// Lanes must update the proposal even if they do not include any transactions so that the
+// block space they did not consume can be rolled over to subsequent lanes.
 func (p *Proposal) UpdateProposal(lane LaneProposal, partialProposalTxs [][]byte) error {
-	if len(partialProposalTxs) == 0 {
-		return nil
-	}
-
 	partialProposalSize := int64(0)
 	for _, tx := range partialProposalTxs {
 		partialProposalSize += int64(len(tx))
 	}
 
 	// Invarient check: Ensure that the lane did not prepare a partial proposal that is too large.
-	maxTxBytesForLane := utils.GetMaxTxBytesForLane(p.GetMaxTxBytes(), p.GetTotalTxBytes(), lane.GetMaxBlockSpace())
-	if partialProposalSize > maxTxBytesForLane {
+	maxTxBytesForLane := p.GetMaxTxBytesForLane(lane)
+	if !p.skipLaneTxBytesLimits && partialProposalSize > maxTxBytesForLane {
 		return fmt.Errorf(
 			"%s lane prepared a partial proposal that is too large: %d > %d",
 			lane.Name(),
@@ -129,7 +201,36 @@ func (p *Proposal) UpdateProposal(lane LaneProposal, partialProposalTxs [][]byte
 			p.maxTxBytes,
 		)
 	}
+
+	// Invarient check: Ensure that the lane did not exceed the gas limit of the lane. Since the
+	// lane's gas limit is bounded by the remaining gas, this also ensures that the block proposal
+	// does not exceed the gas limit.
+	partialProposalGasLimit, err := p.getGasLimit(lane, partialProposalTxs)
+	if err != nil {
+		return err
+	}
+
+	if maxGasLimitForLane := p.GetMaxGasLimitForLane(lane); partialProposalGasLimit > maxGasLimitForLane {
+		return fmt.Errorf(
+			"%s lane prepared a partial proposal that exceeds the gas limit: %d > %d",
+			lane.Name(),
+			partialProposalGasLimit,
+			maxGasLimitForLane,
+		)
+	}
+
 	p.totalTxBytes = updatedSize
+	p.totalGasLimit += partialProposalGasLimit
+
+	// Any block space that the lane was allocated but did not consume rolls over to the
+	// subsequent lanes. Lanes without a limit do not affect the rollover.
+	if p.allocationMode == AllocationModeRollover && !lane.GetMaxBlockSpace().IsZero() {
+		p.rolloverTxBytes = maxTxBytesForLane - partialProposalSize
+	}
+
+	if len(partialProposalTxs) == 0 {
+		return nil
+	}
 
 	p.txs = append(p.txs, partialProposalTxs...)
 
@@ -158,6 +259,12 @@ func (p *Proposal) UpdateProposal(lane LaneProposal, partialProposalTxs [][]byte
 	return nil
 }
 
+// SkipLaneTxBytesLimits allows lanes to exceed the block space they are allocated. The maximum
+// size and the gas limits of the proposal are still enforced.
+func (p *Proposal) SkipLaneTxBytesLimits() {
+	p.skipLaneTxBytesLimits = true
+}
+
 // GetProposal returns all of the transactions in the proposal along with the vote extensions
 // at the top of the proposal.
 func (p *Proposal) GetProposal() [][]byte {
@@ -174,6 +281,49 @@ func (p *Proposal) GetVoteExtensions() [][]byte {
 	return p.voteExtensions
 }
 
+// GetMaxTxBytesForLane returns the maximum number of bytes the given lane can add to the
+// proposal. In the rollover allocation mode, the lane can additionally consume the block
+// space that preceding lanes were allocated but did not consume.
+func (p *Proposal) GetMaxTxBytesForLane(lane LaneProposal) int64 {
+	maxTxBytesForLane := utils.GetMaxTxBytesForLane(p.maxTxBytes, p.totalTxBytes, lane.GetMaxBlockSpace())
+	if p.allocationMode != AllocationModeRollover || lane.GetMaxBlockSpace().IsZero() {
+		return maxTxBytesForLane
+	}
+
+	maxTxBytesForLane += p.rolloverTxBytes
+	if remainder := p.maxTxBytes - p.totalTxBytes; maxTxBytesForLane > remainder {
+		maxTxBytesForLane = remainder
+	}
+
+	if maxTxBytesForLane < 0 {
+		return 0
+	}
+
+	return maxTxBytesForLane
+}
+
+// GetMaxGasLimitForLane returns the maximum gas limit the given lane can add to the proposal.
+// If the proposal has no gas limit, the lane is not limited.
+func (p *Proposal) GetMaxGasLimitForLane(lane LaneProposal) uint64 {
+	if p.maxGasLimit == 0 {
+		return gomath.MaxUint64
+	}
+
+	return utils.GetMaxGasLimitForLane(p.maxGasLimit, p.totalGasLimit, lane.GetMaxBlockGas())
+}
+
+// GetMaxGasLimit returns the maximum gas limit that can be included in the proposal. A value
+// of zero means there is no limit.
+func (p *Proposal) GetMaxGasLimit() uint64 {
+	return p.maxGasLimit
+}
+
+// GetTotalGasLimit returns the total gas limit of the transactions currently included in the
+// proposal.
+func (p *Proposal) GetTotalGasLimit() uint64 {
+	return p.totalGasLimit
+}
+
 // GetMaxTxBytes returns the maximum number of bytes that can be included in the proposal.
 func (p *Proposal) GetMaxTxBytes() int64 {
 	return p.maxTxBytes
@@ -202,3 +352,28 @@ func (p *Proposal) Contains(tx []byte) bool {
 	_, ok := p.cache[txHashStr]
 	return ok
 }
+
+// getGasLimit returns the total gas limit of the given transactions. Transactions are only
+// decoded if the proposal has a gas limit.
+func (p *Proposal) getGasLimit(lane LaneProposal, txs [][]byte) (uint64, error) {
+	if p.maxGasLimit == 0 {
+		return 0, nil
+	}
+
+	var gasLimit uint64
+	for _, txBz := range txs {
+		tx, err := lane.TxDecoder()(txBz)
+		if err != nil {
+			return 0, fmt.Errorf("%s lane prepared a partial proposal with an invalid tx: %w", lane.Name(), err)
+		}
+
+		txGasLimit := utils.GetTxGasLimit(tx)
+		if gasLimit+txGasLimit < gasLimit {
+			return 0, fmt.Errorf("%s lane prepared a partial proposal whose gas limit overflows", lane.Name())
+		}
+
+		gasLimit += txGasLimit
+	}
+
+	return gasLimit, nil
+}
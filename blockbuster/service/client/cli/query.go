@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/skip-mev/pob/blockbuster/service/types"
+)
+
+const (
+	flagPercentiles = "percentiles"
+	flagMaxTxBytes  = "max-tx-bytes"
+	flagExecuteMsgs = "execute-msgs"
+)
+
+// GetQueryCmd returns the cli query commands for the BlockBuster mempool query service.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "blockbuster",
+		Short:                      "Querying commands for the BlockBuster mempool",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryFeeEstimates(),
+		CmdQuerySimulateBundle(),
+	)
+
+	return cmd
+}
+
+// CmdQueryFeeEstimates implements a command that will return the estimated fees required
+// for inclusion in each lane of the mempool.
+func CmdQueryFeeEstimates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-estimates [lane]",
+		Short: "Query the estimated fees required for inclusion in each lane",
+		Long: `Query the estimated fee required for a transaction to be included in the next
+block for the given lane, or for all lanes if no lane is provided, alongside the fee
+percentiles paid over recent blocks.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			percentiles, err := cmd.Flags().GetUintSlice(flagPercentiles)
+			if err != nil {
+				return err
+			}
+
+			maxTxBytes, err := cmd.Flags().GetInt64(flagMaxTxBytes)
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryFeeEstimatesRequest{
+				MaxTxBytes:  maxTxBytes,
+				Percentiles: make([]uint32, len(percentiles)),
+			}
+			for index, percentile := range percentiles {
+				request.Percentiles[index] = uint32(percentile)
+			}
+
+			if len(args) > 0 {
+				request.Lane = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.FeeEstimates(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().UintSlice(flagPercentiles, nil, "The fee percentiles to compute over recent blocks (e.g. 25,50,75)")
+	cmd.Flags().Int64(flagMaxTxBytes, 0, "The maximum number of bytes in a block (defaults to the consensus block max bytes)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySimulateBundle implements a command that will simulate a bid transaction and its
+// bundled transactions against the latest committed state.
+func CmdQuerySimulateBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-bundle [bid_tx]",
+		Short: "Simulate a bid transaction and its bundled transactions",
+		Long: `Simulate a bid transaction and its bundled transactions against the latest committed
+state without submitting the bid, where the bid transaction is a hex-encoded string of the signed
+bid transaction. The gas used, error and events of each transaction are returned alongside whether
+the bid would currently win the auction.`,
+		Args:    cobra.ExactArgs(1),
+		Example: "simulate-bundle 0xFF... --execute-msgs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidTx, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to HEX decode bid transaction: %w", err)
+			}
+
+			executeMsgs, err := cmd.Flags().GetBool(flagExecuteMsgs)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.SimulateBundle(context.Background(), &types.QuerySimulateBundleRequest{
+				BidTx:       bidTx,
+				ExecuteMsgs: executeMsgs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().Bool(flagExecuteMsgs, false, "Execute the messages of each transaction after the ante handler")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/fees"
+	"github.com/skip-mev/pob/blockbuster/lanes/auction"
+	"github.com/skip-mev/pob/blockbuster/service/types"
+)
+
+var _ types.QueryServer = (*QueryService)(nil)
+
+// BundleSimulator defines the interface that is used to simulate bid transactions and their
+// bundles. This is typically the top-of-block lane's CheckTxHandler.
+type BundleSimulator interface {
+	SimulateBundle(ctx sdk.Context, bidTx []byte, executeMsgs bool) (*auction.BundleSimulation, error)
+}
+
+// QueryService defines the BlockBuster mempool gRPC querier service. It exposes
+// the lane registry and the state of the application-side mempool to searchers,
+// wallets and users.
+type QueryService struct {
+	// mempool is the BlockBuster mempool that is being queried.
+	mempool blockbuster.Mempool
+
+	// txDecoder is utilized to decode raw transactions submitted to the service.
+	txDecoder sdk.TxDecoder
+
+	// feeEstimator is utilized to estimate the fees required for inclusion in each
+	// lane. Fee estimates are unavailable if it is not set.
+	feeEstimator *fees.Estimator
+
+	// bundleSimulator is utilized to simulate bundles. Bundle simulation is unavailable
+	// if it is not set.
+	bundleSimulator BundleSimulator
+}
+
+// NewQueryService returns a new BlockBuster mempool query service.
+func NewQueryService(mempool blockbuster.Mempool, txDecoder sdk.TxDecoder) *QueryService {
+	return &QueryService{
+		mempool:   mempool,
+		txDecoder: txDecoder,
+	}
+}
+
+// SetFeeEstimator sets the fee estimator utilized by the FeeEstimates query.
+func (s *QueryService) SetFeeEstimator(estimator *fees.Estimator) {
+	s.feeEstimator = estimator
+}
+
+// SetBundleSimulator sets the bundle simulator utilized by the SimulateBundle query.
+func (s *QueryService) SetBundleSimulator(simulator BundleSimulator) {
+	s.bundleSimulator = simulator
+}
+
+// RegisterGRPCServer registers the BlockBuster mempool query service on the given
+// gRPC server. Applications will typically register the service on the application's
+// gRPC query router.
+func RegisterGRPCServer(server gogogrpc.Server, service *QueryService) {
+	types.RegisterQueryServer(server, service)
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the BlockBuster
+// mempool query service.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// Lanes returns the configuration and current size of each lane in the mempool.
+func (s *QueryService) Lanes(_ context.Context, _ *types.QueryLanesRequest) (*types.QueryLanesResponse, error) {
+	registry := s.mempool.Registry()
+
+	lanes := make([]types.LaneInfo, len(registry))
+	for index, lane := range registry {
+		lanes[index] = types.LaneInfo{
+			Name:          lane.Name(),
+			MaxBlockSpace: lane.GetMaxBlockSpace(),
+			MaxTxs:        int64(lane.GetMaxTxs()),
+			NumTxs:        uint64(lane.CountTx()),
+			MinBlockSpace: lane.GetMinBlockSpace(),
+			MaxBlockGas:   lane.GetMaxBlockGas(),
+		}
+
+		if lanes[index].MaxBlockSpace.IsNil() {
+			lanes[index].MaxBlockSpace = math.LegacyZeroDec()
+		}
+	}
+
+	return &types.QueryLanesResponse{Lanes: lanes}, nil
+}
+
+// TxDistribution returns the number of transactions in each lane.
+func (s *QueryService) TxDistribution(_ context.Context, _ *types.QueryTxDistributionRequest) (*types.QueryTxDistributionResponse, error) {
+	distribution := make(map[string]uint64)
+	for lane, count := range s.mempool.GetTxDistribution() {
+		distribution[lane] = uint64(count)
+	}
+
+	return &types.QueryTxDistributionResponse{Distribution: distribution}, nil
+}
+
+// TxLane returns the lane that the given transaction would be inserted into. This
+// is the first lane in the registry that matches the transaction.
+func (s *QueryService) TxLane(goCtx context.Context, req *types.QueryTxLaneRequest) (*types.QueryTxLaneResponse, error) {
+	if req == nil || len(req.Tx) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty transaction")
+	}
+
+	tx, err := s.txDecoder(req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode transaction: %s", err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, lane := range s.mempool.Registry() {
+		if lane.Match(ctx, tx) {
+			return &types.QueryTxLaneResponse{Lane: lane.Name()}, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "transaction does not match any lane")
+}
+
+// MempoolTx returns whether a transaction with the given hash is in the mempool and
+// the lane it is stored in.
+func (s *QueryService) MempoolTx(_ context.Context, req *types.QueryMempoolTxRequest) (*types.QueryMempoolTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Normalize the hash so that both upper and lower case hex strings are accepted.
+	hash, err := hex.DecodeString(strings.TrimPrefix(req.Hash, "0x"))
+	if err != nil || len(hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid transaction hash: %s", req.Hash))
+	}
+	hashStr := hex.EncodeToString(hash)
+
+	for _, lane := range s.mempool.Registry() {
+		if lane.ContainsTxHash(hashStr) {
+			return &types.QueryMempoolTxResponse{InMempool: true, Lane: lane.Name()}, nil
+		}
+	}
+
+	return &types.QueryMempoolTxResponse{InMempool: false}, nil
+}
+
+// FeeEstimates returns the estimated fee required for a transaction to be included in
+// each lane in the next block alongside fee percentiles over recent blocks.
+func (s *QueryService) FeeEstimates(goCtx context.Context, req *types.QueryFeeEstimatesRequest) (*types.QueryFeeEstimatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if s.feeEstimator == nil {
+		return nil, status.Error(codes.Unimplemented, "fee estimation is not enabled")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Default to the block max bytes from the consensus parameters.
+	maxTxBytes := req.MaxTxBytes
+	if maxTxBytes == 0 {
+		if block := ctx.ConsensusParams().Block; block != nil {
+			maxTxBytes = block.MaxBytes
+		}
+	}
+
+	if maxTxBytes <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "max tx bytes must be positive")
+	}
+
+	estimates, err := s.feeEstimator.EstimateFees(ctx, req.Lane, maxTxBytes, req.Percentiles)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFeeEstimatesResponse{Estimates: estimates}, nil
+}
+
+// SimulateBundle simulates the bid transaction and its bundled transactions against the
+// latest committed state and returns the outcome of each transaction and whether the bid
+// would currently win the auction. No state changes are applied.
+func (s *QueryService) SimulateBundle(goCtx context.Context, req *types.QuerySimulateBundleRequest) (*types.QuerySimulateBundleResponse, error) {
+	if req == nil || len(req.BidTx) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bid transaction")
+	}
+
+	if s.bundleSimulator == nil {
+		return nil, status.Error(codes.Unimplemented, "bundle simulation is not enabled")
+	}
+
+	simulation, err := s.bundleSimulator.SimulateBundle(sdk.UnwrapSDKContext(goCtx), req.BidTx, req.ExecuteMsgs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bundledTxs := make([]types.TxSimulationResult, len(simulation.BundledTxs))
+	for index, bundledTx := range simulation.BundledTxs {
+		bundledTxs[index] = getTxSimulationResult(bundledTx)
+	}
+
+	resp := &types.QuerySimulateBundleResponse{
+		BidTx:      getTxSimulationResult(simulation.BidTx),
+		BundledTxs: bundledTxs,
+		Valid:      simulation.Valid(),
+		WouldWin:   simulation.WouldWin,
+	}
+
+	if simulation.Err != nil {
+		resp.Error = simulation.Err.Error()
+	}
+
+	return resp, nil
+}
+
+// getTxSimulationResult converts the simulation of a transaction into its query response type.
+func getTxSimulationResult(simulation auction.TxSimulation) types.TxSimulationResult {
+	result := types.TxSimulationResult{
+		Hash:      simulation.Hash,
+		GasWanted: simulation.GasWanted,
+		GasUsed:   simulation.GasUsed,
+		Events:    simulation.Events,
+	}
+
+	if simulation.Err != nil {
+		result.Error = simulation.Err.Error()
+	}
+
+	return result
+}
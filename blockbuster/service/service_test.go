@@ -0,0 +1,522 @@
+package service_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/fees"
+	"github.com/skip-mev/pob/blockbuster/lanes/auction"
+	"github.com/skip-mev/pob/blockbuster/lanes/base"
+	"github.com/skip-mev/pob/blockbuster/lanes/free"
+	"github.com/skip-mev/pob/blockbuster/service"
+	"github.com/skip-mev/pob/blockbuster/service/types"
+	"github.com/skip-mev/pob/blockbuster/utils"
+	testutils "github.com/skip-mev/pob/testutils"
+	buildertypes "github.com/skip-mev/pob/x/builder/types"
+	"github.com/stretchr/testify/suite"
+)
+
+// simulationBaseApp is a BaseApp that only provides the consensus params used to simulate bundles.
+type simulationBaseApp struct{}
+
+func (simulationBaseApp) CommitMultiStore() storetypes.CommitMultiStore { return nil }
+
+func (simulationBaseApp) CheckTx(_ *cometabci.RequestCheckTx) (*cometabci.ResponseCheckTx, error) {
+	return nil, nil
+}
+
+func (simulationBaseApp) Logger() log.Logger { return log.NewNopLogger() }
+
+func (simulationBaseApp) LastBlockHeight() int64 { return 1 }
+
+func (simulationBaseApp) GetConsensusParams(_ sdk.Context) cmtproto.ConsensusParams {
+	return cmtproto.ConsensusParams{}
+}
+
+func (simulationBaseApp) ChainID() string { return "test" }
+
+// simulationMsgRouter routes all messages to a handler that emits an event and fails the
+// messages of transactions signed by the failing signer.
+type simulationMsgRouter struct {
+	failingSigner sdk.AccAddress
+}
+
+func (r simulationMsgRouter) Handler(_ sdk.Msg) baseapp.MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(50, "msg")
+
+		if legacyMsg, ok := msg.(sdk.LegacyMsg); ok {
+			for _, signer := range legacyMsg.GetSigners() {
+				if signer.Equals(r.failingSigner) {
+					return nil, fmt.Errorf("msg failed")
+				}
+			}
+		}
+
+		return &sdk.Result{Events: []cometabci.Event{{Type: "msg"}}}, nil
+	}
+}
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+
+	encodingConfig testutils.EncodingConfig
+
+	tobLane  *auction.TOBLane
+	freeLane *free.FreeLane
+	baseLane *base.DefaultLane
+
+	mempool blockbuster.Mempool
+	service *service.QueryService
+
+	accounts []testutils.Account
+	random   *rand.Rand
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.encodingConfig = testutils.CreateTestEncodingConfig()
+	suite.random = rand.New(rand.NewSource(time.Now().Unix()))
+	key := storetypes.NewKVStoreKey(buildertypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	suite.ctx = testCtx.Ctx.WithBlockHeight(1)
+
+	suite.tobLane = auction.NewTOBLane(
+		blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.1"),
+			MaxTxs:        10,
+		},
+		auction.NewDefaultAuctionFactory(suite.encodingConfig.TxConfig.TxDecoder()),
+	)
+
+	suite.freeLane = free.NewFreeLane(
+		blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.2"),
+		},
+		blockbuster.DefaultTxPriority(),
+		free.DefaultMatchHandler(),
+	)
+
+	suite.baseLane = base.NewDefaultLane(
+		blockbuster.LaneConfig{
+			Logger:        log.NewNopLogger(),
+			TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+			MaxBlockSpace: math.LegacyZeroDec(),
+		},
+	)
+
+	suite.mempool = blockbuster.NewMempool(log.NewNopLogger(), true, suite.tobLane, suite.freeLane, suite.baseLane)
+	suite.service = service.NewQueryService(suite.mempool, suite.encodingConfig.TxConfig.TxDecoder())
+	suite.accounts = testutils.RandomAccounts(suite.random, 3)
+}
+
+func (suite *ServiceTestSuite) TestLanes() {
+	bidTx, err := testutils.CreateAuctionTxWithSigners(
+		suite.encodingConfig.TxConfig,
+		suite.accounts[0],
+		sdk.NewCoin("stake", math.NewInt(100)),
+		0,
+		1000,
+		nil,
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mempool.Insert(suite.ctx, bidTx))
+
+	resp, err := suite.service.Lanes(suite.ctx, &types.QueryLanesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Lanes, 3)
+
+	suite.Require().Equal(types.LaneInfo{
+		Name:          auction.LaneName,
+		MaxBlockSpace: math.LegacyMustNewDecFromStr("0.1"),
+		MaxTxs:        10,
+		NumTxs:        1,
+		MinBlockSpace: math.LegacyZeroDec(),
+		MaxBlockGas:   math.LegacyZeroDec(),
+	}, resp.Lanes[0])
+	suite.Require().Equal(types.LaneInfo{
+		Name:          free.LaneName,
+		MaxBlockSpace: math.LegacyMustNewDecFromStr("0.2"),
+		MaxTxs:        0,
+		NumTxs:        0,
+		MinBlockSpace: math.LegacyZeroDec(),
+		MaxBlockGas:   math.LegacyZeroDec(),
+	}, resp.Lanes[1])
+	suite.Require().Equal(types.LaneInfo{
+		Name:          base.LaneName,
+		MaxBlockSpace: math.LegacyZeroDec(),
+		MaxTxs:        0,
+		NumTxs:        0,
+		MinBlockSpace: math.LegacyZeroDec(),
+		MaxBlockGas:   math.LegacyZeroDec(),
+	}, resp.Lanes[2])
+}
+
+func (suite *ServiceTestSuite) TestTxDistribution() {
+	for i := 0; i < 3; i++ {
+		tx, err := testutils.CreateRandomTx(
+			suite.encodingConfig.TxConfig,
+			suite.accounts[0],
+			uint64(i),
+			1,
+			1000,
+			sdk.NewCoin("stake", math.NewInt(int64(i+1))),
+		)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, tx))
+	}
+
+	freeTx, err := testutils.CreateFreeTx(
+		suite.encodingConfig.TxConfig,
+		suite.accounts[1],
+		0,
+		1000,
+		"val1",
+		sdk.NewCoin("stake", math.NewInt(100)),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mempool.Insert(suite.ctx, freeTx))
+
+	resp, err := suite.service.TxDistribution(suite.ctx, &types.QueryTxDistributionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]uint64{
+		auction.LaneName: 0,
+		free.LaneName:    1,
+		base.LaneName:    3,
+	}, resp.Distribution)
+}
+
+func (suite *ServiceTestSuite) TestTxLane() {
+	bidTx, err := testutils.CreateAuctionTxWithSigners(
+		suite.encodingConfig.TxConfig,
+		suite.accounts[0],
+		sdk.NewCoin("stake", math.NewInt(100)),
+		0,
+		1000,
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	freeTx, err := testutils.CreateFreeTx(
+		suite.encodingConfig.TxConfig,
+		suite.accounts[1],
+		0,
+		1000,
+		"val1",
+		sdk.NewCoin("stake", math.NewInt(100)),
+	)
+	suite.Require().NoError(err)
+
+	normalTx, err := testutils.CreateRandomTx(suite.encodingConfig.TxConfig, suite.accounts[2], 0, 1, 1000)
+	suite.Require().NoError(err)
+
+	cases := []struct {
+		name         string
+		tx           sdk.Tx
+		expectedLane string
+	}{
+		{
+			"bid tx belongs to the top of block lane",
+			bidTx,
+			auction.LaneName,
+		},
+		{
+			"free tx belongs to the free lane",
+			freeTx,
+			free.LaneName,
+		},
+		{
+			"normal tx belongs to the default lane",
+			normalTx,
+			base.LaneName,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			txBz, err := suite.encodingConfig.TxConfig.TxEncoder()(tc.tx)
+			suite.Require().NoError(err)
+
+			resp, err := suite.service.TxLane(suite.ctx, &types.QueryTxLaneRequest{Tx: txBz})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedLane, resp.Lane)
+		})
+	}
+
+	suite.Run("invalid tx bytes", func() {
+		_, err := suite.service.TxLane(suite.ctx, &types.QueryTxLaneRequest{Tx: []byte("invalid")})
+		suite.Require().Error(err)
+	})
+
+	suite.Run("empty tx bytes", func() {
+		_, err := suite.service.TxLane(suite.ctx, &types.QueryTxLaneRequest{})
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *ServiceTestSuite) TestMempoolTx() {
+	freeTx, err := testutils.CreateFreeTx(
+		suite.encodingConfig.TxConfig,
+		suite.accounts[1],
+		0,
+		1000,
+		"val1",
+		sdk.NewCoin("stake", math.NewInt(100)),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mempool.Insert(suite.ctx, freeTx))
+
+	_, hash, err := utils.GetTxHashStr(suite.encodingConfig.TxConfig.TxEncoder(), freeTx)
+	suite.Require().NoError(err)
+
+	suite.Run("tx in mempool", func() {
+		resp, err := suite.service.MempoolTx(suite.ctx, &types.QueryMempoolTxRequest{Hash: hash})
+		suite.Require().NoError(err)
+		suite.Require().True(resp.InMempool)
+		suite.Require().Equal(free.LaneName, resp.Lane)
+	})
+
+	suite.Run("upper case hash", func() {
+		resp, err := suite.service.MempoolTx(suite.ctx, &types.QueryMempoolTxRequest{Hash: strings.ToUpper(hash)})
+		suite.Require().NoError(err)
+		suite.Require().True(resp.InMempool)
+		suite.Require().Equal(free.LaneName, resp.Lane)
+	})
+
+	suite.Run("tx not in mempool", func() {
+		resp, err := suite.service.MempoolTx(suite.ctx, &types.QueryMempoolTxRequest{Hash: hex.EncodeToString(make([]byte, 32))})
+		suite.Require().NoError(err)
+		suite.Require().False(resp.InMempool)
+		suite.Require().Empty(resp.Lane)
+	})
+
+	suite.Run("invalid hash", func() {
+		_, err := suite.service.MempoolTx(suite.ctx, &types.QueryMempoolTxRequest{Hash: "not a hash"})
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *ServiceTestSuite) TestFeeEstimates() {
+	suite.Run("fee estimation is not enabled", func() {
+		_, err := suite.service.FeeEstimates(suite.ctx, &types.QueryFeeEstimatesRequest{MaxTxBytes: 1000})
+		suite.Require().Error(err)
+	})
+
+	suite.service.SetFeeEstimator(fees.NewEstimator(suite.mempool, suite.encodingConfig.TxConfig.TxEncoder(), 10))
+
+	suite.Run("estimates for all lanes", func() {
+		resp, err := suite.service.FeeEstimates(suite.ctx, &types.QueryFeeEstimatesRequest{MaxTxBytes: 1000})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Estimates, 3)
+		suite.Require().Equal(auction.LaneName, resp.Estimates[0].Lane)
+		suite.Require().Equal(int64(100), resp.Estimates[0].MaxTxBytes)
+		suite.Require().Len(resp.Estimates[0].Percentiles, len(fees.DefaultPercentiles))
+	})
+
+	suite.Run("estimates for a single lane", func() {
+		resp, err := suite.service.FeeEstimates(suite.ctx, &types.QueryFeeEstimatesRequest{
+			Lane:        free.LaneName,
+			Percentiles: []uint32{50},
+			MaxTxBytes:  1000,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Estimates, 1)
+		suite.Require().Equal(free.LaneName, resp.Estimates[0].Lane)
+		suite.Require().Equal(int64(200), resp.Estimates[0].MaxTxBytes)
+		suite.Require().Len(resp.Estimates[0].Percentiles, 1)
+	})
+
+	suite.Run("max tx bytes defaults to the consensus params", func() {
+		ctx := suite.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 2000},
+		})
+
+		resp, err := suite.service.FeeEstimates(ctx, &types.QueryFeeEstimatesRequest{Lane: base.LaneName})
+		suite.Require().NoError(err)
+		suite.Require().Equal(int64(2000), resp.Estimates[0].MaxTxBytes)
+	})
+
+	suite.Run("missing max tx bytes", func() {
+		_, err := suite.service.FeeEstimates(suite.ctx, &types.QueryFeeEstimatesRequest{})
+		suite.Require().Error(err)
+	})
+
+	suite.Run("unknown lane", func() {
+		_, err := suite.service.FeeEstimates(suite.ctx, &types.QueryFeeEstimatesRequest{Lane: "unknown", MaxTxBytes: 1000})
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *ServiceTestSuite) TestSimulateBundle() {
+	txEncoder := suite.encodingConfig.TxConfig.TxEncoder()
+
+	// failingTxs are the transactions that fail the ante handler.
+	failingTxs := make(map[string]struct{})
+	anteHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1000))
+		ctx.GasMeter().ConsumeGas(100, "ante")
+
+		if _, ok := failingTxs[string(ctx.TxBytes())]; ok {
+			return ctx, fmt.Errorf("ante failed")
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent("ante"))
+		return ctx, nil
+	}
+
+	checkTxHandler := auction.NewCheckTxHandler(
+		simulationBaseApp{},
+		suite.encodingConfig.TxConfig.TxDecoder(),
+		suite.tobLane,
+		anteHandler,
+	)
+
+	bidTx, bundle, err := testutils.CreateAuctionTx(
+		suite.encodingConfig.TxConfig,
+		suite.accounts[0],
+		sdk.NewCoin("stake", math.NewInt(100)),
+		0,
+		1000,
+		suite.accounts[0:2],
+	)
+	suite.Require().NoError(err)
+
+	bidTxBz, err := txEncoder(bidTx)
+	suite.Require().NoError(err)
+
+	bundleBz := make([][]byte, len(bundle))
+	for index, tx := range bundle {
+		bundleBz[index], err = txEncoder(tx)
+		suite.Require().NoError(err)
+	}
+
+	suite.Run("bundle simulation is not enabled", func() {
+		_, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bidTxBz})
+		suite.Require().Error(err)
+	})
+
+	suite.service.SetBundleSimulator(checkTxHandler)
+
+	suite.Run("valid bundle", func() {
+		resp, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bidTxBz})
+		suite.Require().NoError(err)
+		suite.Require().True(resp.Valid)
+		suite.Require().True(resp.WouldWin)
+		suite.Require().Empty(resp.Error)
+
+		hash := sha256.Sum256(bidTxBz)
+		suite.Require().Equal(hex.EncodeToString(hash[:]), resp.BidTx.Hash)
+		suite.Require().Equal(uint64(1000), resp.BidTx.GasWanted)
+		suite.Require().Equal(uint64(100), resp.BidTx.GasUsed)
+		suite.Require().Empty(resp.BidTx.Error)
+		suite.Require().Len(resp.BidTx.Events, 1)
+
+		suite.Require().Len(resp.BundledTxs, 2)
+		for index, bundledTx := range resp.BundledTxs {
+			hash := sha256.Sum256(bundleBz[index])
+			suite.Require().Equal(hex.EncodeToString(hash[:]), bundledTx.Hash)
+			suite.Require().Empty(bundledTx.Error)
+		}
+	})
+
+	suite.Run("messages are only executed if enabled", func() {
+		_, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bidTxBz, ExecuteMsgs: true})
+		suite.Require().Error(err)
+	})
+
+	checkTxHandler.SetMsgRouter(simulationMsgRouter{failingSigner: suite.accounts[1].Address})
+
+	suite.Run("failing messages", func() {
+		resp, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bidTxBz, ExecuteMsgs: true})
+		suite.Require().NoError(err)
+		suite.Require().False(resp.Valid)
+		suite.Require().False(resp.WouldWin)
+
+		suite.Require().Empty(resp.BidTx.Error)
+		suite.Require().Equal(uint64(150), resp.BidTx.GasUsed)
+		suite.Require().Len(resp.BidTx.Events, 2)
+
+		suite.Require().Empty(resp.BundledTxs[0].Error)
+		suite.Require().NotEmpty(resp.BundledTxs[1].Error)
+	})
+
+	suite.Run("failing ante handler", func() {
+		failingTxs[string(bundleBz[0])] = struct{}{}
+		defer delete(failingTxs, string(bundleBz[0]))
+
+		resp, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bidTxBz})
+		suite.Require().NoError(err)
+		suite.Require().False(resp.Valid)
+		suite.Require().False(resp.WouldWin)
+
+		// The remaining bundled transactions are still simulated.
+		suite.Require().NotEmpty(resp.BundledTxs[0].Error)
+		suite.Require().Empty(resp.BundledTxs[1].Error)
+		suite.Require().Equal(uint64(100), resp.BundledTxs[1].GasUsed)
+	})
+
+	suite.Run("bid fails check tx validation", func() {
+		failingTxs[string(bidTxBz)] = struct{}{}
+		defer delete(failingTxs, string(bidTxBz))
+
+		resp, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bidTxBz})
+		suite.Require().NoError(err)
+		suite.Require().False(resp.Valid)
+		suite.Require().False(resp.WouldWin)
+		suite.Require().NotEmpty(resp.Error)
+		suite.Require().NotEmpty(resp.BidTx.Error)
+	})
+
+	suite.Run("bid does not outbid the top bid", func() {
+		topBidTx, err := testutils.CreateAuctionTxWithSigners(
+			suite.encodingConfig.TxConfig,
+			suite.accounts[2],
+			sdk.NewCoin("stake", math.NewInt(200)),
+			0,
+			1000,
+			nil,
+		)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, topBidTx))
+
+		resp, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bidTxBz})
+		suite.Require().NoError(err)
+		suite.Require().True(resp.Valid)
+		suite.Require().False(resp.WouldWin)
+	})
+
+	suite.Run("not a bid tx", func() {
+		_, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{BidTx: bundleBz[0]})
+		suite.Require().Error(err)
+	})
+
+	suite.Run("empty bid tx", func() {
+		_, err := suite.service.SimulateBundle(suite.ctx, &types.QuerySimulateBundleRequest{})
+		suite.Require().Error(err)
+	})
+}
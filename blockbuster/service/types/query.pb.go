@@ -0,0 +1,4434 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pob/blockbuster/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	types1 "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LaneInfo defines the configuration and state of a single lane.
+type LaneInfo struct {
+	// name is the name of the lane.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_block_space is the relative percentage of block space the lane is
+	// allowed to consume. A value of zero means the lane is only limited by the
+	// remaining space in the block.
+	MaxBlockSpace cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_block_space,json=maxBlockSpace,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_space"`
+	// max_txs is the maximum number of transactions the lane's mempool can
+	// store. Zero means there is no limit and a negative value means the lane
+	// does not accept transactions.
+	MaxTxs int64 `protobuf:"varint,3,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty"`
+	// num_txs is the number of transactions currently in the lane.
+	NumTxs uint64 `protobuf:"varint,4,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+	// min_block_space is the relative percentage of block space reserved for
+	// the lane.
+	MinBlockSpace cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_block_space,json=minBlockSpace,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_block_space"`
+	// max_block_gas is the relative percentage of the block gas limit the lane
+	// can consume. Zero means the lane can consume all of the remaining gas.
+	MaxBlockGas cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_block_gas,json=maxBlockGas,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_gas"`
+}
+
+func (m *LaneInfo) Reset()         { *m = LaneInfo{} }
+func (m *LaneInfo) String() string { return proto.CompactTextString(m) }
+func (*LaneInfo) ProtoMessage()    {}
+func (*LaneInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{0}
+}
+func (m *LaneInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneInfo.Merge(m, src)
+}
+func (m *LaneInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneInfo proto.InternalMessageInfo
+
+func (m *LaneInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LaneInfo) GetMaxTxs() int64 {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return 0
+}
+
+func (m *LaneInfo) GetNumTxs() uint64 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+// QueryLanesRequest is the request type for the Query/Lanes RPC method.
+type QueryLanesRequest struct {
+}
+
+func (m *QueryLanesRequest) Reset()         { *m = QueryLanesRequest{} }
+func (m *QueryLanesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLanesRequest) ProtoMessage()    {}
+func (*QueryLanesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{1}
+}
+func (m *QueryLanesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLanesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLanesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLanesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLanesRequest.Merge(m, src)
+}
+func (m *QueryLanesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLanesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLanesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLanesRequest proto.InternalMessageInfo
+
+// QueryLanesResponse is the response type for the Query/Lanes RPC method.
+type QueryLanesResponse struct {
+	// lanes is the list of lanes in the mempool ordered by priority.
+	Lanes []LaneInfo `protobuf:"bytes,1,rep,name=lanes,proto3" json:"lanes"`
+}
+
+func (m *QueryLanesResponse) Reset()         { *m = QueryLanesResponse{} }
+func (m *QueryLanesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLanesResponse) ProtoMessage()    {}
+func (*QueryLanesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{2}
+}
+func (m *QueryLanesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLanesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLanesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLanesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLanesResponse.Merge(m, src)
+}
+func (m *QueryLanesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLanesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLanesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLanesResponse proto.InternalMessageInfo
+
+func (m *QueryLanesResponse) GetLanes() []LaneInfo {
+	if m != nil {
+		return m.Lanes
+	}
+	return nil
+}
+
+// QueryTxDistributionRequest is the request type for the Query/TxDistribution
+// RPC method.
+type QueryTxDistributionRequest struct {
+}
+
+func (m *QueryTxDistributionRequest) Reset()         { *m = QueryTxDistributionRequest{} }
+func (m *QueryTxDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxDistributionRequest) ProtoMessage()    {}
+func (*QueryTxDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{3}
+}
+func (m *QueryTxDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxDistributionRequest.Merge(m, src)
+}
+func (m *QueryTxDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxDistributionRequest proto.InternalMessageInfo
+
+// QueryTxDistributionResponse is the response type for the
+// Query/TxDistribution RPC method.
+type QueryTxDistributionResponse struct {
+	// distribution maps each lane name to the number of transactions it holds.
+	Distribution map[string]uint64 `protobuf:"bytes,1,rep,name=distribution,proto3" json:"distribution,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *QueryTxDistributionResponse) Reset()         { *m = QueryTxDistributionResponse{} }
+func (m *QueryTxDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxDistributionResponse) ProtoMessage()    {}
+func (*QueryTxDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{4}
+}
+func (m *QueryTxDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxDistributionResponse.Merge(m, src)
+}
+func (m *QueryTxDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryTxDistributionResponse) GetDistribution() map[string]uint64 {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+// QueryTxLaneRequest is the request type for the Query/TxLane RPC method.
+type QueryTxLaneRequest struct {
+	// tx is the raw bytes of the transaction.
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *QueryTxLaneRequest) Reset()         { *m = QueryTxLaneRequest{} }
+func (m *QueryTxLaneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxLaneRequest) ProtoMessage()    {}
+func (*QueryTxLaneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{5}
+}
+func (m *QueryTxLaneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxLaneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxLaneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxLaneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxLaneRequest.Merge(m, src)
+}
+func (m *QueryTxLaneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxLaneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxLaneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxLaneRequest proto.InternalMessageInfo
+
+func (m *QueryTxLaneRequest) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// QueryTxLaneResponse is the response type for the Query/TxLane RPC method.
+type QueryTxLaneResponse struct {
+	// lane is the name of the lane the transaction would be inserted into.
+	Lane string `protobuf:"bytes,1,opt,name=lane,proto3" json:"lane,omitempty"`
+}
+
+func (m *QueryTxLaneResponse) Reset()         { *m = QueryTxLaneResponse{} }
+func (m *QueryTxLaneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxLaneResponse) ProtoMessage()    {}
+func (*QueryTxLaneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{6}
+}
+func (m *QueryTxLaneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxLaneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxLaneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxLaneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxLaneResponse.Merge(m, src)
+}
+func (m *QueryTxLaneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxLaneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxLaneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxLaneResponse proto.InternalMessageInfo
+
+func (m *QueryTxLaneResponse) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+// QueryMempoolTxRequest is the request type for the Query/MempoolTx RPC method.
+type QueryMempoolTxRequest struct {
+	// hash is the hex-encoded SHA-256 hash of the transaction bytes.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryMempoolTxRequest) Reset()         { *m = QueryMempoolTxRequest{} }
+func (m *QueryMempoolTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMempoolTxRequest) ProtoMessage()    {}
+func (*QueryMempoolTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{7}
+}
+func (m *QueryMempoolTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMempoolTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMempoolTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMempoolTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMempoolTxRequest.Merge(m, src)
+}
+func (m *QueryMempoolTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMempoolTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMempoolTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMempoolTxRequest proto.InternalMessageInfo
+
+func (m *QueryMempoolTxRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryMempoolTxResponse is the response type for the Query/MempoolTx RPC
+// method.
+type QueryMempoolTxResponse struct {
+	// in_mempool is true if the transaction is currently in the mempool.
+	InMempool bool `protobuf:"varint,1,opt,name=in_mempool,json=inMempool,proto3" json:"in_mempool,omitempty"`
+	// lane is the name of the lane that holds the transaction. It is empty if
+	// the transaction is not in the mempool.
+	Lane string `protobuf:"bytes,2,opt,name=lane,proto3" json:"lane,omitempty"`
+}
+
+func (m *QueryMempoolTxResponse) Reset()         { *m = QueryMempoolTxResponse{} }
+func (m *QueryMempoolTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMempoolTxResponse) ProtoMessage()    {}
+func (*QueryMempoolTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{8}
+}
+func (m *QueryMempoolTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMempoolTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMempoolTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMempoolTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMempoolTxResponse.Merge(m, src)
+}
+func (m *QueryMempoolTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMempoolTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMempoolTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMempoolTxResponse proto.InternalMessageInfo
+
+func (m *QueryMempoolTxResponse) GetInMempool() bool {
+	if m != nil {
+		return m.InMempool
+	}
+	return false
+}
+
+func (m *QueryMempoolTxResponse) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+// FeePercentile defines the fee paid at a given percentile by transactions
+// included in a lane over recent blocks.
+type FeePercentile struct {
+	// percentile is the percentile in the range [1, 100].
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// fee is the fee paid at the percentile, computed independently for each
+	// denom.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *FeePercentile) Reset()         { *m = FeePercentile{} }
+func (m *FeePercentile) String() string { return proto.CompactTextString(m) }
+func (*FeePercentile) ProtoMessage()    {}
+func (*FeePercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{9}
+}
+func (m *FeePercentile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePercentile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePercentile.Merge(m, src)
+}
+func (m *FeePercentile) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePercentile proto.InternalMessageInfo
+
+func (m *FeePercentile) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *FeePercentile) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// FeeEstimate defines the fee estimate for a single lane.
+type FeeEstimate struct {
+	// lane is the name of the lane.
+	Lane string `protobuf:"bytes,1,opt,name=lane,proto3" json:"lane,omitempty"`
+	// max_tx_bytes is the number of bytes the lane may include in the next
+	// block.
+	MaxTxBytes int64 `protobuf:"varint,2,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty"`
+	// lane_full is true if the transactions currently in the lane exceed the
+	// number of bytes the lane may include in the next block.
+	LaneFull bool `protobuf:"varint,3,opt,name=lane_full,json=laneFull,proto3" json:"lane_full,omitempty"`
+	// marginal_fee is the fee of the lowest priority transaction that would be
+	// included in the next block. A transaction must pay more than this fee to
+	// be included when the lane is full. It is empty if the lane is not full.
+	MarginalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=marginal_fee,json=marginalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"marginal_fee"`
+	// num_blocks is the number of recent blocks the percentiles are computed
+	// over.
+	NumBlocks uint64 `protobuf:"varint,5,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// percentiles are the fees paid by transactions included in the lane over
+	// recent blocks.
+	Percentiles []FeePercentile `protobuf:"bytes,6,rep,name=percentiles,proto3" json:"percentiles"`
+}
+
+func (m *FeeEstimate) Reset()         { *m = FeeEstimate{} }
+func (m *FeeEstimate) String() string { return proto.CompactTextString(m) }
+func (*FeeEstimate) ProtoMessage()    {}
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{10}
+}
+func (m *FeeEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEstimate.Merge(m, src)
+}
+func (m *FeeEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEstimate proto.InternalMessageInfo
+
+func (m *FeeEstimate) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *FeeEstimate) GetMaxTxBytes() int64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+func (m *FeeEstimate) GetLaneFull() bool {
+	if m != nil {
+		return m.LaneFull
+	}
+	return false
+}
+
+func (m *FeeEstimate) GetMarginalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MarginalFee
+	}
+	return nil
+}
+
+func (m *FeeEstimate) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *FeeEstimate) GetPercentiles() []FeePercentile {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// QueryFeeEstimatesRequest is the request type for the Query/FeeEstimates RPC
+// method.
+type QueryFeeEstimatesRequest struct {
+	// lane is the name of the lane to estimate fees for. Fees are estimated for
+	// all lanes if it is empty.
+	Lane string `protobuf:"bytes,1,opt,name=lane,proto3" json:"lane,omitempty"`
+	// percentiles are the percentiles to compute over recent blocks. The
+	// default percentiles are used if it is empty.
+	Percentiles []uint32 `protobuf:"varint,2,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+	// max_tx_bytes is the maximum number of bytes in a block. The block max
+	// bytes from the consensus parameters is used if it is zero.
+	MaxTxBytes int64 `protobuf:"varint,3,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty"`
+}
+
+func (m *QueryFeeEstimatesRequest) Reset()         { *m = QueryFeeEstimatesRequest{} }
+func (m *QueryFeeEstimatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEstimatesRequest) ProtoMessage()    {}
+func (*QueryFeeEstimatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{11}
+}
+func (m *QueryFeeEstimatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeEstimatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEstimatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeEstimatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEstimatesRequest.Merge(m, src)
+}
+func (m *QueryFeeEstimatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeEstimatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEstimatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeEstimatesRequest proto.InternalMessageInfo
+
+func (m *QueryFeeEstimatesRequest) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *QueryFeeEstimatesRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+func (m *QueryFeeEstimatesRequest) GetMaxTxBytes() int64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+// QueryFeeEstimatesResponse is the response type for the Query/FeeEstimates
+// RPC method.
+type QueryFeeEstimatesResponse struct {
+	// estimates are the fee estimates for each requested lane.
+	Estimates []FeeEstimate `protobuf:"bytes,1,rep,name=estimates,proto3" json:"estimates"`
+}
+
+func (m *QueryFeeEstimatesResponse) Reset()         { *m = QueryFeeEstimatesResponse{} }
+func (m *QueryFeeEstimatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeEstimatesResponse) ProtoMessage()    {}
+func (*QueryFeeEstimatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{12}
+}
+func (m *QueryFeeEstimatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeEstimatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeEstimatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeEstimatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeEstimatesResponse.Merge(m, src)
+}
+func (m *QueryFeeEstimatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeEstimatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeEstimatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeEstimatesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeEstimatesResponse) GetEstimates() []FeeEstimate {
+	if m != nil {
+		return m.Estimates
+	}
+	return nil
+}
+
+// QuerySimulateBundleRequest is the request type for the Query/SimulateBundle
+// RPC method.
+type QuerySimulateBundleRequest struct {
+	// bid_tx is the raw bytes of the bid transaction.
+	BidTx []byte `protobuf:"bytes,1,opt,name=bid_tx,json=bidTx,proto3" json:"bid_tx,omitempty"`
+	// execute_msgs determines whether the messages of each transaction are
+	// executed after the ante handler. If false, only the ante handler is run.
+	ExecuteMsgs bool `protobuf:"varint,2,opt,name=execute_msgs,json=executeMsgs,proto3" json:"execute_msgs,omitempty"`
+}
+
+func (m *QuerySimulateBundleRequest) Reset()         { *m = QuerySimulateBundleRequest{} }
+func (m *QuerySimulateBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBundleRequest) ProtoMessage()    {}
+func (*QuerySimulateBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{13}
+}
+func (m *QuerySimulateBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBundleRequest.Merge(m, src)
+}
+func (m *QuerySimulateBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateBundleRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateBundleRequest) GetBidTx() []byte {
+	if m != nil {
+		return m.BidTx
+	}
+	return nil
+}
+
+func (m *QuerySimulateBundleRequest) GetExecuteMsgs() bool {
+	if m != nil {
+		return m.ExecuteMsgs
+	}
+	return false
+}
+
+// TxSimulationResult defines the outcome of simulating a single transaction.
+type TxSimulationResult struct {
+	// hash is the hex-encoded SHA-256 hash of the transaction bytes.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// gas_wanted is the gas limit of the transaction.
+	GasWanted uint64 `protobuf:"varint,2,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the transaction.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error is the error returned by the transaction. It is empty if the
+	// transaction succeeded.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// events are the events emitted by the transaction.
+	Events []types1.Event `protobuf:"bytes,5,rep,name=events,proto3" json:"events"`
+}
+
+func (m *TxSimulationResult) Reset()         { *m = TxSimulationResult{} }
+func (m *TxSimulationResult) String() string { return proto.CompactTextString(m) }
+func (*TxSimulationResult) ProtoMessage()    {}
+func (*TxSimulationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{14}
+}
+func (m *TxSimulationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSimulationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSimulationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSimulationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSimulationResult.Merge(m, src)
+}
+func (m *TxSimulationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSimulationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSimulationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSimulationResult proto.InternalMessageInfo
+
+func (m *TxSimulationResult) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TxSimulationResult) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *TxSimulationResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TxSimulationResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TxSimulationResult) GetEvents() []types1.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// QuerySimulateBundleResponse is the response type for the Query/SimulateBundle
+// RPC method.
+type QuerySimulateBundleResponse struct {
+	// bid_tx is the outcome of the bid transaction.
+	BidTx TxSimulationResult `protobuf:"bytes,1,opt,name=bid_tx,json=bidTx,proto3" json:"bid_tx"`
+	// bundled_txs are the outcomes of the bundled transactions in the order
+	// they are included in the bid.
+	BundledTxs []TxSimulationResult `protobuf:"bytes,2,rep,name=bundled_txs,json=bundledTxs,proto3" json:"bundled_txs"`
+	// valid is true if the bid passes the same validation as in CheckTx and the
+	// bid transaction and all of its bundled transactions succeeded.
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// would_win is true if the bundle is valid and the bid ranks ahead of the
+	// current top bid in the mempool.
+	WouldWin bool `protobuf:"varint,4,opt,name=would_win,json=wouldWin,proto3" json:"would_win,omitempty"`
+	// error is the error returned when the bid is validated as in CheckTx. It is
+	// empty if the bid would be accepted into the mempool.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QuerySimulateBundleResponse) Reset()         { *m = QuerySimulateBundleResponse{} }
+func (m *QuerySimulateBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBundleResponse) ProtoMessage()    {}
+func (*QuerySimulateBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_271a8ddc471566be, []int{15}
+}
+func (m *QuerySimulateBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBundleResponse.Merge(m, src)
+}
+func (m *QuerySimulateBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateBundleResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateBundleResponse) GetBidTx() TxSimulationResult {
+	if m != nil {
+		return m.BidTx
+	}
+	return TxSimulationResult{}
+}
+
+func (m *QuerySimulateBundleResponse) GetBundledTxs() []TxSimulationResult {
+	if m != nil {
+		return m.BundledTxs
+	}
+	return nil
+}
+
+func (m *QuerySimulateBundleResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QuerySimulateBundleResponse) GetWouldWin() bool {
+	if m != nil {
+		return m.WouldWin
+	}
+	return false
+}
+
+func (m *QuerySimulateBundleResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LaneInfo)(nil), "pob.blockbuster.v1.LaneInfo")
+	proto.RegisterType((*QueryLanesRequest)(nil), "pob.blockbuster.v1.QueryLanesRequest")
+	proto.RegisterType((*QueryLanesResponse)(nil), "pob.blockbuster.v1.QueryLanesResponse")
+	proto.RegisterType((*QueryTxDistributionRequest)(nil), "pob.blockbuster.v1.QueryTxDistributionRequest")
+	proto.RegisterType((*QueryTxDistributionResponse)(nil), "pob.blockbuster.v1.QueryTxDistributionResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "pob.blockbuster.v1.QueryTxDistributionResponse.DistributionEntry")
+	proto.RegisterType((*QueryTxLaneRequest)(nil), "pob.blockbuster.v1.QueryTxLaneRequest")
+	proto.RegisterType((*QueryTxLaneResponse)(nil), "pob.blockbuster.v1.QueryTxLaneResponse")
+	proto.RegisterType((*QueryMempoolTxRequest)(nil), "pob.blockbuster.v1.QueryMempoolTxRequest")
+	proto.RegisterType((*QueryMempoolTxResponse)(nil), "pob.blockbuster.v1.QueryMempoolTxResponse")
+	proto.RegisterType((*FeePercentile)(nil), "pob.blockbuster.v1.FeePercentile")
+	proto.RegisterType((*FeeEstimate)(nil), "pob.blockbuster.v1.FeeEstimate")
+	proto.RegisterType((*QueryFeeEstimatesRequest)(nil), "pob.blockbuster.v1.QueryFeeEstimatesRequest")
+	proto.RegisterType((*QueryFeeEstimatesResponse)(nil), "pob.blockbuster.v1.QueryFeeEstimatesResponse")
+	proto.RegisterType((*QuerySimulateBundleRequest)(nil), "pob.blockbuster.v1.QuerySimulateBundleRequest")
+	proto.RegisterType((*TxSimulationResult)(nil), "pob.blockbuster.v1.TxSimulationResult")
+	proto.RegisterType((*QuerySimulateBundleResponse)(nil), "pob.blockbuster.v1.QuerySimulateBundleResponse")
+}
+
+func init() { proto.RegisterFile("pob/blockbuster/v1/query.proto", fileDescriptor_271a8ddc471566be) }
+
+var fileDescriptor_271a8ddc471566be = []byte{
+	// 1271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0xf7, 0x57, 0x77, 0xdf, 0x26, 0xfd, 0x7e, 0x3b, 0xfd, 0x41, 0xb2, 0x29, 0x9b, 0x8d,
+	0x0b, 0x61, 0x5b, 0x88, 0x4d, 0x0a, 0x87, 0xaa, 0x17, 0x44, 0xda, 0x06, 0x55, 0x6d, 0x11, 0xb8,
+	0x29, 0x15, 0x48, 0xc8, 0xf8, 0xc7, 0xc4, 0x19, 0xc5, 0x1e, 0x6f, 0x3d, 0xe3, 0xad, 0x57, 0x88,
+	0x03, 0x1c, 0x39, 0x20, 0x24, 0x24, 0x2e, 0x9c, 0xb8, 0x72, 0xe0, 0xc4, 0x89, 0x3b, 0x52, 0x8f,
+	0x15, 0x5c, 0x10, 0x12, 0x05, 0xb5, 0x9c, 0xf9, 0x03, 0x38, 0xa1, 0x19, 0xcf, 0x6e, 0xbc, 0x89,
+	0xd3, 0xa6, 0x15, 0xa7, 0xf5, 0xbc, 0xf7, 0xe6, 0xbd, 0xcf, 0xfb, 0xcc, 0xfb, 0xb1, 0xd0, 0x1d,
+	0xc4, 0xae, 0xe9, 0x86, 0xb1, 0xb7, 0xe3, 0xa6, 0x8c, 0xe3, 0xc4, 0x1c, 0xae, 0x99, 0x77, 0x52,
+	0x9c, 0x8c, 0x8c, 0x41, 0x12, 0xf3, 0x18, 0xa1, 0x41, 0xec, 0x1a, 0x05, 0xbd, 0x31, 0x5c, 0xeb,
+	0x9c, 0x08, 0xe2, 0x20, 0x96, 0x6a, 0x53, 0x7c, 0xe5, 0x96, 0x9d, 0xd3, 0x41, 0x1c, 0x07, 0x21,
+	0x36, 0x9d, 0x01, 0x31, 0x1d, 0x4a, 0x63, 0xee, 0x70, 0x12, 0x53, 0xa6, 0xb4, 0x0b, 0x5e, 0xcc,
+	0xa2, 0x98, 0xd9, 0xf9, 0xb5, 0xfc, 0xa0, 0x54, 0xdd, 0xfc, 0x64, 0xba, 0x0e, 0xc3, 0xe6, 0x70,
+	0xcd, 0xc5, 0xdc, 0x59, 0x33, 0xbd, 0x98, 0x50, 0xa5, 0x5f, 0xe4, 0x98, 0xfa, 0x38, 0x89, 0x08,
+	0xe5, 0xa6, 0xe3, 0x7a, 0xc4, 0xe4, 0xa3, 0x01, 0x56, 0x97, 0xf5, 0xdf, 0x2b, 0xd0, 0xbc, 0xee,
+	0x50, 0x7c, 0x95, 0x6e, 0xc5, 0x08, 0x41, 0x8d, 0x3a, 0x11, 0x9e, 0xd7, 0x7a, 0x5a, 0xbf, 0x65,
+	0xc9, 0x6f, 0xf4, 0x3e, 0xfc, 0x2f, 0x72, 0x32, 0x5b, 0xa6, 0x60, 0xb3, 0x81, 0xe3, 0xe1, 0xf9,
+	0x8a, 0x50, 0xaf, 0xaf, 0xdd, 0x7b, 0xb0, 0x34, 0xf3, 0xdb, 0x83, 0xa5, 0xc5, 0x3c, 0x3c, 0xf3,
+	0x77, 0x0c, 0x12, 0x9b, 0x91, 0xc3, 0xb7, 0x8d, 0xeb, 0x38, 0x70, 0xbc, 0xd1, 0x65, 0xec, 0xfd,
+	0xfc, 0xc3, 0x2a, 0x28, 0xac, 0x97, 0xb1, 0x67, 0xcd, 0x45, 0x4e, 0xb6, 0x2e, 0x1c, 0xdd, 0x14,
+	0x7e, 0xd0, 0x73, 0x70, 0x44, 0xb8, 0xe6, 0x19, 0x9b, 0xaf, 0xf6, 0xb4, 0x7e, 0xd5, 0x6a, 0x44,
+	0x4e, 0xb6, 0x99, 0x31, 0xa1, 0xa0, 0x69, 0x24, 0x15, 0xb5, 0x9e, 0xd6, 0xaf, 0x59, 0x0d, 0x9a,
+	0x46, 0x42, 0x21, 0xc0, 0x10, 0x3a, 0x05, 0xa6, 0xfe, 0xec, 0x60, 0x08, 0x2d, 0x80, 0xb9, 0x05,
+	0x73, 0xbb, 0x79, 0x06, 0x0e, 0x9b, 0x6f, 0x3c, 0xab, 0xe3, 0xf6, 0x38, 0xcb, 0xb7, 0x1c, 0xa6,
+	0x1f, 0x87, 0x63, 0xef, 0x8a, 0x72, 0x10, 0x1c, 0x33, 0x0b, 0xdf, 0x49, 0x31, 0xe3, 0xfa, 0xdb,
+	0x80, 0x8a, 0x42, 0x36, 0x88, 0x29, 0xc3, 0xe8, 0x02, 0xd4, 0x43, 0x21, 0x98, 0xd7, 0x7a, 0xd5,
+	0x7e, 0xfb, 0xfc, 0x69, 0x63, 0x7f, 0xe9, 0x18, 0xe3, 0xa7, 0x5a, 0xaf, 0x09, 0x5c, 0x56, 0x7e,
+	0x41, 0x3f, 0x0d, 0x1d, 0xe9, 0x6f, 0x33, 0xbb, 0x4c, 0x18, 0x4f, 0x88, 0x9b, 0x8a, 0xd2, 0x19,
+	0x47, 0xfb, 0x49, 0x83, 0xc5, 0x52, 0xb5, 0x8a, 0x8b, 0x61, 0xd6, 0x2f, 0xc8, 0x55, 0xf8, 0x37,
+	0xcb, 0xc2, 0x3f, 0xc6, 0x8d, 0x51, 0x14, 0x5e, 0xa1, 0x3c, 0x19, 0x59, 0x53, 0x6e, 0x3b, 0x6f,
+	0xc0, 0xb1, 0x7d, 0x26, 0xe8, 0xff, 0x50, 0xdd, 0xc1, 0x23, 0x55, 0x70, 0xe2, 0x13, 0x9d, 0x80,
+	0xfa, 0xd0, 0x09, 0xd3, 0xbc, 0xca, 0x6a, 0x56, 0x7e, 0xb8, 0x58, 0xb9, 0xa0, 0xe9, 0x2f, 0x28,
+	0xd6, 0x36, 0x33, 0xc1, 0x82, 0xca, 0x0e, 0x1d, 0x85, 0x0a, 0xcf, 0xa4, 0x83, 0x59, 0xab, 0xc2,
+	0x33, 0xfd, 0x2c, 0x1c, 0x9f, 0xb2, 0x52, 0x49, 0x22, 0xa8, 0x09, 0xae, 0xc6, 0xa5, 0x2d, 0xbe,
+	0xf5, 0x97, 0xe1, 0xa4, 0x34, 0xbd, 0x81, 0xa3, 0x41, 0x1c, 0x87, 0x9b, 0xd9, 0xd8, 0x27, 0x82,
+	0xda, 0xb6, 0xc3, 0xb6, 0xc7, 0xc6, 0xe2, 0x5b, 0xbf, 0x06, 0xa7, 0xf6, 0x1a, 0x2b, 0xd7, 0xcf,
+	0x03, 0x10, 0x6a, 0x47, 0xb9, 0x5c, 0xde, 0x69, 0x5a, 0x2d, 0x42, 0x95, 0xe1, 0x24, 0x72, 0xa5,
+	0x10, 0xf9, 0x0b, 0x0d, 0xe6, 0x36, 0x30, 0x7e, 0x07, 0x27, 0x1e, 0xa6, 0x9c, 0x84, 0x18, 0x75,
+	0x01, 0x06, 0x93, 0x93, 0x74, 0x32, 0x67, 0x15, 0x24, 0xe8, 0x43, 0xa8, 0x6e, 0x61, 0xe1, 0x44,
+	0xbc, 0xcd, 0x82, 0xa1, 0xca, 0x4d, 0xb4, 0xbc, 0xa1, 0x5a, 0xde, 0xb8, 0x14, 0x13, 0xba, 0xfe,
+	0xaa, 0xa8, 0x8b, 0xef, 0xfe, 0x58, 0xea, 0x07, 0x84, 0x6f, 0xa7, 0xae, 0xe1, 0xc5, 0x91, 0x9a,
+	0x16, 0xea, 0x67, 0x95, 0xf9, 0x3b, 0x6a, 0x02, 0x88, 0x0b, 0xcc, 0x12, 0x7e, 0xf5, 0x1f, 0x2b,
+	0xd0, 0xde, 0xc0, 0xf8, 0x0a, 0xe3, 0x24, 0x72, 0x78, 0x29, 0x5d, 0xa8, 0x07, 0xb3, 0x79, 0xbb,
+	0xda, 0xee, 0x88, 0x63, 0x26, 0x13, 0xaa, 0x5a, 0x20, 0x7b, 0x76, 0x5d, 0x48, 0xd0, 0x22, 0xb4,
+	0x84, 0xa5, 0xbd, 0x95, 0x86, 0xa1, 0x6c, 0xe9, 0xa6, 0xd5, 0x14, 0x82, 0x8d, 0x34, 0x0c, 0x11,
+	0x15, 0xd7, 0x93, 0x80, 0x50, 0x27, 0xb4, 0x45, 0x2a, 0xb5, 0xff, 0x3e, 0x95, 0xf6, 0x38, 0xc0,
+	0x06, 0x96, 0xcf, 0x22, 0x86, 0x88, 0xac, 0x60, 0x26, 0xc7, 0x44, 0xcd, 0x6a, 0xd1, 0x34, 0x92,
+	0xad, 0xc9, 0xd0, 0x55, 0x68, 0xef, 0xd2, 0x2b, 0xba, 0x5d, 0xa0, 0x59, 0x2e, 0x2b, 0xfa, 0xa9,
+	0x87, 0x52, 0x8d, 0x57, 0xbc, 0xab, 0x27, 0x30, 0x2f, 0x4b, 0xa3, 0x40, 0x20, 0x2b, 0x94, 0x52,
+	0x09, 0x91, 0x53, 0xa1, 0xc5, 0x9b, 0xce, 0x4d, 0x79, 0xdc, 0x47, 0x75, 0x75, 0x2f, 0xd5, 0xfa,
+	0x47, 0xb0, 0x50, 0x12, 0x53, 0x55, 0xe4, 0x25, 0x68, 0xe1, 0xb1, 0x50, 0xb5, 0xf3, 0xd2, 0x01,
+	0x99, 0x8d, 0x2f, 0xab, 0xbc, 0x76, 0xef, 0xe9, 0xef, 0xa9, 0xa1, 0x72, 0x93, 0x44, 0x69, 0x28,
+	0x2c, 0x52, 0xea, 0x87, 0x93, 0xb6, 0x3b, 0x09, 0x0d, 0x97, 0xf8, 0xf6, 0xa4, 0xf5, 0xea, 0x2e,
+	0xf1, 0x37, 0x33, 0xb4, 0x0c, 0xb3, 0x38, 0xc3, 0x5e, 0xca, 0xb1, 0x1d, 0xb1, 0x20, 0xaf, 0x91,
+	0xa6, 0xd5, 0x56, 0xb2, 0x1b, 0x2c, 0x60, 0xfa, 0xf7, 0x1a, 0xa0, 0xcd, 0x4c, 0x79, 0xcd, 0x07,
+	0x48, 0x1a, 0x96, 0xf6, 0x9c, 0x78, 0xc2, 0xc0, 0x61, 0xf6, 0x5d, 0x87, 0x72, 0xec, 0xab, 0x81,
+	0xd0, 0x0a, 0x1c, 0x76, 0x5b, 0x0a, 0xd0, 0x02, 0x34, 0x85, 0x3a, 0x65, 0xd8, 0x97, 0x0c, 0xd5,
+	0xac, 0x23, 0x81, 0xc3, 0x6e, 0x31, 0xec, 0x8b, 0x29, 0x82, 0x93, 0x24, 0x4e, 0xe4, 0xfe, 0x68,
+	0x59, 0xf9, 0x01, 0xbd, 0x0e, 0x0d, 0x3c, 0xc4, 0x94, 0x8b, 0x72, 0x10, 0xa4, 0x9c, 0x32, 0x76,
+	0x57, 0xa3, 0x21, 0x56, 0xa3, 0x71, 0x45, 0xa8, 0x15, 0x17, 0xca, 0x56, 0xff, 0x67, 0x3c, 0x3f,
+	0xf7, 0x32, 0x31, 0x61, 0xbb, 0x48, 0x45, 0xfb, 0xfc, 0x4a, 0x19, 0xd5, 0xfb, 0x33, 0x1e, 0x8f,
+	0xf0, 0x9c, 0xb8, 0x1b, 0xd0, 0x76, 0xa5, 0x5b, 0x5f, 0xae, 0xbd, 0xbc, 0xcf, 0x9f, 0xce, 0x13,
+	0x28, 0x07, 0x62, 0x51, 0xe6, 0x53, 0x94, 0xf8, 0xaa, 0x0b, 0xf3, 0x83, 0xe8, 0xcf, 0xbb, 0x71,
+	0x1a, 0xfa, 0xf6, 0x5d, 0x42, 0x25, 0x33, 0x4d, 0xab, 0x29, 0x05, 0xb7, 0x09, 0xdd, 0xa5, 0xac,
+	0x5e, 0xa0, 0xec, 0xfc, 0xdf, 0x0d, 0xa8, 0xcb, 0xe4, 0xd1, 0x08, 0xea, 0x72, 0x5f, 0xa1, 0x17,
+	0x0f, 0xdc, 0x0c, 0xc5, 0x25, 0xd7, 0x59, 0x79, 0x92, 0x59, 0x4e, 0x9f, 0xbe, 0xfc, 0xd9, 0x2f,
+	0x7f, 0x7d, 0x55, 0x59, 0x44, 0x0b, 0x66, 0xc9, 0x5f, 0x29, 0xb9, 0xdf, 0xd0, 0x37, 0x1a, 0x1c,
+	0x9d, 0xde, 0x3a, 0xc8, 0x38, 0xf4, 0x7a, 0xca, 0xd1, 0x98, 0x4f, 0xb9, 0xce, 0xf4, 0xbe, 0x84,
+	0xa5, 0xa3, 0x5e, 0x19, 0xac, 0xe2, 0x62, 0x43, 0x9f, 0x6a, 0xd0, 0xc8, 0xb7, 0x0d, 0x5a, 0x79,
+	0x4c, 0x94, 0xc2, 0xd2, 0xea, 0xbc, 0xf4, 0x44, 0x3b, 0x85, 0x62, 0x45, 0xa2, 0xe8, 0x5d, 0xd4,
+	0xce, 0xe9, 0x8b, 0x65, 0x40, 0x78, 0x66, 0xcb, 0x91, 0xf2, 0xb9, 0x06, 0xad, 0xc9, 0x66, 0x42,
+	0x67, 0x0f, 0x74, 0xbf, 0x77, 0xd5, 0x75, 0xce, 0x1d, 0xc6, 0x74, 0x1a, 0x0c, 0xea, 0x96, 0x23,
+	0x61, 0xe6, 0xc7, 0xa2, 0x6b, 0x3f, 0x41, 0x5f, 0x6b, 0x30, 0x5b, 0x9c, 0x4b, 0xe8, 0x95, 0x03,
+	0x83, 0x94, 0x8c, 0xcc, 0xce, 0xea, 0x21, 0xad, 0x15, 0xaa, 0xb3, 0x12, 0xd5, 0x19, 0xb4, 0x5c,
+	0x86, 0x6a, 0x0b, 0x63, 0x7b, 0x32, 0xd2, 0xd0, 0xb7, 0x1a, 0x1c, 0x9d, 0x6e, 0xe2, 0xc7, 0xd4,
+	0x51, 0xe9, 0xdc, 0xeb, 0x98, 0x87, 0xb6, 0x57, 0xf0, 0x0c, 0x09, 0xaf, 0x2f, 0x5e, 0xf0, 0x4c,
+	0x19, 0x42, 0xa6, 0xae, 0xd9, 0x79, 0xf7, 0xae, 0x5f, 0xbb, 0xf7, 0xb0, 0xab, 0xdd, 0x7f, 0xd8,
+	0xd5, 0xfe, 0x7c, 0xd8, 0xd5, 0xbe, 0x7c, 0xd4, 0x9d, 0xb9, 0xff, 0xa8, 0x3b, 0xf3, 0xeb, 0xa3,
+	0xee, 0xcc, 0x07, 0x6b, 0x85, 0x3d, 0xc8, 0x76, 0xc8, 0x60, 0x35, 0xc2, 0xc3, 0x7d, 0x1e, 0x19,
+	0x4e, 0x86, 0xc4, 0xc3, 0xf9, 0x5a, 0x74, 0x1b, 0xf2, 0x4f, 0xfe, 0x6b, 0xff, 0x0e, 0x00, 0x24,
+	0xb9, 0x41, 0x96, 0xa6, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Lanes queries the configuration and current size of each lane in the
+	// mempool. Lanes are returned in the order they are registered.
+	Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error)
+	// TxDistribution queries the number of transactions in each lane.
+	TxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error)
+	// TxLane queries the lane that a transaction would be inserted into.
+	TxLane(ctx context.Context, in *QueryTxLaneRequest, opts ...grpc.CallOption) (*QueryTxLaneResponse, error)
+	// MempoolTx queries whether a transaction with the given hash is currently
+	// in the mempool and, if so, which lane it is stored in.
+	MempoolTx(ctx context.Context, in *QueryMempoolTxRequest, opts ...grpc.CallOption) (*QueryMempoolTxResponse, error)
+	// FeeEstimates queries the estimated fee required for a transaction to be
+	// included in each lane in the next block alongside fee percentiles over
+	// recent blocks.
+	FeeEstimates(ctx context.Context, in *QueryFeeEstimatesRequest, opts ...grpc.CallOption) (*QueryFeeEstimatesResponse, error)
+	// SimulateBundle simulates a bid transaction and its bundled transactions
+	// against the latest committed state without submitting the bid. It returns
+	// the outcome of each transaction and whether the bid would currently win
+	// the auction.
+	SimulateBundle(ctx context.Context, in *QuerySimulateBundleRequest, opts ...grpc.CallOption) (*QuerySimulateBundleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error) {
+	out := new(QueryLanesResponse)
+	err := c.cc.Invoke(ctx, "/pob.blockbuster.v1.Query/Lanes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error) {
+	out := new(QueryTxDistributionResponse)
+	err := c.cc.Invoke(ctx, "/pob.blockbuster.v1.Query/TxDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxLane(ctx context.Context, in *QueryTxLaneRequest, opts ...grpc.CallOption) (*QueryTxLaneResponse, error) {
+	out := new(QueryTxLaneResponse)
+	err := c.cc.Invoke(ctx, "/pob.blockbuster.v1.Query/TxLane", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MempoolTx(ctx context.Context, in *QueryMempoolTxRequest, opts ...grpc.CallOption) (*QueryMempoolTxResponse, error) {
+	out := new(QueryMempoolTxResponse)
+	err := c.cc.Invoke(ctx, "/pob.blockbuster.v1.Query/MempoolTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeEstimates(ctx context.Context, in *QueryFeeEstimatesRequest, opts ...grpc.CallOption) (*QueryFeeEstimatesResponse, error) {
+	out := new(QueryFeeEstimatesResponse)
+	err := c.cc.Invoke(ctx, "/pob.blockbuster.v1.Query/FeeEstimates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateBundle(ctx context.Context, in *QuerySimulateBundleRequest, opts ...grpc.CallOption) (*QuerySimulateBundleResponse, error) {
+	out := new(QuerySimulateBundleResponse)
+	err := c.cc.Invoke(ctx, "/pob.blockbuster.v1.Query/SimulateBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Lanes queries the configuration and current size of each lane in the
+	// mempool. Lanes are returned in the order they are registered.
+	Lanes(context.Context, *QueryLanesRequest) (*QueryLanesResponse, error)
+	// TxDistribution queries the number of transactions in each lane.
+	TxDistribution(context.Context, *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error)
+	// TxLane queries the lane that a transaction would be inserted into.
+	TxLane(context.Context, *QueryTxLaneRequest) (*QueryTxLaneResponse, error)
+	// MempoolTx queries whether a transaction with the given hash is currently
+	// in the mempool and, if so, which lane it is stored in.
+	MempoolTx(context.Context, *QueryMempoolTxRequest) (*QueryMempoolTxResponse, error)
+	// FeeEstimates queries the estimated fee required for a transaction to be
+	// included in each lane in the next block alongside fee percentiles over
+	// recent blocks.
+	FeeEstimates(context.Context, *QueryFeeEstimatesRequest) (*QueryFeeEstimatesResponse, error)
+	// SimulateBundle simulates a bid transaction and its bundled transactions
+	// against the latest committed state without submitting the bid. It returns
+	// the outcome of each transaction and whether the bid would currently win
+	// the auction.
+	SimulateBundle(context.Context, *QuerySimulateBundleRequest) (*QuerySimulateBundleResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Lanes(ctx context.Context, req *QueryLanesRequest) (*QueryLanesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lanes not implemented")
+}
+func (*UnimplementedQueryServer) TxDistribution(ctx context.Context, req *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxDistribution not implemented")
+}
+func (*UnimplementedQueryServer) TxLane(ctx context.Context, req *QueryTxLaneRequest) (*QueryTxLaneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxLane not implemented")
+}
+func (*UnimplementedQueryServer) MempoolTx(ctx context.Context, req *QueryMempoolTxRequest) (*QueryMempoolTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MempoolTx not implemented")
+}
+func (*UnimplementedQueryServer) FeeEstimates(ctx context.Context, req *QueryFeeEstimatesRequest) (*QueryFeeEstimatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEstimates not implemented")
+}
+func (*UnimplementedQueryServer) SimulateBundle(ctx context.Context, req *QuerySimulateBundleRequest) (*QuerySimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Lanes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLanesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lanes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.blockbuster.v1.Query/Lanes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lanes(ctx, req.(*QueryLanesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.blockbuster.v1.Query/TxDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxDistribution(ctx, req.(*QueryTxDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxLane_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxLaneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxLane(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.blockbuster.v1.Query/TxLane",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxLane(ctx, req.(*QueryTxLaneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MempoolTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMempoolTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MempoolTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.blockbuster.v1.Query/MempoolTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MempoolTx(ctx, req.(*QueryMempoolTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeEstimates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEstimatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEstimates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.blockbuster.v1.Query/FeeEstimates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEstimates(ctx, req.(*QueryFeeEstimatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.blockbuster.v1.Query/SimulateBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBundle(ctx, req.(*QuerySimulateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pob.blockbuster.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lanes",
+			Handler:    _Query_Lanes_Handler,
+		},
+		{
+			MethodName: "TxDistribution",
+			Handler:    _Query_TxDistribution_Handler,
+		},
+		{
+			MethodName: "TxLane",
+			Handler:    _Query_TxLane_Handler,
+		},
+		{
+			MethodName: "MempoolTx",
+			Handler:    _Query_MempoolTx_Handler,
+		},
+		{
+			MethodName: "FeeEstimates",
+			Handler:    _Query_FeeEstimates_Handler,
+		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Query_SimulateBundle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pob/blockbuster/v1/query.proto",
+}
+
+func (m *LaneInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxBlockGas.Size()
+		i -= size
+		if _, err := m.MaxBlockGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinBlockSpace.Size()
+		i -= size
+		if _, err := m.MinBlockSpace.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NumTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTxs))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxBlockSpace.Size()
+		i -= size
+		if _, err := m.MaxBlockSpace.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLanesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLanesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLanesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLanesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLanesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLanesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lanes) > 0 {
+		for iNdEx := len(m.Lanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for k := range m.Distribution {
+			v := m.Distribution[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxLaneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxLaneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxLaneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxLaneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxLaneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxLaneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMempoolTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMempoolTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMempoolTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMempoolTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMempoolTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMempoolTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InMempool {
+		i--
+		if m.InMempool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePercentile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePercentile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePercentile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Percentile != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Percentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MarginalFee) > 0 {
+		for iNdEx := len(m.MarginalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarginalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LaneFull {
+		i--
+		if m.LaneFull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEstimatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEstimatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEstimatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Percentiles) > 0 {
+		dAtA2 := make([]byte, len(m.Percentiles)*10)
+		var j1 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEstimatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEstimatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEstimatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Estimates) > 0 {
+		for iNdEx := len(m.Estimates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Estimates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteMsgs {
+		i--
+		if m.ExecuteMsgs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BidTx) > 0 {
+		i -= len(m.BidTx)
+		copy(dAtA[i:], m.BidTx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidTx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSimulationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSimulationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSimulationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WouldWin {
+		i--
+		if m.WouldWin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BundledTxs) > 0 {
+		for iNdEx := len(m.BundledTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundledTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.BidTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LaneInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MaxBlockSpace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxTxs != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTxs))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovQuery(uint64(m.NumTxs))
+	}
+	l = m.MinBlockSpace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxBlockGas.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLanesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLanesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lanes) > 0 {
+		for _, e := range m.Lanes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTxDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for k, v := range m.Distribution {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxLaneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLaneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMempoolTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMempoolTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InMempool {
+		n += 2
+	}
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FeePercentile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovQuery(uint64(m.Percentile))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTxBytes))
+	}
+	if m.LaneFull {
+		n += 2
+	}
+	if len(m.MarginalFee) > 0 {
+		for _, e := range m.MarginalFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.NumBlocks))
+	}
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeEstimatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTxBytes))
+	}
+	return n
+}
+
+func (m *QueryFeeEstimatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Estimates) > 0 {
+		for _, e := range m.Estimates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BidTx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExecuteMsgs {
+		n += 2
+	}
+	return n
+}
+
+func (m *TxSimulationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovQuery(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BidTx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.BundledTxs) > 0 {
+		for _, e := range m.BundledTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Valid {
+		n += 2
+	}
+	if m.WouldWin {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LaneInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSpace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockSpace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
+			}
+			m.MaxTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockSpace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBlockSpace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLanesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLanesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLanesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLanesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLanesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLanesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lanes = append(m.Lanes, LaneInfo{})
+			if err := m.Lanes[len(m.Lanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Distribution == nil {
+				m.Distribution = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Distribution[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxLaneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxLaneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxLaneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxLaneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxLaneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxLaneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMempoolTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMempoolTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMempoolTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMempoolTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMempoolTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMempoolTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InMempool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InMempool = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePercentile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePercentile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
+			}
+			m.MaxTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaneFull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LaneFull = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarginalFee = append(m.MarginalFee, types.Coin{})
+			if err := m.MarginalFee[len(m.MarginalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentiles = append(m.Percentiles, FeePercentile{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEstimatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEstimatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEstimatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
+			}
+			m.MaxTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEstimatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEstimatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEstimatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Estimates = append(m.Estimates, FeeEstimate{})
+			if err := m.Estimates[len(m.Estimates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidTx = append(m.BidTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.BidTx == nil {
+				m.BidTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteMsgs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecuteMsgs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSimulationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSimulationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSimulationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundledTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundledTxs = append(m.BundledTxs, TxSimulationResult{})
+			if err := m.BundledTxs[len(m.BundledTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WouldWin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WouldWin = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
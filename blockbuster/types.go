@@ -45,6 +45,9 @@ type (
 	// context of lanes instead of modules.
 	ProcessLanesHandler func(ctx sdk.Context, txs []sdk.Tx) (sdk.Context, error)
 
+	// BidRanking defines how lanes that run an auction rank bids.
+	BidRanking int
+
 	// LaneConfig defines the basic functionality needed for a lane.
 	LaneConfig struct {
 		Logger      log.Logger
@@ -58,6 +61,18 @@ type (
 		// lane (up to maxTxBytes as provided by the request). This is useful for the default lane.
 		MaxBlockSpace math.LegacyDec
 
+		// MinBlockSpace defines the relative percentage of block space that is reserved for
+		// this lane. Lanes that precede this lane cannot consume the reserved block space, which
+		// guarantees that this lane can include transactions even when preceding lanes are
+		// saturated. NOTE: If this is not set, no block space is reserved for the lane.
+		MinBlockSpace math.LegacyDec
+
+		// MaxBlockGas defines the relative percentage of the block gas limit that can be
+		// used by this lane. NOTE: If this is not set or set to zero, the lane is only
+		// limited by the remaining gas in the block. The block gas limit is defined by
+		// the consensus parameters.
+		MaxBlockGas math.LegacyDec
+
 		// IgnoreList defines the list of lanes to ignore when processing transactions. This
 		// is useful for when you want lanes to exist after the default lane. For example,
 		// say there are two lanes: default and free. The free lane should be processed after
@@ -74,9 +89,27 @@ type (
 		//   (sequence number) when evicting transactions.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTxs int
+
+		// BidRanking defines how bids are ranked by lanes that run an auction, i.e. the
+		// top-of-block lane. It is ignored by all other lanes. NOTE: If this is not set,
+		// bids are ranked by their value.
+		BidRanking BidRanking
 	}
 )
 
+const (
+	// BidRankingTotal ranks bids by their value.
+	BidRankingTotal BidRanking = iota
+
+	// BidRankingPerGas ranks bids by their value per unit of gas wanted by the bid
+	// transaction and all of its bundled transactions.
+	BidRankingPerGas
+
+	// BidRankingPerByte ranks bids by their value per byte of the bid transaction and all
+	// of its bundled transactions.
+	BidRankingPerByte
+)
+
 // NewLaneConfig returns a new LaneConfig. This will be embedded in a lane.
 func NewBaseLaneConfig(
 	logger log.Logger,
@@ -112,6 +145,24 @@ func (c *LaneConfig) ValidateBasic() error {
 		return fmt.Errorf("max block space must be set to a value between 0 and 1")
 	}
 
+	if !c.MinBlockSpace.IsNil() {
+		if c.MinBlockSpace.IsNegative() || c.MinBlockSpace.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("min block space must be set to a value between 0 and 1")
+		}
+
+		if !c.MaxBlockSpace.IsZero() && c.MinBlockSpace.GT(c.MaxBlockSpace) {
+			return fmt.Errorf("min block space cannot be greater than max block space")
+		}
+	}
+
+	if !c.MaxBlockGas.IsNil() && (c.MaxBlockGas.IsNegative() || c.MaxBlockGas.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("max block gas must be set to a value between 0 and 1")
+	}
+
+	if c.BidRanking < BidRankingTotal || c.BidRanking > BidRankingPerByte {
+		return fmt.Errorf("unknown bid ranking %d", c.BidRanking)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// AnteLane defines the required API dependencies for the AnteBuilder. The builder uses
+	// the name of the lane to look up its ante chain and the Match function to route
+	// transactions to the ante chain of the lane they belong to.
+	AnteLane interface {
+		Lane
+		Name() string
+	}
+
+	// AnteDecorator is an AnteDecorator identified by a unique name. The name is used by
+	// lanes to reference the decorator when skipping or replacing it.
+	AnteDecorator struct {
+		Name      string
+		Decorator sdk.AnteDecorator
+	}
+
+	// LaneAnteConfig defines how the ante chain of a lane differs from the default ante
+	// chain of the application.
+	LaneAnteConfig struct {
+		// Lane is the lane the configuration applies to.
+		Lane AnteLane
+
+		// Skip is the set of names of default decorators that are not executed for
+		// transactions that belong to the lane.
+		Skip []string
+
+		// Replace maps the names of default decorators to the decorators that are
+		// executed in their place for transactions that belong to the lane.
+		Replace map[string]sdk.AnteDecorator
+
+		// Add is the set of decorators that are executed after the default decorators
+		// for transactions that belong to the lane.
+		Add []AnteDecorator
+	}
+
+	// AnteBuilder builds the per-lane ante handlers and the global ante handler of the
+	// application from a single definition. The default ante chain is executed for all
+	// transactions that do not belong to a lane with a LaneAnteConfig. Transactions that
+	// belong to a configured lane execute the default ante chain with the lane's
+	// decorators skipped, replaced or added.
+	AnteBuilder struct {
+		decorators []AnteDecorator
+		lanes      []LaneAnteConfig
+	}
+)
+
+// NewAnteBuilder returns a new AnteBuilder with the given default ante chain. The
+// decorators are executed in the order they are provided.
+func NewAnteBuilder(decorators ...AnteDecorator) *AnteBuilder {
+	return &AnteBuilder{
+		decorators: decorators,
+		lanes:      make([]LaneAnteConfig, 0),
+	}
+}
+
+// SetLaneConfig sets the ante configuration of a lane. Transactions are routed to the
+// first configured lane that matches them, so lanes should be configured in the same
+// order as they are registered in the mempool.
+func (b *AnteBuilder) SetLaneConfig(cfg LaneAnteConfig) *AnteBuilder {
+	for index, lane := range b.lanes {
+		if lane.Lane.Name() == cfg.Lane.Name() {
+			b.lanes[index] = cfg
+			return b
+		}
+	}
+
+	b.lanes = append(b.lanes, cfg)
+	return b
+}
+
+// ValidateBasic returns an error if the default ante chain contains duplicate names or
+// if a lane references a decorator that is not part of the default ante chain.
+func (b *AnteBuilder) ValidateBasic() error {
+	names := make(map[string]struct{}, len(b.decorators))
+	for _, decorator := range b.decorators {
+		if decorator.Name == "" {
+			return fmt.Errorf("ante decorator name cannot be empty")
+		}
+
+		if decorator.Decorator == nil {
+			return fmt.Errorf("ante decorator %s cannot be nil", decorator.Name)
+		}
+
+		if _, ok := names[decorator.Name]; ok {
+			return fmt.Errorf("duplicate ante decorator %s", decorator.Name)
+		}
+
+		names[decorator.Name] = struct{}{}
+	}
+
+	for _, cfg := range b.lanes {
+		if cfg.Lane == nil {
+			return fmt.Errorf("lane ante config must specify a lane")
+		}
+
+		for _, name := range cfg.Skip {
+			if _, ok := names[name]; !ok {
+				return fmt.Errorf("%s lane skips unknown ante decorator %s", cfg.Lane.Name(), name)
+			}
+
+			if _, ok := cfg.Replace[name]; ok {
+				return fmt.Errorf("%s lane both skips and replaces ante decorator %s", cfg.Lane.Name(), name)
+			}
+		}
+
+		for name, decorator := range cfg.Replace {
+			if _, ok := names[name]; !ok {
+				return fmt.Errorf("%s lane replaces unknown ante decorator %s", cfg.Lane.Name(), name)
+			}
+
+			if decorator == nil {
+				return fmt.Errorf("%s lane replaces ante decorator %s with a nil decorator", cfg.Lane.Name(), name)
+			}
+		}
+
+		for _, decorator := range cfg.Add {
+			if decorator.Decorator == nil {
+				return fmt.Errorf("%s lane adds nil ante decorator %s", cfg.Lane.Name(), decorator.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// LaneAnteHandler returns the ante handler of the lane with the given name. Lanes that
+// are not configured use the global ante handler, which verifies each transaction using
+// the ante chain of the lane it belongs to. This ensures that transactions that are
+// bundled by a lane (e.g. the top of block lane) are verified in the same way as they
+// would be by the global ante handler.
+func (b *AnteBuilder) LaneAnteHandler(lane string) (sdk.AnteHandler, error) {
+	if err := b.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	for _, cfg := range b.lanes {
+		if cfg.Lane.Name() == lane {
+			return sdk.ChainAnteDecorators(b.laneDecorators(cfg)...), nil
+		}
+	}
+
+	return b.anteHandler(), nil
+}
+
+// AnteHandler returns the global ante handler of the application. Each transaction is
+// verified using the ante chain of the first configured lane that matches it, or the
+// default ante chain if no configured lane matches.
+func (b *AnteBuilder) AnteHandler() (sdk.AnteHandler, error) {
+	if err := b.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return b.anteHandler(), nil
+}
+
+// anteHandler returns the global ante handler without validating the configuration.
+func (b *AnteBuilder) anteHandler() sdk.AnteHandler {
+	defaultHandler := sdk.ChainAnteDecorators(b.sdkDecorators(b.decorators)...)
+
+	laneHandlers := make([]sdk.AnteHandler, len(b.lanes))
+	for index, cfg := range b.lanes {
+		laneHandlers[index] = sdk.ChainAnteDecorators(b.laneDecorators(cfg)...)
+	}
+
+	lanes := b.lanes
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		for index, cfg := range lanes {
+			if cfg.Lane.Match(ctx, tx) {
+				return laneHandlers[index](ctx, tx, simulate)
+			}
+		}
+
+		return defaultHandler(ctx, tx, simulate)
+	}
+}
+
+// laneDecorators returns the ordered ante decorators executed for transactions that
+// belong to the lane with the given configuration.
+func (b *AnteBuilder) laneDecorators(cfg LaneAnteConfig) []sdk.AnteDecorator {
+	skip := make(map[string]struct{}, len(cfg.Skip))
+	for _, name := range cfg.Skip {
+		skip[name] = struct{}{}
+	}
+
+	decorators := make([]sdk.AnteDecorator, 0, len(b.decorators)+len(cfg.Add))
+	for _, decorator := range b.decorators {
+		if _, ok := skip[decorator.Name]; ok {
+			continue
+		}
+
+		if replacement, ok := cfg.Replace[decorator.Name]; ok {
+			decorators = append(decorators, replacement)
+			continue
+		}
+
+		decorators = append(decorators, decorator.Decorator)
+	}
+
+	return append(decorators, b.sdkDecorators(cfg.Add)...)
+}
+
+// sdkDecorators returns the underlying sdk.AnteDecorators of the given decorators.
+func (b *AnteBuilder) sdkDecorators(decorators []AnteDecorator) []sdk.AnteDecorator {
+	sdkDecorators := make([]sdk.AnteDecorator, len(decorators))
+	for index, decorator := range decorators {
+		sdkDecorators[index] = decorator.Decorator
+	}
+
+	return sdkDecorators
+}
@@ -0,0 +1,172 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// mockTx is a transaction that belongs to the lane with the given name.
+	mockTx struct {
+		sdk.Tx
+		lane string
+	}
+
+	// mockLane matches all transactions with the lane's name.
+	mockLane struct {
+		name string
+	}
+
+	// recordDecorator records its name when it is executed.
+	recordDecorator struct {
+		name     string
+		executed *[]string
+	}
+)
+
+func (l mockLane) Name() string {
+	return l.name
+}
+
+func (l mockLane) Match(_ sdk.Context, tx sdk.Tx) bool {
+	return tx.(mockTx).lane == l.name
+}
+
+func (d recordDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.executed = append(*d.executed, d.name)
+	return next(ctx, tx, simulate)
+}
+
+func TestAnteBuilder(t *testing.T) {
+	executed := make([]string, 0)
+	decorator := func(name string) utils.AnteDecorator {
+		return utils.AnteDecorator{Name: name, Decorator: recordDecorator{name: name, executed: &executed}}
+	}
+
+	newBuilder := func() *utils.AnteBuilder {
+		return utils.NewAnteBuilder(decorator("a"), decorator("b"), decorator("c")).
+			SetLaneConfig(utils.LaneAnteConfig{
+				Lane: mockLane{name: "free"},
+				Skip: []string{"b"},
+			}).
+			SetLaneConfig(utils.LaneAnteConfig{
+				Lane:    mockLane{name: "custom"},
+				Replace: map[string]sdk.AnteDecorator{"a": recordDecorator{name: "x", executed: &executed}},
+				Add:     []utils.AnteDecorator{decorator("y")},
+			})
+	}
+
+	testCases := []struct {
+		name     string
+		lane     string
+		expected []string
+	}{
+		{
+			"transaction that does not belong to a configured lane uses the default chain",
+			"default",
+			[]string{"a", "b", "c"},
+		},
+		{
+			"lane can skip decorators",
+			"free",
+			[]string{"a", "c"},
+		},
+		{
+			"lane can replace and add decorators",
+			"custom",
+			[]string{"x", "b", "c", "y"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := newBuilder()
+
+			anteHandler, err := builder.AnteHandler()
+			require.NoError(t, err)
+
+			executed = executed[:0]
+			_, err = anteHandler(sdk.Context{}, mockTx{lane: tc.lane}, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, executed)
+
+			// The lane's ante handler must execute the same decorators as the global ante handler.
+			laneAnteHandler, err := builder.LaneAnteHandler(tc.lane)
+			require.NoError(t, err)
+
+			executed = executed[:0]
+			_, err = laneAnteHandler(sdk.Context{}, mockTx{lane: tc.lane}, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, executed)
+		})
+	}
+}
+
+func TestAnteBuilderValidateBasic(t *testing.T) {
+	decorator := func(name string) utils.AnteDecorator {
+		executed := make([]string, 0)
+		return utils.AnteDecorator{Name: name, Decorator: recordDecorator{name: name, executed: &executed}}
+	}
+
+	testCases := []struct {
+		name    string
+		builder *utils.AnteBuilder
+		expPass bool
+	}{
+		{
+			"valid configuration",
+			utils.NewAnteBuilder(decorator("a"), decorator("b")).
+				SetLaneConfig(utils.LaneAnteConfig{Lane: mockLane{name: "free"}, Skip: []string{"a"}}),
+			true,
+		},
+		{
+			"duplicate decorator name",
+			utils.NewAnteBuilder(decorator("a"), decorator("a")),
+			false,
+		},
+		{
+			"empty decorator name",
+			utils.NewAnteBuilder(decorator("")),
+			false,
+		},
+		{
+			"lane skips unknown decorator",
+			utils.NewAnteBuilder(decorator("a")).
+				SetLaneConfig(utils.LaneAnteConfig{Lane: mockLane{name: "free"}, Skip: []string{"b"}}),
+			false,
+		},
+		{
+			"lane replaces unknown decorator",
+			utils.NewAnteBuilder(decorator("a")).
+				SetLaneConfig(utils.LaneAnteConfig{
+					Lane:    mockLane{name: "free"},
+					Replace: map[string]sdk.AnteDecorator{"b": decorator("b").Decorator},
+				}),
+			false,
+		},
+		{
+			"lane skips and replaces the same decorator",
+			utils.NewAnteBuilder(decorator("a")).
+				SetLaneConfig(utils.LaneAnteConfig{
+					Lane:    mockLane{name: "free"},
+					Skip:    []string{"a"},
+					Replace: map[string]sdk.AnteDecorator{"a": decorator("a").Decorator},
+				}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.builder.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
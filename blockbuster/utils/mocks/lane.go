@@ -65,6 +65,20 @@ func (_m *Lane) Contains(tx types.Tx) bool {
 	return r0
 }
 
+// ContainsTxHash provides a mock function with given fields: hash
+func (_m *Lane) ContainsTxHash(hash string) bool {
+	ret := _m.Called(hash)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // CountTx provides a mock function with given fields:
 func (_m *Lane) CountTx() int {
 	ret := _m.Called()
@@ -79,6 +93,20 @@ func (_m *Lane) CountTx() int {
 	return r0
 }
 
+// GetMaxBlockGas provides a mock function with given fields:
+func (_m *Lane) GetMaxBlockGas() math.LegacyDec {
+	ret := _m.Called()
+
+	var r0 math.LegacyDec
+	if rf, ok := ret.Get(0).(func() math.LegacyDec); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	return r0
+}
+
 // GetMaxBlockSpace provides a mock function with given fields:
 func (_m *Lane) GetMaxBlockSpace() math.LegacyDec {
 	ret := _m.Called()
@@ -93,6 +121,34 @@ func (_m *Lane) GetMaxBlockSpace() math.LegacyDec {
 	return r0
 }
 
+// GetMaxTxs provides a mock function with given fields:
+func (_m *Lane) GetMaxTxs() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetMinBlockSpace provides a mock function with given fields:
+func (_m *Lane) GetMinBlockSpace() math.LegacyDec {
+	ret := _m.Called()
+
+	var r0 math.LegacyDec
+	if rf, ok := ret.Get(0).(func() math.LegacyDec); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	return r0
+}
+
 // Insert provides a mock function with given fields: _a0, _a1
 func (_m *Lane) Insert(_a0 context.Context, _a1 types.Tx) error {
 	ret := _m.Called(_a0, _a1)
@@ -241,6 +297,22 @@ func (_m *Lane) SetIgnoreList(ignoreList []blockbuster.Lane) {
 	_m.Called(ignoreList)
 }
 
+// TxDecoder provides a mock function with given fields:
+func (_m *Lane) TxDecoder() types.TxDecoder {
+	ret := _m.Called()
+
+	var r0 types.TxDecoder
+	if rf, ok := ret.Get(0).(func() types.TxDecoder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.TxDecoder)
+		}
+	}
+
+	return r0
+}
+
 // NewLane creates a new instance of Lane. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLane(t interface {
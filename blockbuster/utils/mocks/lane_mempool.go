@@ -44,6 +44,20 @@ func (_m *LaneMempool) Contains(tx types.Tx) bool {
 	return r0
 }
 
+// ContainsTxHash provides a mock function with given fields: hash
+func (_m *LaneMempool) ContainsTxHash(hash string) bool {
+	ret := _m.Called(hash)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // CountTx provides a mock function with given fields:
 func (_m *LaneMempool) CountTx() int {
 	ret := _m.Called()
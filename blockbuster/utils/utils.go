@@ -69,3 +69,36 @@ func GetMaxTxBytesForLane(maxTxBytes, totalTxBytes int64, ratio math.LegacyDec)
 	// Otherwise, we calculate the max tx bytes for the lane based on the ratio.
 	return ratio.MulInt64(maxTxBytes).TruncateInt().Int64()
 }
+
+// GetMaxGasLimitForLane returns the maximum gas limit that can be included in the proposal for
+// the given lane. Similar to block space, a ratio of zero means the lane is only limited by the
+// remaining gas in the proposal.
+func GetMaxGasLimitForLane(maxGasLimit, totalGasLimit uint64, ratio math.LegacyDec) uint64 {
+	if totalGasLimit >= maxGasLimit {
+		return 0
+	}
+
+	remainder := maxGasLimit - totalGasLimit
+	if ratio.IsNil() || ratio.IsZero() {
+		return remainder
+	}
+
+	// Otherwise, we calculate the max gas limit for the lane based on the ratio.
+	maxGasLimitForLane := ratio.MulInt(math.NewIntFromUint64(maxGasLimit)).TruncateInt().Uint64()
+	if maxGasLimitForLane > remainder {
+		return remainder
+	}
+
+	return maxGasLimitForLane
+}
+
+// GetTxGasLimit returns the gas limit of the transaction. Transactions that do not specify a
+// gas limit have a gas limit of zero.
+func GetTxGasLimit(tx sdk.Tx) uint64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+
+	return feeTx.GetGas()
+}
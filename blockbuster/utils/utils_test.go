@@ -75,3 +75,58 @@ func TestGetMaxTxBytesForLane(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMaxGasLimitForLane(t *testing.T) {
+	testCases := []struct {
+		name          string
+		maxGasLimit   uint64
+		totalGasLimit uint64
+		ratio         math.LegacyDec
+		expected      uint64
+	}{
+		{
+			"ratio is zero",
+			100,
+			50,
+			math.LegacyZeroDec(),
+			50,
+		},
+		{
+			"ratio is zero and the proposal is full",
+			100,
+			150,
+			math.LegacyZeroDec(),
+			0,
+		},
+		{
+			"ratio is 10%",
+			100,
+			50,
+			math.LegacyMustNewDecFromStr("0.1"),
+			10,
+		},
+		{
+			"ratio is capped by the remaining gas",
+			100,
+			80,
+			math.LegacyMustNewDecFromStr("0.5"),
+			20,
+		},
+		{
+			"ratio is 50%",
+			101,
+			0,
+			math.LegacyMustNewDecFromStr("0.5"),
+			50,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := utils.GetMaxGasLimitForLane(tc.maxGasLimit, tc.totalGasLimit, tc.ratio)
+			if actual != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, actual)
+			}
+		})
+	}
+}
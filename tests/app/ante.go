@@ -17,10 +17,31 @@ type POBHandlerOptions struct {
 	TxEncoder     sdk.TxEncoder
 	BuilderKeeper builderkeeper.Keeper
 	FreeLane      blockbuster.Lane
+	BundleLane    builderante.BundleLane
 }
 
-// NewPOBAnteHandler wraps all of the default Cosmos SDK AnteDecorators with the POB AnteHandler.
-func NewPOBAnteHandler(options POBHandlerOptions) sdk.AnteHandler {
+// Names of the decorators in the POB ante chain. Lanes reference these names to skip
+// or replace decorators.
+const (
+	SetUpContextDecorator        = "set_up_context"
+	ExtensionOptionsDecorator    = "extension_options"
+	ValidateBasicDecorator       = "validate_basic"
+	TxTimeoutHeightDecorator     = "tx_timeout_height"
+	ValidateMemoDecorator        = "validate_memo"
+	ConsumeGasForTxSizeDecorator = "consume_gas_for_tx_size"
+	DeductFeeDecorator           = "deduct_fee"
+	SetPubKeyDecorator           = "set_pub_key"
+	ValidateSigCountDecorator    = "validate_sig_count"
+	SigGasConsumeDecorator       = "sig_gas_consume"
+	SigVerificationDecorator     = "sig_verification"
+	IncrementSequenceDecorator   = "increment_sequence"
+	BuilderDecorator             = "builder"
+)
+
+// NewPOBAnteBuilder returns the ante builder that defines the default Cosmos SDK AnteDecorators
+// wrapped with the POB AnteHandler along with the lane specific ante chains. The free lane does
+// not deduct fees.
+func NewPOBAnteBuilder(options POBHandlerOptions) *utils.AnteBuilder {
 	if options.BaseOptions.AccountKeeper == nil {
 		panic("account keeper is required for ante builder")
 	}
@@ -33,29 +54,45 @@ func NewPOBAnteHandler(options POBHandlerOptions) sdk.AnteHandler {
 		panic("sign mode handler is required for ante builder")
 	}
 
-	anteDecorators := []sdk.AnteDecorator{
-		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		ante.NewExtensionOptionsDecorator(options.BaseOptions.ExtensionOptionChecker),
-		ante.NewValidateBasicDecorator(),
-		ante.NewTxTimeoutHeightDecorator(),
-		ante.NewValidateMemoDecorator(options.BaseOptions.AccountKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(options.BaseOptions.AccountKeeper),
-		utils.NewIgnoreDecorator(
-			ante.NewDeductFeeDecorator(
+	builder := utils.NewAnteBuilder(
+		utils.AnteDecorator{Name: SetUpContextDecorator, Decorator: ante.NewSetUpContextDecorator()}, // outermost AnteDecorator. SetUpContext must be called first
+		utils.AnteDecorator{Name: ExtensionOptionsDecorator, Decorator: ante.NewExtensionOptionsDecorator(options.BaseOptions.ExtensionOptionChecker)},
+		utils.AnteDecorator{Name: ValidateBasicDecorator, Decorator: ante.NewValidateBasicDecorator()},
+		utils.AnteDecorator{Name: TxTimeoutHeightDecorator, Decorator: ante.NewTxTimeoutHeightDecorator()},
+		utils.AnteDecorator{Name: ValidateMemoDecorator, Decorator: ante.NewValidateMemoDecorator(options.BaseOptions.AccountKeeper)},
+		utils.AnteDecorator{Name: ConsumeGasForTxSizeDecorator, Decorator: ante.NewConsumeGasForTxSizeDecorator(options.BaseOptions.AccountKeeper)},
+		utils.AnteDecorator{
+			Name: DeductFeeDecorator,
+			Decorator: ante.NewDeductFeeDecorator(
 				options.BaseOptions.AccountKeeper,
 				options.BaseOptions.BankKeeper,
 				options.BaseOptions.FeegrantKeeper,
 				options.BaseOptions.TxFeeChecker,
 			),
-			options.FreeLane,
-		),
-		ante.NewSetPubKeyDecorator(options.BaseOptions.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewValidateSigCountDecorator(options.BaseOptions.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.BaseOptions.AccountKeeper, options.BaseOptions.SigGasConsumer),
-		ante.NewSigVerificationDecorator(options.BaseOptions.AccountKeeper, options.BaseOptions.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(options.BaseOptions.AccountKeeper),
-		builderante.NewBuilderDecorator(options.BuilderKeeper, options.TxEncoder, options.TOBLane, options.Mempool),
+		},
+		utils.AnteDecorator{Name: SetPubKeyDecorator, Decorator: ante.NewSetPubKeyDecorator(options.BaseOptions.AccountKeeper)}, // SetPubKeyDecorator must be called before all signature verification decorators
+		utils.AnteDecorator{Name: ValidateSigCountDecorator, Decorator: ante.NewValidateSigCountDecorator(options.BaseOptions.AccountKeeper)},
+		utils.AnteDecorator{Name: SigGasConsumeDecorator, Decorator: ante.NewSigGasConsumeDecorator(options.BaseOptions.AccountKeeper, options.BaseOptions.SigGasConsumer)},
+		utils.AnteDecorator{Name: SigVerificationDecorator, Decorator: ante.NewSigVerificationDecorator(options.BaseOptions.AccountKeeper, options.BaseOptions.SignModeHandler)},
+		utils.AnteDecorator{Name: IncrementSequenceDecorator, Decorator: ante.NewIncrementSequenceDecorator(options.BaseOptions.AccountKeeper)},
+		utils.AnteDecorator{Name: BuilderDecorator, Decorator: builderante.NewBuilderDecorator(options.BuilderKeeper, options.TxEncoder, options.TOBLane, options.Mempool).WithBundleLane(options.BundleLane)},
+	)
+
+	// Transactions in the free lane do not pay fees.
+	builder.SetLaneConfig(utils.LaneAnteConfig{
+		Lane: options.FreeLane,
+		Skip: []string{DeductFeeDecorator},
+	})
+
+	return builder
+}
+
+// NewPOBAnteHandler returns the global ante handler defined by the POB ante builder.
+func NewPOBAnteHandler(options POBHandlerOptions) sdk.AnteHandler {
+	anteHandler, err := NewPOBAnteBuilder(options).AnteHandler()
+	if err != nil {
+		panic(err)
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...)
+	return anteHandler
 }
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -63,15 +64,23 @@ import (
 
 	"github.com/skip-mev/pob/blockbuster"
 	"github.com/skip-mev/pob/blockbuster/abci"
+	"github.com/skip-mev/pob/blockbuster/fees"
 	"github.com/skip-mev/pob/blockbuster/lanes/auction"
 	"github.com/skip-mev/pob/blockbuster/lanes/base"
+	"github.com/skip-mev/pob/blockbuster/lanes/bundle"
 	"github.com/skip-mev/pob/blockbuster/lanes/free"
+	blockbusterservice "github.com/skip-mev/pob/blockbuster/service"
 	buildermodule "github.com/skip-mev/pob/x/builder"
 	builderkeeper "github.com/skip-mev/pob/x/builder/keeper"
+	builderpost "github.com/skip-mev/pob/x/builder/post"
 )
 
 const (
 	ChainID = "chain-id-0"
+
+	// MaxVoteExtensionBids is the maximum number of bids a validator includes in its vote
+	// extension.
+	MaxVoteExtensionBids = 5
 )
 
 var (
@@ -163,6 +172,10 @@ func New(
 		app        = &TestApp{}
 		appBuilder *runtime.AppBuilder
 
+		// bundleExecutor executes the bundled transactions of bundles that are submitted
+		// without a bid. It is set up once the ante handler has been created.
+		bundleExecutor = bundle.NewExecutor()
+
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
 			AppConfig,
@@ -172,6 +185,9 @@ func New(
 
 				logger,
 
+				// supply the executor of bundles that are submitted without a bid
+				bundleExecutor,
+
 				// ADVANCED CONFIGURATION
 
 				//
@@ -269,11 +285,44 @@ func New(
 		MaxBlockSpace: math.LegacyZeroDec(), // This means the lane has no limit on block space.
 		MaxTxs:        0,                    // This means the lane has no limit on the number of transactions it can store.
 	}
-	tobLane := auction.NewTOBLane(
+	auctionFactory := auction.NewDefaultAuctionFactory(app.txConfig.TxDecoder())
+	// Bids are ranked with the same price converter as the one used by the x/builder keeper.
+	tobLane := auction.NewTOBLaneWithPriceConverter(
 		tobConfig,
-		auction.NewDefaultAuctionFactory(app.txConfig.TxDecoder()),
+		auctionFactory,
+		app.BuilderKeeper.GetPriceConverter(),
 	)
 
+	// The number of winning bundles per block is governed by the x/builder parameters.
+	tobLane.SetBundleLimiter(app.BuilderKeeper)
+
+	// The pricing rule of the auction is governed by the x/builder parameters. When the second
+	// price pricing rule is used, the lane includes the runner-up bid in the proposal.
+	tobLane.SetPricingRuleProvider(app.BuilderKeeper)
+
+	// With sealed bids, the auction is run over vote extensions so that the reveals that lose
+	// the auction are recorded and their commitments are not slashed.
+	tobLane.SetSealedBidsProvider(app.BuilderKeeper)
+
+	// Front-running protection is enforced on the bundles of encrypted bids once they are
+	// decrypted. Encrypted bids are only accepted if the auction factory has a decrypter.
+	tobLane.SetBundleValidator(app.BuilderKeeper)
+
+	// Bundle lane allows transactions to be included atomically and in order without a bid.
+	// Bundles are ranked by the combined fees of their transactions.
+	bundleConfig := blockbuster.LaneConfig{
+		Logger:        app.Logger(),
+		TxEncoder:     app.txConfig.TxEncoder(),
+		TxDecoder:     app.txConfig.TxDecoder(),
+		MaxBlockSpace: math.LegacyZeroDec(),
+		MaxTxs:        0,
+	}
+	bundleLane := bundle.NewBundleLane(bundleConfig)
+
+	// Bundles are subject to the same bundle size limit and front-running protection as
+	// the bundles of bids.
+	bundleLane.SetBundleValidator(app.BuilderKeeper)
+
 	// Free lane allows transactions to be included in the next block for free.
 	freeConfig := blockbuster.LaneConfig{
 		Logger:        app.Logger(),
@@ -301,12 +350,37 @@ func New(
 	// Set the lanes into the mempool.
 	lanes := []blockbuster.Lane{
 		tobLane,
+		bundleLane,
 		freeLane,
 		defaultLane,
 	}
 	mempool := blockbuster.NewMempool(app.Logger(), true, lanes...)
 	app.App.SetMempool(mempool)
 
+	// Create the fee estimator. Transactions in the top of block lane are ordered by
+	// their bid rather than their fee, so the bid is used as the lane's fee.
+	feeEstimator := fees.NewEstimator(mempool, app.txConfig.TxEncoder(), fees.DefaultNumBlocks)
+	feeEstimator.SetFeeHandler(tobLane.Name(), func(tx sdk.Tx) (sdk.Coins, error) {
+		bidInfo, err := auctionFactory.GetAuctionBidInfo(tx)
+		if err != nil || bidInfo == nil {
+			return nil, fmt.Errorf("failed to get bid info: %w", err)
+		}
+
+		return sdk.NewCoins(bidInfo.Bid), nil
+	})
+
+	// Bundles are ordered by the combined fees of their transactions, so the combined fees
+	// are used as the bundle lane's fee.
+	feeEstimator.SetFeeHandler(bundleLane.Name(), func(tx sdk.Tx) (sdk.Coins, error) {
+		return bundle.GetBundleFees(app.txConfig.TxDecoder(), tx)
+	})
+
+	// Register the BlockBuster mempool query service so that searchers, wallets and
+	// users can inspect the state of the mempool.
+	queryService := blockbusterservice.NewQueryService(mempool, app.txConfig.TxDecoder())
+	queryService.SetFeeEstimator(feeEstimator)
+	blockbusterservice.RegisterGRPCServer(app.GRPCQueryRouter(), queryService)
+
 	// Create a global ante handler that will be called on each transaction when
 	// proposals are being built and verified.
 	handlerOptions := ante.HandlerOptions{
@@ -322,26 +396,64 @@ func New(
 		TxDecoder:     app.txConfig.TxDecoder(),
 		TxEncoder:     app.txConfig.TxEncoder(),
 		FreeLane:      freeLane,
+		BundleLane:    bundleLane,
 		TOBLane:       tobLane,
 		Mempool:       mempool,
 	}
-	anteHandler := NewPOBAnteHandler(options)
+	anteBuilder := NewPOBAnteBuilder(options)
+	anteHandler, err := anteBuilder.AnteHandler()
+	if err != nil {
+		panic(err)
+	}
 
-	// Set the lane config on the lanes.
+	// Set the lane specific ante handlers on the lanes. The per-lane and global ante
+	// handlers are derived from the same definition so they cannot diverge.
 	for _, lane := range lanes {
-		lane.SetAnteHandler(anteHandler)
+		laneAnteHandler, err := anteBuilder.LaneAnteHandler(lane.Name())
+		if err != nil {
+			panic(err)
+		}
+
+		lane.SetAnteHandler(laneAnteHandler)
 	}
 	app.App.SetAnteHandler(anteHandler)
 
-	// Set the abci handlers on base app
+	// Bundled transactions are run through the global ante handler and their messages are
+	// executed when the bundle is executed.
+	bundleExecutor.SetUp(app.txConfig.TxDecoder(), anteHandler, app.MsgServiceRouter())
+
+	// Record the successful execution of bundled transactions so that bidders can be refunded
+	// when revert protection is enabled.
+	app.App.SetPostHandler(sdk.ChainPostDecorators(
+		builderpost.NewRevertProtectionDecorator(app.BuilderKeeper),
+	))
+
+	// Set the abci handlers on base app. Proposals are decoded with the top of block lane's
+	// decoder so that auction settlements can be verified.
 	proposalHandler := abci.NewProposalHandler(
 		app.Logger(),
-		app.TxConfig().TxDecoder(),
+		tobLane.TxDecoder(),
 		lanes,
 	)
+	proposalHandler.SetVoteExtensionAuction(
+		tobLane,
+		abci.NewDefaultValidateVoteExtensionsFn(NewValidatorStore(app.StakingKeeper)),
+	)
 	app.App.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
 	app.App.SetProcessProposal(proposalHandler.ProcessProposalHandler())
 
+	// Once vote extensions are enabled, validators extend their votes with the top bids in
+	// their local top of block mempool and the auction is run over the bids of all validators.
+	voteExtensionHandler := abci.NewVoteExtensionHandler(
+		app.Logger(),
+		tobLane,
+		app.txConfig.TxDecoder(),
+		app.txConfig.TxEncoder(),
+		MaxVoteExtensionBids,
+	)
+	app.App.SetExtendVoteHandler(voteExtensionHandler.ExtendVoteHandler())
+	app.App.SetVerifyVoteExtensionHandler(voteExtensionHandler.VerifyVoteExtensionHandler())
+
 	// Set the custom CheckTx handler on BaseApp.
 	checkTxHandler := auction.NewCheckTxHandler(
 		app.App,
@@ -351,6 +463,24 @@ func New(
 	)
 	app.SetCheckTx(checkTxHandler.CheckTx())
 
+	// Searchers can simulate bundles against the latest committed state, optionally executing
+	// the messages of each transaction.
+	checkTxHandler.SetMsgRouter(app.MsgServiceRouter())
+	queryService.SetBundleSimulator(checkTxHandler)
+
+	// Store the auction settlement of each block before its transactions are executed so
+	// that the winners of the auction are charged the clearing price, and record the fees
+	// paid in each lane of the finalized block for fee estimation.
+	settlementHook := app.BuilderKeeper.PreFinalizeBlockHook()
+	feeEstimatorHook := feeEstimator.PreFinalizeBlockHook(app.txConfig.TxDecoder())
+	app.App.SetPreFinalizeBlockHook(func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) error {
+		if err := feeEstimatorHook(ctx, req); err != nil {
+			return err
+		}
+
+		return settlementHook(ctx, req)
+	})
+
 	// ---------------------------------------------------------------------------- //
 	// ------------------------- End Custom Code ---------------------------------- //
 	// ---------------------------------------------------------------------------- //
@@ -454,6 +584,10 @@ func (app *TestApp) SimulationManager() *module.SimulationManager {
 // API server.
 func (app *TestApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	app.App.RegisterAPIRoutes(apiSvr, apiConfig)
+
+	// register the BlockBuster mempool query service gateway routes
+	blockbusterservice.RegisterGRPCGatewayRoutes(apiSvr.ClientCtx, apiSvr.GRPCGatewayRouter)
+
 	// register swagger API in app.go so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
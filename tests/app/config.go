@@ -81,7 +81,7 @@ var (
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
-		{Account: buildertypes.ModuleName, Permissions: []string{}},
+		{Account: buildertypes.ModuleName, Permissions: []string{authtypes.Burner}},
 	}
 
 	// blocked account addresses
@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	blockbustercli "github.com/skip-mev/pob/blockbuster/service/client/cli"
 	"github.com/skip-mev/pob/tests/app"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -256,6 +257,7 @@ func queryCommand() *cobra.Command {
 		authcmd.QueryTxsByEventsCmd(),
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
+		blockbustercli.GetQueryCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+var _ baseapp.ValidatorStore = ValidatorStore{}
+
+// ValidatorStore adapts the staking keeper to the validator store that is utilized to
+// validate the vote extensions of the previous block.
+type ValidatorStore struct {
+	stakingKeeper *stakingkeeper.Keeper
+}
+
+// NewValidatorStore returns a new validator store backed by the given staking keeper.
+func NewValidatorStore(stakingKeeper *stakingkeeper.Keeper) ValidatorStore {
+	return ValidatorStore{
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// GetValidatorByConsAddr returns the validator with the given consensus address.
+func (s ValidatorStore) GetValidatorByConsAddr(ctx sdk.Context, addr cryptotypes.Address) (baseapp.Validator, error) {
+	return s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(addr))
+}
+
+// TotalBondedTokens returns the total amount of bonded tokens. If the amount cannot be
+// retrieved, zero is returned so that no vote extensions are considered valid.
+func (s ValidatorStore) TotalBondedTokens(ctx sdk.Context) math.Int {
+	total, err := s.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.ZeroInt()
+	}
+
+	return total
+}
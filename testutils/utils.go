@@ -1,6 +1,7 @@
 package test
 
 import (
+	"fmt"
 	"math/rand"
 
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -154,6 +155,34 @@ func CreateRandomTx(txCfg client.TxConfig, account Account, nonce, numberMsgs, t
 	return txBuilder.GetTx(), nil
 }
 
+// CreateBundledTx returns a random transaction with the bundled tx extension option, which can
+// only be executed as part of a bundle that is submitted without a bid.
+func CreateBundledTx(txCfg client.TxConfig, account Account, nonce, numberMsgs, timeout uint64, fees ...sdk.Coin) (authsigning.Tx, error) {
+	randomTx, err := CreateRandomTx(txCfg, account, nonce, numberMsgs, timeout, fees...)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := txCfg.WrapTxBuilder(randomTx)
+	if err != nil {
+		return nil, err
+	}
+
+	extTxBuilder, ok := txBuilder.(tx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return nil, fmt.Errorf("tx builder does not support extension options")
+	}
+
+	option, err := types.NewAnyWithValue(&buildertypes.ExtensionOptionBundledTx{})
+	if err != nil {
+		return nil, err
+	}
+
+	extTxBuilder.SetNonCriticalExtensionOptions(option)
+
+	return txBuilder.GetTx(), nil
+}
+
 func CreateRandomTxBz(txCfg client.TxConfig, account Account, nonce, numberMsgs, timeout uint64) ([]byte, error) {
 	tx, err := CreateRandomTx(txCfg, account, nonce, numberMsgs, timeout)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
 	"github.com/skip-mev/pob/x/builder/keeper"
 	"github.com/skip-mev/pob/x/builder/types"
 )
@@ -19,6 +20,14 @@ type (
 	TOBLane interface {
 		GetAuctionBidInfo(tx sdk.Tx) (*types.BidInfo, error)
 		GetTopAuctionTx(ctx context.Context) sdk.Tx
+		GetBidRanking() blockbuster.BidRanking
+		Compare(ctx sdk.Context, this sdk.Tx, other sdk.Tx) int
+	}
+
+	// BundleLane is an interface that defines the methods required to interact with the bundle
+	// lane.
+	BundleLane interface {
+		GetBundleInfo(tx sdk.Tx) (*types.BundleInfo, error)
 	}
 
 	// Mempool is an interface that defines the methods required to interact with the application-side mempool.
@@ -32,6 +41,10 @@ type (
 		txEncoder     sdk.TxEncoder
 		lane          TOBLane
 		mempool       Mempool
+
+		// bundleLane is used to validate bundles that are submitted without a bid. If it is not
+		// set, bundles are only validated when they are executed.
+		bundleLane BundleLane
 	}
 )
 
@@ -44,6 +57,13 @@ func NewBuilderDecorator(ak keeper.Keeper, txEncoder sdk.TxEncoder, lane TOBLane
 	}
 }
 
+// WithBundleLane returns a copy of the decorator that uses the given bundle lane to validate
+// bundles that are submitted without a bid.
+func (bd BuilderDecorator) WithBundleLane(bundleLane BundleLane) BuilderDecorator {
+	bd.bundleLane = bundleLane
+	return bd
+}
+
 // AnteHandle validates that the auction bid is valid if one exists. If valid it will deduct the entrance fee from the
 // bidder's account.
 func (bd BuilderDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
@@ -59,6 +79,32 @@ func (bd BuilderDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 		return ctx, err
 	}
 
+	// Bundles submitted without a bid are included by the bundle lane, which only accepts
+	// transactions that submit a single bundle and nothing else.
+	if err := validateSubmitBundleTx(tx); err != nil {
+		return ctx, err
+	}
+
+	// Bundled transactions can only be executed as part of the bundle that submits them, so
+	// that they cannot be included in a block on their own.
+	if types.IsBundledTx(tx) && !types.IsBundleExecution(ctx) {
+		return ctx, fmt.Errorf("bundled transactions can only be executed as part of a bundle")
+	}
+
+	// Validate the bundle if one exists, so that invalid bundles are rejected in CheckTx.
+	if bd.bundleLane != nil {
+		bundleInfo, err := bd.bundleLane.GetBundleInfo(tx)
+		if err != nil {
+			return ctx, err
+		}
+
+		if bundleInfo != nil {
+			if err := bd.builderKeeper.ValidateBundleInfo(ctx, bundleInfo); err != nil {
+				return ctx, errors.Wrap(err, "failed to validate bundle")
+			}
+		}
+	}
+
 	// Validate the auction bid if one exists.
 	if bidInfo != nil {
 		// Auction transactions must have a timeout set to a valid block height.
@@ -70,8 +116,20 @@ func (bd BuilderDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 		// is checkTx or recheckTx. Otherwise, the ABCI handlers (VerifyVoteExtension, ExtendVoteExtension, etc.)
 		// will always compare the auction bid to the highest bidding transaction in the mempool leading to
 		// poor liveness guarantees.
+		compareToTopBid := ctx.IsCheckTx() || ctx.IsReCheckTx()
+		if compareToTopBid {
+			maxBundlesPerBlock, err := bd.builderKeeper.GetMaxBundlesPerBlock(ctx)
+			if err != nil {
+				return ctx, err
+			}
+
+			// If more than one bundle can win the auction, a bid that does not outbid the top bid
+			// can still win, so it only needs to pay the reserve fee.
+			compareToTopBid = maxBundlesPerBlock <= 1
+		}
+
 		topBid := sdk.Coin{}
-		if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		if compareToTopBid {
 			if topBidTx := bd.lane.GetTopAuctionTx(ctx); topBidTx != nil {
 				topBidBz, err := bd.txEncoder(topBidTx)
 				if err != nil {
@@ -90,7 +148,13 @@ func (bd BuilderDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 						return ctx, err
 					}
 
-					topBid = topBidInfo.Bid
+					// Under the per gas and per byte rankings, a bid with a lower value can outrank the
+					// top bid, so the bid is compared to the top bid with the lane's ranking instead.
+					if bd.lane.GetBidRanking() == blockbuster.BidRankingTotal {
+						topBid = topBidInfo.Bid
+					} else if err := bd.ValidateBidRank(ctx, tx, topBidTx); err != nil {
+						return ctx, err
+					}
 				}
 			}
 		}
@@ -103,6 +167,26 @@ func (bd BuilderDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 	return next(ctx, tx, simulate)
 }
 
+// ValidateBidRank validates that the bid transaction ranks ahead of the top bid transaction in
+// the mempool under the lane's bid ranking. Sealed bids are not required to outrank the top bid
+// since the auction selects the highest revealed bids.
+func (bd BuilderDecorator) ValidateBidRank(ctx sdk.Context, bidTx, topBidTx sdk.Tx) error {
+	params, err := bd.builderKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.SealedBidsEnabled() {
+		return nil
+	}
+
+	if bd.lane.Compare(ctx, bidTx, topBidTx) != 1 {
+		return fmt.Errorf("bid does not rank ahead of the highest bid in the mempool")
+	}
+
+	return nil
+}
+
 // ValidateTimeout validates that the timeout is greater than or equal to the expected block height
 // the bid transaction will be executed in.
 //
@@ -127,3 +211,16 @@ func (bd BuilderDecorator) ValidateTimeout(ctx sdk.Context, timeout int64) error
 
 	return nil
 }
+
+// validateSubmitBundleTx validates that a transaction that submits a bundle without a bid does
+// not contain any other messages.
+func validateSubmitBundleTx(tx sdk.Tx) error {
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		if _, ok := msg.(*types.MsgSubmitBundle); ok && len(msgs) != 1 {
+			return fmt.Errorf("invalid MsgSubmitBundle transaction")
+		}
+	}
+
+	return nil
+}
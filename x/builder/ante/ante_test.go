@@ -10,10 +10,12 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	"github.com/skip-mev/pob/blockbuster"
 	"github.com/skip-mev/pob/blockbuster/lanes/auction"
 	"github.com/skip-mev/pob/blockbuster/lanes/base"
+	"github.com/skip-mev/pob/blockbuster/lanes/bundle"
 	testutils "github.com/skip-mev/pob/testutils"
 	"github.com/skip-mev/pob/x/builder/ante"
 	"github.com/skip-mev/pob/x/builder/keeper"
@@ -63,7 +65,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	// Keepers set up
 	ctrl := gomock.NewController(suite.T())
 	suite.accountKeeper = testutils.NewMockAccountKeeper(ctrl)
-	suite.accountKeeper.EXPECT().GetModuleAddress(buildertypes.ModuleName).Return(sdk.AccAddress{}).AnyTimes()
+	suite.accountKeeper.EXPECT().GetModuleAddressAndPermissions(buildertypes.ModuleName).Return(sdk.AccAddress{}, []string{authtypes.Burner}).AnyTimes()
 	suite.bankKeeper = testutils.NewMockBankKeeper(ctrl)
 	suite.distrKeeper = testutils.NewMockDistributionKeeper(ctrl)
 	suite.stakingKeeper = testutils.NewMockStakingKeeper(ctrl)
@@ -137,6 +139,7 @@ func (suite *AnteTestSuite) TestAnteHandler() {
 
 		// Auction setup
 		maxBundleSize          uint32 = 5
+		maxBundlesPerBlock     uint32 = 1
 		reserveFee                    = sdk.NewCoin("stake", math.NewInt(100))
 		minBidIncrement               = sdk.NewCoin("stake", math.NewInt(100))
 		frontRunningProtection        = true
@@ -162,9 +165,17 @@ func (suite *AnteTestSuite) TestAnteHandler() {
 			},
 			false,
 		},
+		{
+			"smaller bid than winning bid with multiple bundles per block, valid auction tx",
+			func() {
+				maxBundlesPerBlock = 2
+			},
+			true,
+		},
 		{
 			"bidder has insufficient balance, invalid auction tx",
 			func() {
+				maxBundlesPerBlock = 1
 				insertTopBid = false
 				balance = sdk.NewCoin("stake", math.NewInt(10))
 			},
@@ -266,6 +277,7 @@ func (suite *AnteTestSuite) TestAnteHandler() {
 			// Set the auction params
 			err := suite.builderKeeper.SetParams(suite.ctx, buildertypes.Params{
 				MaxBundleSize:          maxBundleSize,
+				MaxBundlesPerBlock:     maxBundlesPerBlock,
 				ReserveFee:             reserveFee,
 				MinBidIncrement:        minBidIncrement,
 				FrontRunningProtection: frontRunningProtection,
@@ -304,3 +316,143 @@ func (suite *AnteTestSuite) TestAnteHandler() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestSubmitBundleTx() {
+	bundleLane := bundle.NewBundleLane(blockbuster.LaneConfig{
+		Logger:        suite.ctx.Logger(),
+		TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+		TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+		AnteHandler:   suite.anteHandler,
+		MaxBlockSpace: math.LegacyZeroDec(),
+	})
+	suite.builderDecorator = ante.NewBuilderDecorator(suite.builderKeeper, suite.encodingConfig.TxConfig.TxEncoder(), suite.tobLane, suite.mempool).WithBundleLane(bundleLane)
+
+	sender := testutils.RandomAccounts(suite.random, 1)[0]
+	bundledTx, err := testutils.CreateBundledTx(suite.encodingConfig.TxConfig, sender, 1, 1, 0)
+	suite.Require().NoError(err)
+
+	bundledTxBz, err := suite.encodingConfig.TxConfig.TxEncoder()(bundledTx)
+	suite.Require().NoError(err)
+
+	msg := buildertypes.NewMsgSubmitBundle(sender.Address, [][]byte{bundledTxBz})
+
+	suite.Run("transaction that only submits a bundle", func() {
+		tx, err := testutils.CreateTx(suite.encodingConfig.TxConfig, sender, 0, 0, []sdk.Msg{msg})
+		suite.Require().NoError(err)
+
+		_, err = suite.anteHandler(suite.ctx, tx, false)
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("transaction that submits a bundle with other messages", func() {
+		msgs := append([]sdk.Msg{msg}, testutils.CreateRandomMsgs(sender.Address, 1)...)
+		tx, err := testutils.CreateTx(suite.encodingConfig.TxConfig, sender, 0, 0, msgs)
+		suite.Require().NoError(err)
+
+		_, err = suite.anteHandler(suite.ctx, tx, false)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("bundle with a transaction without the bundled tx extension option", func() {
+		randomTxBz, err := testutils.CreateRandomTxBz(suite.encodingConfig.TxConfig, sender, 1, 1, 0)
+		suite.Require().NoError(err)
+
+		msg := buildertypes.NewMsgSubmitBundle(sender.Address, [][]byte{randomTxBz})
+		tx, err := testutils.CreateTx(suite.encodingConfig.TxConfig, sender, 0, 0, []sdk.Msg{msg})
+		suite.Require().NoError(err)
+
+		_, err = suite.anteHandler(suite.ctx, tx, false)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("bundle that exceeds the max bundle size", func() {
+		params := buildertypes.DefaultParams()
+		params.MaxBundleSize = 0
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+		defer func() {
+			suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, buildertypes.DefaultParams()))
+		}()
+
+		tx, err := testutils.CreateTx(suite.encodingConfig.TxConfig, sender, 0, 0, []sdk.Msg{msg})
+		suite.Require().NoError(err)
+
+		_, err = suite.anteHandler(suite.ctx, tx, false)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("bundled transaction outside of a bundle", func() {
+		_, err := suite.anteHandler(suite.ctx, bundledTx, false)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("bundled transaction executed as part of a bundle", func() {
+		_, err := suite.anteHandler(buildertypes.WithBundleExecution(suite.ctx), bundledTx, false)
+		suite.Require().NoError(err)
+	})
+}
+
+func (suite *AnteTestSuite) TestBidRanking() {
+	// createBidTx creates a bid transaction without bundled transactions that wants the
+	// given amount of gas.
+	createBidTx := func(bidder testutils.Account, bid int64, gasLimit uint64) sdk.Tx {
+		tx, err := testutils.CreateAuctionTxWithSigners(suite.encodingConfig.TxConfig, bidder, sdk.NewInt64Coin("stake", bid), 0, 1000, nil)
+		suite.Require().NoError(err)
+
+		txBuilder, err := suite.encodingConfig.TxConfig.WrapTxBuilder(tx)
+		suite.Require().NoError(err)
+		txBuilder.SetGasLimit(gasLimit)
+
+		return txBuilder.GetTx()
+	}
+
+	accounts := testutils.RandomAccounts(suite.random, 3)
+
+	// The top bid bids the most in total but the least per unit of gas.
+	topBidTx := createBidTx(accounts[0], 1000, 1_000_000)
+
+	setup := func(ranking blockbuster.BidRanking) {
+		suite.tobLane = auction.NewTOBLane(
+			blockbuster.LaneConfig{
+				Logger:        suite.ctx.Logger(),
+				TxEncoder:     suite.encodingConfig.TxConfig.TxEncoder(),
+				TxDecoder:     suite.encodingConfig.TxConfig.TxDecoder(),
+				AnteHandler:   suite.anteHandler,
+				MaxBlockSpace: math.LegacyZeroDec(),
+				BidRanking:    ranking,
+			},
+			auction.NewDefaultAuctionFactory(suite.encodingConfig.TxConfig.TxDecoder()),
+		)
+		suite.mempool = blockbuster.NewMempool(log.NewTestLogger(suite.T()), true, suite.tobLane, suite.baseLane)
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, topBidTx))
+
+		params := buildertypes.DefaultParams()
+		params.ReserveFee = sdk.NewInt64Coin("stake", 100)
+		params.MinBidIncrement = sdk.NewInt64Coin("stake", 100)
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		suite.balance = sdk.NewInt64Coin("stake", 10000)
+		suite.builderDecorator = ante.NewBuilderDecorator(suite.builderKeeper, suite.encodingConfig.TxConfig.TxEncoder(), suite.tobLane, suite.mempool)
+		suite.ctx = suite.ctx.WithBlockHeight(1)
+	}
+
+	suite.Run("bid with a lower total but a higher bid per gas is accepted", func() {
+		setup(blockbuster.BidRankingPerGas)
+
+		_, err := suite.anteHandler(suite.ctx, createBidTx(accounts[1], 500, 10_000), false)
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("bid with a lower bid per gas is rejected", func() {
+		setup(blockbuster.BidRankingPerGas)
+
+		_, err := suite.anteHandler(suite.ctx, createBidTx(accounts[2], 2000, 10_000_000), false)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("bids are compared by their total under the total ranking", func() {
+		setup(blockbuster.BidRankingTotal)
+
+		_, err := suite.anteHandler(suite.ctx, createBidTx(accounts[1], 500, 10_000), false)
+		suite.Require().Error(err)
+	})
+}
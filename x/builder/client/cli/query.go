@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -22,6 +23,12 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdQueryParams(),
+		CmdQueryAuctionResult(),
+		CmdQueryAuctionResults(),
+		CmdQuerySearcher(),
+		CmdQueryPendingRewards(),
+		CmdQueryValidatorRewards(),
+		CmdQueryCensorshipScore(),
 	)
 
 	return cmd
@@ -55,3 +62,191 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// CmdQueryAuctionResult implements a command that will return the auction results at a given height.
+func CmdQueryAuctionResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-result [height]",
+		Short: "Query the auction results at a given height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryAuctionResultRequest{Height: height}
+			response, err := queryClient.AuctionResult(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryAuctionResults implements a command that will return all of the auction results retained in state.
+func CmdQueryAuctionResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-results",
+		Short: "Query all of the auction results retained by the builder module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryAuctionResultsRequest{Pagination: pageReq}
+			response, err := queryClient.AuctionResults(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auction-results")
+
+	return cmd
+}
+
+// CmdQuerySearcher implements a command that will return a registered searcher.
+func CmdQuerySearcher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "searcher [address]",
+		Short: "Query a searcher registered with the builder module",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QuerySearcherRequest{Address: args[0]}
+			response, err := queryClient.Searcher(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&response.Searcher)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryPendingRewards implements a command that will return the auction proceeds that have been
+// accrued for a recipient but not paid out yet.
+func CmdQueryPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [address]",
+		Short: "Query the auction proceeds accrued for a recipient that have not been paid out",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryPendingRewardsRequest{Address: args[0]}
+			response, err := queryClient.PendingRewards(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryValidatorRewards implements a command that will return the proposer rewards accrued by a
+// validator that have not been withdrawn yet.
+func CmdQueryValidatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rewards [validator-address]",
+		Short: "Query the builder rewards accrued by a validator that have not been withdrawn",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryValidatorRewardsRequest{ValidatorAddress: args[0]}
+			response, err := queryClient.ValidatorRewards(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryCensorshipScore implements a command that will return the number of blocks in which a
+// validator was reported to have censored a higher bid.
+func CmdQueryCensorshipScore() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "censorship-score [validator-cons-address]",
+		Short: "Query the number of blocks in which a validator censored a higher bid",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryCensorshipScoreRequest{ValidatorAddress: args[0]}
+			response, err := queryClient.CensorshipScore(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -27,6 +28,16 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewAuctionBidTx(),
+		NewCommitBidTx(),
+		NewRevealBidTx(),
+		NewRevealBidCommitmentTx(),
+		NewSubmitBundleTx(),
+		NewRegisterSearcherTx(),
+		NewUnbondSearcherCollateralTx(),
+		NewWithdrawSearcherCollateralTx(),
+		NewWithdrawBuilderRewardsTx(),
+		NewSetBuilderRewardsWithdrawAddressTx(),
+		NewReportCensoredBidTx(),
 	)
 
 	return txCmd
@@ -83,3 +94,419 @@ where each transaction is a hex-encoded string of a signed transaction.
 
 	return cmd
 }
+
+func NewCommitBidTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bid [bidder] [bid] [bundled_tx1,bundled_tx2,...,bundled_txN] [salt] [collateral]",
+		Short: "Create a sealed bid commitment transaction",
+		Long: `Create a sealed bid commitment transaction. The commitment is computed from the bid,
+the list of signed bundled transactions and the salt, where each transaction and the salt are
+hex-encoded strings. The same bid, bundled transactions and salt must be used to reveal the bid.
+`,
+		Args:    cobra.ExactArgs(5),
+		Example: "commit-bid cosmos1... 10000uatom 0xFF...,0xCC...,0xAA... 0x1F... 100uatom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bid, bundledTxs, salt, err := parseSealedBid(args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			commitment := types.ComputeBidCommitment(clientCtx.GetFromAddress(), bid, bundledTxs, salt)
+			msg := types.NewMsgCommitBid(clientCtx.GetFromAddress(), commitment, collateral)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevealBidTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-bid [bidder] [bid] [bundled_tx1,bundled_tx2,...,bundled_txN] [salt]",
+		Short: "Create a transaction that reveals a sealed bid",
+		Long: `Create a transaction that reveals a sealed bid with a list of signed bundled transactions,
+where each transaction and the salt are hex-encoded strings.
+`,
+		Args:    cobra.ExactArgs(4),
+		Example: "reveal-bid cosmos1... 10000uatom 0xFF...,0xCC...,0xAA... 0x1F...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bid, bundledTxs, salt, err := parseSealedBid(args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			// ensure timeout is non-zero
+			timeoutHeight, _ := cmd.Flags().GetUint64(flags.FlagTimeoutHeight)
+			if timeoutHeight == 0 {
+				return errors.New("timeout height must be greater than 0")
+			}
+
+			msg := types.NewMsgRevealBid(clientCtx.GetFromAddress(), bid, bundledTxs, salt)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevealBidCommitmentTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-bid-commitment [bidder] [bid] [bundled_tx1,bundled_tx2,...,bundled_txN] [salt]",
+		Short: "Create a transaction that reveals a sealed bid without bidding",
+		Long: `Create a transaction that reveals a sealed bid without bidding, e.g. once the revealed
+bid lost the auction, so that the collateral of the commitment is returned rather than slashed
+once it expires. Each transaction and the salt are hex-encoded strings.
+`,
+		Args:    cobra.ExactArgs(4),
+		Example: "reveal-bid-commitment cosmos1... 10000uatom 0xFF...,0xCC...,0xAA... 0x1F...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bid, bundledTxs, salt, err := parseSealedBid(args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBidCommitment(clientCtx.GetFromAddress(), bid, bundledTxs, salt)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSubmitBundleTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-bundle [sender] [bundled_tx1,bundled_tx2,...,bundled_txN]",
+		Short: "Submit a bundle of signed transactions without a bid",
+		Long: `Submit a bundle of signed transactions that is executed atomically and in order
+without a bid, where each transaction is a hex-encoded string of a signed transaction.
+Each bundled transaction must carry the ExtensionOptionBundledTx non-critical extension
+option. Bundles are ranked by the combined fees of the submitting and the bundled
+transactions.
+`,
+		Args:    cobra.ExactArgs(2),
+		Example: "submit-bundle cosmos1... 0xFF...,0xCC...,0xAA...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokens := strings.Split(args[1], ",")
+			bundledTxs := make([][]byte, len(tokens))
+			for i, token := range tokens {
+				rawTx, err := hex.DecodeString(token)
+				if err != nil {
+					return fmt.Errorf("failed to HEX decode bundled transaction %d: %w", i, err)
+				}
+
+				bundledTxs[i] = rawTx
+			}
+
+			msg := types.NewMsgSubmitBundle(clientCtx.GetFromAddress(), bundledTxs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRegisterSearcherTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-searcher [searcher] [collateral]",
+		Short: "Create a transaction that registers a searcher",
+		Long: `Create a transaction that registers a searcher and bonds the collateral. Searchers
+that are already registered add the collateral to their bonded collateral.
+`,
+		Args:    cobra.ExactArgs(2),
+		Example: "register-searcher cosmos1... 1000uatom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterSearcher(clientCtx.GetFromAddress(), collateral)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUnbondSearcherCollateralTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond-searcher-collateral [searcher] [amount]",
+		Short: "Create a transaction that unbonds collateral of a registered searcher",
+		Long: `Create a transaction that unbonds collateral of a registered searcher. The unbonding
+collateral can be withdrawn once the searcher unbonding period has passed and can be
+slashed until then.
+`,
+		Args:    cobra.ExactArgs(2),
+		Example: "unbond-searcher-collateral cosmos1... 1000uatom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbondSearcherCollateral(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawSearcherCollateralTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-searcher-collateral [searcher]",
+		Short: "Create a transaction that withdraws the unbonded collateral of a registered searcher",
+		Long: `Create a transaction that withdraws the unbonding collateral of a registered searcher
+once the searcher unbonding period has passed.
+`,
+		Args:    cobra.ExactArgs(1),
+		Example: "withdraw-searcher-collateral cosmos1...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawSearcherCollateral(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawBuilderRewardsTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-builder-rewards [validator-addr]",
+		Short: "Create a transaction that withdraws the builder rewards accrued by a validator",
+		Long: `Create a transaction that withdraws the proposer rewards accrued by a validator to
+the validator's withdraw address. The transaction can be signed by any account, e.g. by
+the withdraw address.
+`,
+		Args:    cobra.ExactArgs(1),
+		Example: "withdraw-builder-rewards cosmosvaloper1... --from withdrawer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawBuilderRewards(clientCtx.GetFromAddress(), validator)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetBuilderRewardsWithdrawAddressTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-builder-rewards-withdraw-address [validator-key] [withdraw-address]",
+		Short: "Create a transaction that sets the address a validator withdraws builder rewards to",
+		Long: `Create a transaction that sets the address a validator withdraws its proposer
+rewards to. The transaction must be signed by the key of the validator's operator.
+`,
+		Args:    cobra.ExactArgs(2),
+		Example: "set-builder-rewards-withdraw-address validator cosmos1...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawAddress, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBuilderRewardsWithdrawAddress(sdk.ValAddress(clientCtx.GetFromAddress()), withdrawAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewReportCensoredBidTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-censored-bid [reporter] [height] [bid_tx] [validator] [vote_extension] [extension_signature]",
+		Short: "Create a transaction that reports a bid that was censored by the proposer of a block",
+		Long: `Create a transaction that reports that the proposer of the block at the given height
+omitted the reporter's bid from the auction although the bid was included in the vote extension
+of the given validator in the commit of the previous block. The bid transaction, the vote extension
+and its signature are hex-encoded strings, and the validator is a consensus address. The
+transaction must be signed by the bidder.
+`,
+		Args:    cobra.ExactArgs(6),
+		Example: "report-censored-bid cosmos1... 100 0xFF... cosmosvalcons1... 0xAA... 0xBB...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidTx, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to HEX decode bid transaction: %w", err)
+			}
+
+			validator, err := sdk.ConsAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+
+			voteExtension, err := hex.DecodeString(args[4])
+			if err != nil {
+				return fmt.Errorf("failed to HEX decode vote extension: %w", err)
+			}
+
+			signature, err := hex.DecodeString(args[5])
+			if err != nil {
+				return fmt.Errorf("failed to HEX decode vote extension signature: %w", err)
+			}
+
+			msg := types.NewMsgReportCensoredBid(clientCtx.GetFromAddress(), height, bidTx, validator, voteExtension, signature)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseSealedBid parses the bid, the hex-encoded bundled transactions and the hex-encoded salt
+// of a sealed bid.
+func parseSealedBid(bidArg, bundleArg, saltArg string) (sdk.Coin, [][]byte, []byte, error) {
+	bid, err := sdk.ParseCoinNormalized(bidArg)
+	if err != nil {
+		return sdk.Coin{}, nil, nil, err
+	}
+
+	tokens := strings.Split(bundleArg, ",")
+	bundledTxs := make([][]byte, len(tokens))
+	for i, token := range tokens {
+		rawTx, err := hex.DecodeString(token)
+		if err != nil {
+			return sdk.Coin{}, nil, nil, fmt.Errorf("failed to HEX decode bundled transaction %d: %w", i, err)
+		}
+
+		bundledTxs[i] = rawTx
+	}
+
+	salt, err := hex.DecodeString(saltArg)
+	if err != nil {
+		return sdk.Coin{}, nil, nil, fmt.Errorf("failed to HEX decode salt: %w", err)
+	}
+
+	return bid, bundledTxs, salt, nil
+}
@@ -19,6 +19,34 @@ func (k Keeper) ValidateBidInfo(ctx sdk.Context, highestBid sdk.Coin, bidInfo *t
 		return fmt.Errorf("bundle size (%d) exceeds max bundle size (%d)", len(bidInfo.Transactions), maxBundleSize)
 	}
 
+	// When sealed bids are enabled, only bids that reveal a commitment made in a previous block
+	// can participate in the auction. Sealed bids are not required to outbid the highest bid
+	// by the minimum bid increment since the auction selects the highest revealed bids.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case params.SealedBidsEnabled() && bidInfo.Commitment == nil:
+		return fmt.Errorf("sealed bids are enabled; bids must be committed to and revealed")
+
+	case params.SealedBidsEnabled():
+		if _, err := k.ValidateBidCommitment(ctx, bidInfo.Bidder, bidInfo.Commitment); err != nil {
+			return err
+		}
+
+		highestBid = sdk.Coin{}
+
+	case bidInfo.Commitment != nil:
+		return fmt.Errorf("sealed bids are disabled")
+	}
+
+	// Only registered searchers can bid when searcher registration is required.
+	if err := k.ValidateSearcher(ctx, params, bidInfo.Bidder); err != nil {
+		return err
+	}
+
 	// Validate the bid amount.
 	if err := k.ValidateAuctionBid(ctx, bidInfo.Bidder, bidInfo.Bid, highestBid); err != nil {
 		return err
@@ -39,6 +67,55 @@ func (k Keeper) ValidateBidInfo(ctx sdk.Context, highestBid sdk.Coin, bidInfo *t
 	return nil
 }
 
+// ValidateDecryptedBundle validates the bundle of a bid with encrypted bundled transactions once
+// it has been decrypted by the proposal handlers. The signers of encrypted bundled transactions are
+// not known when the bid is validated by the ante handler, so front-running protection is enforced
+// on the decrypted bundle instead. Encrypted bundles are rejected if the keeper has no bundle
+// decrypter, since the keeper could not track the decrypted transactions once the bid executes.
+func (k Keeper) ValidateDecryptedBundle(ctx sdk.Context, bidInfo *types.BidInfo) error {
+	if k.bundleDecrypter == nil {
+		return fmt.Errorf("encrypted bundled transactions require a bundle decrypter")
+	}
+
+	protectionEnabled, err := k.FrontRunningProtectionEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !protectionEnabled {
+		return nil
+	}
+
+	return k.ValidateAuctionBundle(bidInfo.Bidder, bidInfo.Signers)
+}
+
+// ValidateBundleInfo validates that the bundle can be included without a bid. Bundles submitted
+// without a bid are subject to the same bundle size limit and front-running protection as the
+// bundles of bids, where the sender takes the place of the bidder.
+func (k Keeper) ValidateBundleInfo(ctx sdk.Context, bundleInfo *types.BundleInfo) error {
+	maxBundleSize, err := k.GetMaxBundleSize(ctx)
+	if err != nil {
+		return err
+	}
+
+	if uint32(len(bundleInfo.Transactions)) > maxBundleSize {
+		return fmt.Errorf("bundle size (%d) exceeds max bundle size (%d)", len(bundleInfo.Transactions), maxBundleSize)
+	}
+
+	protectionEnabled, err := k.FrontRunningProtectionEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if protectionEnabled {
+		if err := k.ValidateAuctionBundle(bundleInfo.Sender, bundleInfo.Signers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ValidateAuctionBid validates that the bidder has sufficient funds to participate in the auction and that the bid amount
 // is sufficiently high enough.
 func (k Keeper) ValidateAuctionBid(ctx sdk.Context, bidder sdk.AccAddress, bid, highestBid sdk.Coin) error {
@@ -46,15 +123,16 @@ func (k Keeper) ValidateAuctionBid(ctx sdk.Context, bidder sdk.AccAddress, bid,
 		return fmt.Errorf("bid amount cannot be nil")
 	}
 
-	// Get the bid floor.
-	reserveFee, err := k.GetReserveFee(ctx)
+	// Get the bid floor and minimum bid increment for the denom of the bid. This
+	// will error if the denom is not an allowed bid denom.
+	reserveFee, minBidIncrement, err := k.GetAuctionFees(ctx, bid.Denom)
 	if err != nil {
 		return err
 	}
 
 	// Ensure that the bid denomination matches the fee denominations.
-	if bid.Denom != reserveFee.Denom {
-		return fmt.Errorf("bid denom (%s) does not match the reserve fee denom (%s)", bid, reserveFee)
+	if minBidIncrement.Denom != bid.Denom {
+		return fmt.Errorf("min bid increment denom (%s) does not match the bid denom (%s)", minBidIncrement, bid)
 	}
 
 	// Bid must be greater than the bid floor.
@@ -64,25 +142,20 @@ func (k Keeper) ValidateAuctionBid(ctx sdk.Context, bidder sdk.AccAddress, bid,
 
 	if !highestBid.IsNil() {
 		// Ensure the bid is greater than the highest bid + min bid increment.
-		minBidIncrement, err := k.GetMinBidIncrement(ctx)
-		if err != nil {
+		if highestBid.Denom == bid.Denom {
+			minBid := highestBid.Add(minBidIncrement)
+			if !bid.IsGTE(minBid) {
+				return fmt.Errorf(
+					"bid amount (%s) is less than the highest bid (%s) + min bid increment (%s); smallest acceptable bid is (%s)",
+					bid,
+					highestBid,
+					minBidIncrement,
+					minBid,
+				)
+			}
+		} else if err := k.validateCrossDenomBid(ctx, bid, highestBid, minBidIncrement); err != nil {
 			return err
 		}
-
-		if minBidIncrement.Denom != bid.Denom {
-			return fmt.Errorf("min bid increment denom (%s) does not match the bid denom (%s)", minBidIncrement, bid)
-		}
-
-		minBid := highestBid.Add(minBidIncrement)
-		if !bid.IsGTE(minBid) {
-			return fmt.Errorf(
-				"bid amount (%s) is less than the highest bid (%s) + min bid increment (%s); smallest acceptable bid is (%s)",
-				bid,
-				highestBid,
-				minBidIncrement,
-				minBid,
-			)
-		}
 	}
 
 	// ensure the bidder has enough funds to cover all the inclusion fees
@@ -94,6 +167,40 @@ func (k Keeper) ValidateAuctionBid(ctx sdk.Context, bidder sdk.AccAddress, bid,
 	return nil
 }
 
+// validateCrossDenomBid validates that a bid is greater than the highest bid + min bid increment
+// when the bids are denominated in different denoms. Both bids are converted into a common unit
+// of account using the price converter.
+func (k Keeper) validateCrossDenomBid(ctx sdk.Context, bid, highestBid, minBidIncrement sdk.Coin) error {
+	bidValue, err := k.priceConverter.ConvertBid(ctx, bid)
+	if err != nil {
+		return fmt.Errorf("failed to convert bid (%s): %w", bid, err)
+	}
+
+	highestBidValue, err := k.priceConverter.ConvertBid(ctx, highestBid)
+	if err != nil {
+		return fmt.Errorf("failed to convert highest bid (%s): %w", highestBid, err)
+	}
+
+	minBidIncrementValue, err := k.priceConverter.ConvertBid(ctx, minBidIncrement)
+	if err != nil {
+		return fmt.Errorf("failed to convert min bid increment (%s): %w", minBidIncrement, err)
+	}
+
+	minBidValue := highestBidValue.Add(minBidIncrementValue)
+	if bidValue.LT(minBidValue) {
+		return fmt.Errorf(
+			"bid value (%s) of bid (%s) is less than the value of the highest bid (%s) + min bid increment (%s); smallest acceptable value is (%s)",
+			bidValue,
+			bid,
+			highestBid,
+			minBidIncrement,
+			minBidValue,
+		)
+	}
+
+	return nil
+}
+
 // ValidateAuctionBundle validates the ordering of the referenced transactions. Bundles are valid if
 //  1. all of the transactions are signed by the signer.
 //  2. some subset of contiguous transactions starting from the first tx are signed by the same signer, and all other tranasctions
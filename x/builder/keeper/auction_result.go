@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetAuctionResult stores the auction result for the height and bundle index included in the result.
+func (k Keeper) SetAuctionResult(ctx sdk.Context, result types.AuctionResult) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := result.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetAuctionResultKey(result.Height, result.BundleIndex), bz)
+
+	return nil
+}
+
+// GetAuctionResult returns the auction result of the winning bundle at the given height and bundle index.
+func (k Keeper) GetAuctionResult(ctx sdk.Context, height uint64, bundleIndex uint32) (types.AuctionResult, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAuctionResultKey(height, bundleIndex))
+	if len(bz) == 0 {
+		return types.AuctionResult{}, fmt.Errorf("no auction result found for height %d and bundle index %d", height, bundleIndex)
+	}
+
+	result := types.AuctionResult{}
+	if err := result.Unmarshal(bz); err != nil {
+		return types.AuctionResult{}, err
+	}
+
+	return result, nil
+}
+
+// GetAuctionResultsAtHeight returns the auction results of all of the winning bundles at the given
+// height ordered by bundle index.
+func (k Keeper) GetAuctionResultsAtHeight(ctx sdk.Context, height uint64) ([]types.AuctionResult, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAuctionResultHeightPrefix(height))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	results := make([]types.AuctionResult, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		result := types.AuctionResult{}
+		if err := result.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// IterateAuctionResults iterates over all of the auction results in ascending height and
+// bundle index order and calls the provided callback. Iteration stops if the callback returns true.
+func (k Keeper) IterateAuctionResults(ctx sdk.Context, cb func(result types.AuctionResult) (stop bool)) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuctionResult)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		result := types.AuctionResult{}
+		if err := result.Unmarshal(iterator.Value()); err != nil {
+			return err
+		}
+
+		if cb(result) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// GetAllAuctionResults returns all of the auction results retained in state.
+func (k Keeper) GetAllAuctionResults(ctx sdk.Context) ([]types.AuctionResult, error) {
+	results := make([]types.AuctionResult, 0)
+
+	err := k.IterateAuctionResults(ctx, func(result types.AuctionResult) bool {
+		results = append(results, result)
+		return false
+	})
+
+	return results, err
+}
+
+// PruneAuctionResults removes all auction results that fall outside of the retention
+// window defined by the AuctionResultRetention parameter. The vote extension auctions and the
+// censorship reports of blocks outside of the window are removed as well, so censored bids can
+// only be reported within the window. Pruning is disabled when the retention is set to zero.
+func (k Keeper) PruneAuctionResults(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if params.AuctionResultRetention == 0 || height < params.AuctionResultRetention {
+		return nil
+	}
+
+	// All results with a height strictly less than the cutoff are pruned.
+	cutoff := height - params.AuctionResultRetention + 1
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixAuctionResult, types.GetAuctionResultHeightPrefix(cutoff))
+	defer iterator.Close()
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	reportIterator := store.Iterator(types.KeyPrefixCensorshipReport, types.GetCensorshipReportHeightPrefix(cutoff))
+	defer reportIterator.Close()
+
+	for ; reportIterator.Valid(); reportIterator.Next() {
+		keys = append(keys, reportIterator.Key())
+	}
+
+	evidenceIterator := store.Iterator(types.KeyPrefixAuctionEvidence, types.GetAuctionEvidenceKey(cutoff))
+	defer evidenceIterator.Close()
+
+	for ; evidenceIterator.Valid(); evidenceIterator.Next() {
+		keys = append(keys, evidenceIterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return nil
+}
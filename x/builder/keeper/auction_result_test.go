@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) createAuctionResults(heights ...uint64) []types.AuctionResult {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	bidder := testutils.RandomAccounts(rng, 1)[0]
+
+	results := make([]types.AuctionResult, len(heights))
+	for i, height := range heights {
+		results[i] = types.AuctionResult{
+			Height:         height,
+			Bidder:         bidder.Address.String(),
+			Bid:            sdk.NewInt64Coin("stake", int64(100*(i+1))),
+			ProposerReward: sdk.NewCoins(sdk.NewInt64Coin("stake", int64(10*(i+1)))),
+			EscrowReward:   sdk.NewCoins(sdk.NewInt64Coin("stake", int64(90*(i+1)))),
+		}
+
+		suite.Require().NoError(suite.builderKeeper.SetAuctionResult(suite.ctx, results[i]))
+	}
+
+	return results
+}
+
+func (suite *KeeperTestSuite) TestAuctionResults() {
+	results := suite.createAuctionResults(3, 1, 2)
+
+	suite.Run("can retrieve each auction result", func() {
+		for _, expected := range results {
+			result, err := suite.builderKeeper.GetAuctionResult(suite.ctx, expected.Height, expected.BundleIndex)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expected, result)
+		}
+	})
+
+	suite.Run("returns an error for a missing height", func() {
+		_, err := suite.builderKeeper.GetAuctionResult(suite.ctx, 4, 0)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("iterates in ascending height order", func() {
+		all, err := suite.builderKeeper.GetAllAuctionResults(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().Len(all, 3)
+
+		for i, result := range all {
+			suite.Require().Equal(uint64(i+1), result.Height)
+		}
+	})
+
+	suite.Run("can retrieve multiple winning bundles at the same height", func() {
+		second := results[0]
+		second.BundleIndex = 1
+		second.Bid = sdk.NewInt64Coin("stake", 50)
+		suite.Require().NoError(suite.builderKeeper.SetAuctionResult(suite.ctx, second))
+
+		atHeight, err := suite.builderKeeper.GetAuctionResultsAtHeight(suite.ctx, results[0].Height)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.AuctionResult{results[0], second}, atHeight)
+	})
+}
+
+func (suite *KeeperTestSuite) TestPruneAuctionResults() {
+	testCases := []struct {
+		name      string
+		retention uint64
+		height    int64
+		expected  []uint64
+	}{
+		{
+			name:      "pruning disabled",
+			retention: 0,
+			height:    10,
+			expected:  []uint64{1, 2, 3, 4, 5},
+		},
+		{
+			name:      "height below retention",
+			retention: 10,
+			height:    5,
+			expected:  []uint64{1, 2, 3, 4, 5},
+		},
+		{
+			name:      "prunes results outside of the retention window",
+			retention: 2,
+			height:    5,
+			expected:  []uint64{4, 5},
+		},
+		{
+			name:      "retains only the current height",
+			retention: 1,
+			height:    5,
+			expected:  []uint64{5},
+		},
+		{
+			name:      "prunes results outside of the default retention window",
+			retention: types.DefaultAuctionResultRetention,
+			height:    int64(types.DefaultAuctionResultRetention) + 3,
+			expected:  []uint64{4, 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.createAuctionResults(1, 2, 3, 4, 5)
+
+			params := types.DefaultParams()
+			params.AuctionResultRetention = tc.retention
+			suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+			ctx := suite.ctx.WithBlockHeight(tc.height)
+			suite.Require().NoError(suite.builderKeeper.PruneAuctionResults(ctx))
+
+			all, err := suite.builderKeeper.GetAllAuctionResults(ctx)
+			suite.Require().NoError(err)
+
+			heights := make([]uint64, len(all))
+			for i, result := range all {
+				heights[i] = result.Height
+			}
+			suite.Require().Equal(tc.expected, heights)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAuctionResults() {
+	results := suite.createAuctionResults(1, 2, 3)
+	queryServer := keeper.NewQueryServer(suite.builderKeeper)
+
+	suite.Run("query a single auction result", func() {
+		resp, err := queryServer.AuctionResult(suite.ctx, &types.QueryAuctionResultRequest{Height: 2})
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.AuctionResult{results[1]}, resp.AuctionResults)
+	})
+
+	suite.Run("query a missing auction result", func() {
+		_, err := queryServer.AuctionResult(suite.ctx, &types.QueryAuctionResultRequest{Height: 10})
+		suite.Require().Error(err)
+	})
+
+	suite.Run("query all auction results with pagination", func() {
+		resp, err := queryServer.AuctionResults(suite.ctx, &types.QueryAuctionResultsRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(results[:2], resp.AuctionResults)
+		suite.Require().Equal(uint64(3), resp.Pagination.Total)
+
+		resp, err = queryServer.AuctionResults(suite.ctx, &types.QueryAuctionResultsRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(results[2:], resp.AuctionResults)
+	})
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetAuctionSettlement stores the auction settlement of the current block.
+func (k Keeper) SetAuctionSettlement(ctx sdk.Context, settlement types.AuctionSettlement) error {
+	bz, err := settlement.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAuctionSettlement, bz)
+
+	return nil
+}
+
+// GetAuctionSettlement returns the auction settlement of the current block.
+func (k Keeper) GetAuctionSettlement(ctx sdk.Context) (types.AuctionSettlement, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyAuctionSettlement)
+	if len(bz) == 0 {
+		return types.AuctionSettlement{}, fmt.Errorf("no auction settlement found")
+	}
+
+	settlement := types.AuctionSettlement{}
+	if err := settlement.Unmarshal(bz); err != nil {
+		return types.AuctionSettlement{}, err
+	}
+
+	return settlement, nil
+}
+
+// HasAuctionSettlement returns true if an auction settlement is stored for the current block.
+func (k Keeper) HasAuctionSettlement(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyAuctionSettlement)
+}
+
+// DeleteAuctionSettlement removes the auction settlement of the current block.
+func (k Keeper) DeleteAuctionSettlement(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyAuctionSettlement)
+}
+
+// PreFinalizeBlockHook returns a hook that stores the auction settlement included in a block
+// before any of the transactions in the block are executed. This allows the winners of the
+// auction to be charged the clearing price when the second price pricing rule is used. The
+// hook also records the vote extension auction of the block so that censored bids can be
+// reported, and marks the sealed bids revealed in the block or its auction info as revealed so
+// that losing reveals are not slashed. The hook must be set on the BaseApp of the application.
+func (k Keeper) PreFinalizeBlockHook() sdk.PreFinalizeBlockHook {
+	return func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) error {
+		k.DeleteAuctionSettlement(ctx)
+
+		if err := k.processAuctionInfo(ctx, req); err != nil {
+			return err
+		}
+
+		if err := k.MarkRevealedBidCommitments(ctx, req.Txs); err != nil {
+			return err
+		}
+
+		for _, bz := range req.Txs {
+			if !types.IsAuctionSettlement(bz) {
+				continue
+			}
+
+			// Auction settlements are verified when the block proposal is processed, so an
+			// invalid settlement is ignored rather than halting the chain.
+			settlement, err := types.DecodeAuctionSettlement(bz)
+			if err != nil {
+				k.Logger(ctx).Error("failed to decode auction settlement", "err", err)
+				return nil
+			}
+
+			return k.SetAuctionSettlement(ctx, settlement)
+		}
+
+		return nil
+	}
+}
+
+// GetClearingPrice returns the amount that the winner of the auction pays for the given bid.
+// Under the first price pricing rule, the winner pays its bid. Under the second price pricing
+// rule, the block must include an auction settlement. The winner pays the runner-up bid plus
+// the minimum bid increment, or the reserve fee if there is no runner-up bid. A runner-up bid
+// in a different denom is converted to the denom of the winning bid with the price converter.
+// The winner never pays more than its bid.
+func (k Keeper) GetClearingPrice(ctx sdk.Context, params types.Params, bid sdk.Coin) (sdk.Coin, error) {
+	if !params.SecondPriceEnabled() {
+		return bid, nil
+	}
+
+	reserveFee, minBidIncrement, found := params.GetAuctionFees(bid.Denom)
+	if !found {
+		return sdk.Coin{}, fmt.Errorf("denom %s is not an allowed bid denom; allowed denoms are %v", bid.Denom, params.GetAllowedBidDenoms())
+	}
+
+	settlement, err := k.GetAuctionSettlement(ctx)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("the second price pricing rule requires an auction settlement: %w", err)
+	}
+
+	price := reserveFee
+	if settlement.HasRunnerUp() {
+		runnerUpBid, err := k.convertBidToDenom(ctx, settlement.RunnerUpBid, bid.Denom)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to price the runner-up bid in %s: %w", bid.Denom, err)
+		}
+
+		if runnerUpPrice := runnerUpBid.Add(minBidIncrement); runnerUpPrice.IsGTE(price) {
+			price = runnerUpPrice
+		}
+	}
+
+	if price.IsGTE(bid) {
+		return bid, nil
+	}
+
+	return price, nil
+}
+
+// convertBidToDenom converts the bid to the given denom using the price converter. The
+// converted amount is rounded up so that it is worth at least as much as the bid.
+func (k Keeper) convertBidToDenom(ctx sdk.Context, bid sdk.Coin, denom string) (sdk.Coin, error) {
+	if bid.Denom == denom {
+		return bid, nil
+	}
+
+	bidValue, err := k.priceConverter.ConvertBid(ctx, bid)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	unitValue, err := k.priceConverter.ConvertBid(ctx, sdk.NewCoin(denom, math.OneInt()))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !unitValue.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("the price of %s must be positive", denom)
+	}
+
+	return sdk.NewCoin(denom, bidValue.Quo(unitValue).Ceil().TruncateInt()), nil
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/prices"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestGetClearingPrice() {
+	reserveFee := sdk.NewInt64Coin("stake", 10)
+	minBidIncrement := sdk.NewInt64Coin("stake", 5)
+	bid := sdk.NewInt64Coin("stake", 100)
+
+	coin := func(denom string, amount int64) *sdk.Coin {
+		c := sdk.NewInt64Coin(denom, amount)
+		return &c
+	}
+
+	testCases := []struct {
+		name        string
+		rule        types.PricingRule
+		runnerUpBid *sdk.Coin
+		expected    sdk.Coin
+	}{
+		{
+			"first price pricing rule charges the bid",
+			types.PricingRule_PRICING_RULE_FIRST_PRICE,
+			coin("stake", 50),
+			bid,
+		},
+		{
+			"second price pricing rule without a runner-up bid charges the reserve fee",
+			types.PricingRule_PRICING_RULE_SECOND_PRICE,
+			&sdk.Coin{},
+			reserveFee,
+		},
+		{
+			"second price pricing rule charges the runner-up bid plus the min bid increment",
+			types.PricingRule_PRICING_RULE_SECOND_PRICE,
+			coin("stake", 50),
+			sdk.NewInt64Coin("stake", 55),
+		},
+		{
+			"second price pricing rule never charges less than the reserve fee",
+			types.PricingRule_PRICING_RULE_SECOND_PRICE,
+			coin("stake", 1),
+			reserveFee,
+		},
+		{
+			"second price pricing rule never charges more than the bid",
+			types.PricingRule_PRICING_RULE_SECOND_PRICE,
+			coin("stake", 99),
+			bid,
+		},
+		{
+			"runner-up bid in a different denom is converted to the denom of the bid",
+			types.PricingRule_PRICING_RULE_SECOND_PRICE,
+			coin("atom", 20),
+			sdk.NewInt64Coin("stake", 45),
+		},
+		{
+			"converted runner-up bid is rounded up",
+			types.PricingRule_PRICING_RULE_SECOND_PRICE,
+			coin("osmo", 21),
+			sdk.NewInt64Coin("stake", 37),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// One atom is worth two stake and one osmo is worth one and a half stake.
+			suite.builderKeeper = suite.builderKeeper.WithPriceConverter(prices.NewStaticPriceConverter(map[string]math.LegacyDec{
+				"stake": math.LegacyOneDec(),
+				"atom":  math.LegacyNewDec(2),
+				"osmo":  math.LegacyNewDecWithPrec(15, 1),
+			}))
+
+			params := types.DefaultParams()
+			params.ReserveFee = reserveFee
+			params.MinBidIncrement = minBidIncrement
+			params.PricingRule = tc.rule
+
+			settlement := types.AuctionSettlement{RunnerUpBid: *tc.runnerUpBid}
+			if !tc.runnerUpBid.IsNil() {
+				settlement.RunnerUpBidTxHash = bytes.Repeat([]byte{0x01}, 32)
+			}
+			suite.Require().NoError(suite.builderKeeper.SetAuctionSettlement(suite.ctx, settlement))
+
+			price, err := suite.builderKeeper.GetClearingPrice(suite.ctx, params, bid)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, price)
+		})
+	}
+
+	suite.Run("second price pricing rule requires an auction settlement", func() {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.PricingRule = types.PricingRule_PRICING_RULE_SECOND_PRICE
+
+		_, err := suite.builderKeeper.GetClearingPrice(suite.ctx, params, bid)
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *KeeperTestSuite) TestSecondPriceAuctionBid() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+
+	bid := sdk.NewInt64Coin("stake", 100)
+	runnerUpBid := sdk.NewInt64Coin("stake", 50)
+	price := runnerUpBid.Add(types.DefaultMinBidIncrement)
+
+	suite.SetupTest()
+
+	params := types.DefaultParams()
+	params.EscrowAccountAddress = escrow.Address
+	params.PricingRule = types.PricingRule_PRICING_RULE_SECOND_PRICE
+	suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+	// The pre finalize block hook stores the auction settlement included in the block.
+	settlementBz, err := types.EncodeAuctionSettlement(types.AuctionSettlement{
+		RunnerUpBid:       runnerUpBid,
+		RunnerUpBidTxHash: bytes.Repeat([]byte{0x01}, 32),
+	})
+	suite.Require().NoError(err)
+
+	hook := suite.builderKeeper.PreFinalizeBlockHook()
+	suite.Require().NoError(hook(suite.ctx, &cometabci.RequestFinalizeBlock{Txs: [][]byte{{0x0A}, settlementBz}}))
+
+	settlement, err := suite.builderKeeper.GetAuctionSettlement(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(runnerUpBid, settlement.RunnerUpBid)
+
+	// The winner is charged the clearing price rather than its bid.
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), bidder.Address, escrow.Address, sdk.NewCoins(price)).
+		Return(nil)
+
+	_, err = suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, nil))
+	suite.Require().NoError(err)
+
+	results, err := suite.builderKeeper.GetAuctionResultsAtHeight(suite.ctx, uint64(suite.ctx.BlockHeight()))
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+	suite.Require().Equal(bid, results[0].Bid)
+	suite.Require().Equal(&price, results[0].Price)
+
+	// The auction settlement of a previous block is removed by the hook.
+	suite.Require().NoError(hook(suite.ctx, &cometabci.RequestFinalizeBlock{}))
+	suite.Require().False(suite.builderKeeper.HasAuctionSettlement(suite.ctx))
+}
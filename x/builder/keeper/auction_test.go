@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testutils "github.com/skip-mev/pob/testutils"
 	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/prices"
 	"github.com/skip-mev/pob/x/builder/types"
 )
 
@@ -327,3 +328,206 @@ func (suite *KeeperTestSuite) TestValidateBundle() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestValidateDecryptedBundle() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	bidder := testutils.RandomAccounts(rng, 1)[0]
+	other := testutils.RandomAccounts(rng, 1)[0]
+
+	// The bidder front-runs a transaction of another account.
+	bidInfo := &types.BidInfo{
+		Bidder: bidder.Address,
+		Signers: []map[string]struct{}{
+			{bidder.Address.String(): {}},
+			{other.Address.String(): {}},
+		},
+	}
+
+	// Encrypted bundles are rejected if the keeper cannot decrypt them.
+	suite.Require().Error(suite.builderKeeper.ValidateDecryptedBundle(suite.ctx, bidInfo))
+
+	for _, frontRunningProtection := range []bool{true, false} {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.FrontRunningProtection = frontRunningProtection
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		err := suite.builderKeeper.WithBundleDecrypter(plaintextDecrypter{}).ValidateDecryptedBundle(suite.ctx, bidInfo)
+		if frontRunningProtection {
+			suite.Require().Error(err)
+		} else {
+			suite.Require().NoError(err)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidateBundleInfo() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	sender := testutils.RandomAccounts(rng, 1)[0]
+	other := testutils.RandomAccounts(rng, 1)[0]
+
+	cases := []struct {
+		name                   string
+		signers                []testutils.Account
+		maxBundleSize          uint32
+		frontRunningProtection bool
+		pass                   bool
+	}{
+		{
+			"valid bundle signed by the sender",
+			[]testutils.Account{sender, sender},
+			2,
+			true,
+			true,
+		},
+		{
+			"bundle exceeds the max bundle size",
+			[]testutils.Account{sender, sender, sender},
+			2,
+			true,
+			false,
+		},
+		{
+			"sender front-runs another account",
+			[]testutils.Account{sender, other},
+			2,
+			true,
+			false,
+		},
+		{
+			"sender front-runs another account without front-running protection",
+			[]testutils.Account{sender, other},
+			2,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := types.DefaultParams()
+			params.MaxBundleSize = tc.maxBundleSize
+			params.FrontRunningProtection = tc.frontRunningProtection
+			suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+			bundleInfo := &types.BundleInfo{
+				Sender:       sender.Address,
+				Transactions: make([][]byte, len(tc.signers)),
+				Signers:      make([]map[string]struct{}, len(tc.signers)),
+			}
+			for index, signer := range tc.signers {
+				bundleInfo.Transactions[index] = []byte{byte(index)}
+				bundleInfo.Signers[index] = map[string]struct{}{signer.Address.String(): {}}
+			}
+
+			err := suite.builderKeeper.ValidateBundleInfo(suite.ctx, bundleInfo)
+			if tc.pass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidateAuctionBidMultiDenom() {
+	rnd := rand.New(rand.NewSource(time.Now().Unix()))
+	bidder := testutils.RandomAccounts(rnd, 1)[0]
+
+	params := types.DefaultParams()
+	params.ReserveFee = sdk.NewInt64Coin("stake", 100)
+	params.MinBidIncrement = sdk.NewInt64Coin("stake", 10)
+	params.BidDenoms = []types.BidDenom{
+		{
+			ReserveFee:      sdk.NewInt64Coin("usdc", 50),
+			MinBidIncrement: sdk.NewInt64Coin("usdc", 5),
+		},
+	}
+	suite.Require().NoError(params.Validate())
+	suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+	// One usdc is worth two stake.
+	suite.builderKeeper = suite.builderKeeper.WithPriceConverter(prices.NewStaticPriceConverter(map[string]math.LegacyDec{
+		"stake": math.LegacyOneDec(),
+		"usdc":  math.LegacyNewDec(2),
+	}))
+
+	suite.bankKeeper.EXPECT().GetBalance(suite.ctx, bidder.Address, "stake").Return(sdk.NewInt64Coin("stake", 10000)).AnyTimes()
+	suite.bankKeeper.EXPECT().GetBalance(suite.ctx, bidder.Address, "usdc").Return(sdk.NewInt64Coin("usdc", 10000)).AnyTimes()
+
+	cases := []struct {
+		name       string
+		bid        sdk.Coin
+		highestBid sdk.Coin
+		pass       bool
+	}{
+		{
+			"bid in a denom that is not allowed",
+			sdk.NewInt64Coin("atom", 1000),
+			sdk.Coin{},
+			false,
+		},
+		{
+			"bid below the reserve fee of its denom",
+			sdk.NewInt64Coin("usdc", 49),
+			sdk.Coin{},
+			false,
+		},
+		{
+			"bid at the reserve fee of its denom",
+			sdk.NewInt64Coin("usdc", 50),
+			sdk.Coin{},
+			true,
+		},
+		{
+			"bid does not outbid the highest bid in the same denom",
+			sdk.NewInt64Coin("usdc", 104),
+			sdk.NewInt64Coin("usdc", 100),
+			false,
+		},
+		{
+			"bid outbids the highest bid in the same denom",
+			sdk.NewInt64Coin("usdc", 105),
+			sdk.NewInt64Coin("usdc", 100),
+			true,
+		},
+		{
+			"bid does not outbid the highest bid in a different denom",
+			sdk.NewInt64Coin("usdc", 104),
+			sdk.NewInt64Coin("stake", 200),
+			false,
+		},
+		{
+			"bid outbids the highest bid in a different denom",
+			sdk.NewInt64Coin("usdc", 105),
+			sdk.NewInt64Coin("stake", 200),
+			true,
+		},
+		{
+			"bid in the reserve fee denom outbids a bid in a different denom",
+			sdk.NewInt64Coin("stake", 210),
+			sdk.NewInt64Coin("usdc", 100),
+			true,
+		},
+		{
+			"bid in the reserve fee denom does not outbid a bid in a different denom",
+			sdk.NewInt64Coin("stake", 209),
+			sdk.NewInt64Coin("usdc", 100),
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			err := suite.builderKeeper.ValidateAuctionBid(suite.ctx, bidder.Address, tc.bid, tc.highestBid)
+			if tc.pass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
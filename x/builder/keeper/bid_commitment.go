@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetBidCommitment stores the sealed bid commitment and indexes it by its expiration height.
+func (k Keeper) SetBidCommitment(ctx sdk.Context, commitment types.BidCommitment) error {
+	bidder, err := sdk.AccAddressFromBech32(commitment.Bidder)
+	if err != nil {
+		return err
+	}
+
+	bz, err := commitment.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBidCommitmentKey(bidder, commitment.Commitment), bz)
+	store.Set(types.GetBidCommitmentExpirationKey(commitment.ExpirationHeight, bidder, commitment.Commitment), []byte{})
+
+	return nil
+}
+
+// GetBidCommitment returns the sealed bid commitment of the bidder.
+func (k Keeper) GetBidCommitment(ctx sdk.Context, bidder sdk.AccAddress, commitment []byte) (types.BidCommitment, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetBidCommitmentKey(bidder, commitment))
+	if len(bz) == 0 {
+		return types.BidCommitment{}, fmt.Errorf("no bid commitment %X found for bidder %s", commitment, bidder)
+	}
+
+	result := types.BidCommitment{}
+	if err := result.Unmarshal(bz); err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	return result, nil
+}
+
+// DeleteBidCommitment removes the sealed bid commitment and its expiration index entry.
+func (k Keeper) DeleteBidCommitment(ctx sdk.Context, commitment types.BidCommitment) error {
+	bidder, err := sdk.AccAddressFromBech32(commitment.Bidder)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBidCommitmentKey(bidder, commitment.Commitment))
+	store.Delete(types.GetBidCommitmentExpirationKey(commitment.ExpirationHeight, bidder, commitment.Commitment))
+
+	return nil
+}
+
+// GetAllBidCommitments returns all of the sealed bid commitments that have not been revealed.
+func (k Keeper) GetAllBidCommitments(ctx sdk.Context) ([]types.BidCommitment, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBidCommitment)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	commitments := make([]types.BidCommitment, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		commitment := types.BidCommitment{}
+		if err := commitment.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments, nil
+}
+
+// ValidateBidCommitment validates that the bidder committed to the sealed bid in a previous
+// block and that the bid is revealed within the reveal window. The commitment is returned
+// if it is valid.
+func (k Keeper) ValidateBidCommitment(ctx sdk.Context, bidder sdk.AccAddress, commitment []byte) (types.BidCommitment, error) {
+	bidCommitment, err := k.GetBidCommitment(ctx, bidder, commitment)
+	if err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	// If the mode is CheckTx or ReCheckTx, we increment the current block height by one to
+	// account for the fact that the transaction will be executed in the next block.
+	height := uint64(ctx.BlockHeight())
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		height++
+	}
+
+	if height <= bidCommitment.Height {
+		return types.BidCommitment{}, fmt.Errorf(
+			"bid must be revealed after the block the commitment was included in (commitment height: %d, current block height: %d)",
+			bidCommitment.Height,
+			height,
+		)
+	}
+
+	if height > bidCommitment.ExpirationHeight {
+		return types.BidCommitment{}, fmt.Errorf(
+			"reveal window has passed (expiration height: %d, current block height: %d)",
+			bidCommitment.ExpirationHeight,
+			height,
+		)
+	}
+
+	return bidCommitment, nil
+}
+
+// MarkRevealedBidCommitments marks the sealed bid commitments of all of the reveals included in
+// the given transactions as revealed, e.g. the transactions of the current block or the bids of
+// its auction info. The reveal of a bid can be included in a block even if its transaction fails
+// or the bid loses the auction, so this ensures that the collateral of bidders that revealed
+// their bid in time is returned rather than slashed once their commitments expire. Transactions
+// that cannot be decoded and reveals that do not match a commitment within its reveal window are
+// ignored.
+func (k Keeper) MarkRevealedBidCommitments(ctx sdk.Context, txs [][]byte) error {
+	if k.txDecoder == nil {
+		return nil
+	}
+
+	for _, txBz := range txs {
+		tx, err := k.txDecoder(txBz)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			revealMsg, ok := msg.(*types.MsgRevealBid)
+			if !ok {
+				continue
+			}
+
+			bidder, err := sdk.AccAddressFromBech32(revealMsg.Bidder)
+			if err != nil {
+				continue
+			}
+
+			commitment, err := k.ValidateBidCommitment(
+				ctx,
+				bidder,
+				types.ComputeBidCommitment(bidder, revealMsg.Bid, revealMsg.Transactions, revealMsg.Salt),
+			)
+			if err != nil || commitment.Revealed {
+				continue
+			}
+
+			if err := k.markBidCommitmentRevealed(ctx, commitment); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// markBidCommitmentRevealed marks the sealed bid commitment as revealed. Its collateral is
+// returned to the bidder once the commitment expires.
+func (k Keeper) markBidCommitmentRevealed(ctx sdk.Context, commitment types.BidCommitment) error {
+	commitment.Revealed = true
+	return k.SetBidCommitment(ctx, commitment)
+}
+
+// SlashExpiredBidCommitments removes all of the sealed bid commitments that can no longer be
+// revealed. The collateral of commitments that were revealed is returned to the bidder, while
+// the collateral of commitments that were never revealed is sent to the escrow account.
+func (k Keeper) SlashExpiredBidCommitments(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// All commitments with an expiration height less than or equal to the current height
+	// cannot be revealed in any subsequent block.
+	height := uint64(ctx.BlockHeight())
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixBidCommitmentExpiration, types.GetBidCommitmentExpirationPrefix(height+1))
+	defer iterator.Close()
+
+	expired := make([]types.BidCommitment, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// The expiration index key is the expiration height prefix followed by the suffix of
+		// the commitment's primary key.
+		suffix := iterator.Key()[len(types.GetBidCommitmentExpirationPrefix(0)):]
+		bz := store.Get(append(append([]byte{}, types.KeyPrefixBidCommitment...), suffix...))
+
+		commitment := types.BidCommitment{}
+		if err := commitment.Unmarshal(bz); err != nil {
+			return err
+		}
+
+		expired = append(expired, commitment)
+	}
+
+	for _, commitment := range expired {
+		eventType, recipient := types.EventTypeSlashCommitment, sdk.AccAddress(params.EscrowAccountAddress)
+		if commitment.Revealed {
+			bidder, err := sdk.AccAddressFromBech32(commitment.Bidder)
+			if err != nil {
+				return err
+			}
+
+			eventType, recipient = types.EventTypeReleaseCommitment, bidder
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, k.moduleAddress, recipient, sdk.NewCoins(commitment.Collateral)); err != nil {
+			return err
+		}
+
+		if err := k.DeleteBidCommitment(ctx, commitment); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.EventAttrBidder, commitment.Bidder),
+				sdk.NewAttribute(types.EventAttrCommitment, fmt.Sprintf("%X", commitment.Commitment)),
+				sdk.NewAttribute(types.EventAttrCollateral, commitment.Collateral.String()),
+			),
+		)
+	}
+
+	return nil
+}
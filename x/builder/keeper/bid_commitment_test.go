@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestSealedBids() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+
+	bid := sdk.NewInt64Coin("stake", 1024)
+	collateral := sdk.NewInt64Coin("stake", 100)
+	transactions := [][]byte{{0xFF}, {0xFF}}
+	salt := []byte("0123456789abcdef")
+	commitment := types.ComputeBidCommitment(bidder.Address, bid, transactions, salt)
+
+	revealMsg := types.NewMsgRevealBid(bidder.Address, bid, transactions, salt)
+
+	setUp := func(revealWindow uint64) {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.EscrowAccountAddress = escrow.Address
+		params.RevealWindow = revealWindow
+		params.MinCommitmentCollateral = collateral
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		suite.ctx = suite.ctx.WithBlockHeight(10)
+	}
+
+	commit := func() {
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, sdk.AccAddress{}, sdk.NewCoins(collateral)).
+			Return(nil)
+
+		_, err := suite.msgServer.CommitBid(suite.ctx, types.NewMsgCommitBid(bidder.Address, commitment, collateral))
+		suite.Require().NoError(err)
+	}
+
+	suite.Run("sealed bids are disabled", func() {
+		setUp(0)
+
+		_, err := suite.msgServer.CommitBid(suite.ctx, types.NewMsgCommitBid(bidder.Address, commitment, collateral))
+		suite.Require().Error(err)
+
+		_, err = suite.msgServer.RevealBid(suite.ctx, revealMsg)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("open bids are rejected when sealed bids are enabled", func() {
+		setUp(2)
+
+		_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, transactions))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("collateral must be at least the minimum collateral", func() {
+		setUp(2)
+
+		_, err := suite.msgServer.CommitBid(suite.ctx, types.NewMsgCommitBid(bidder.Address, commitment, sdk.NewInt64Coin("stake", 99)))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("commitment is stored with its reveal window", func() {
+		setUp(2)
+		commit()
+
+		stored, err := suite.builderKeeper.GetBidCommitment(suite.ctx, bidder.Address, commitment)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(10), stored.Height)
+		suite.Require().Equal(uint64(12), stored.ExpirationHeight)
+		suite.Require().Equal(collateral, stored.Collateral)
+
+		// The same commitment cannot be made twice.
+		_, err = suite.msgServer.CommitBid(suite.ctx, types.NewMsgCommitBid(bidder.Address, commitment, collateral))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("bid cannot be revealed in the block the commitment was included in", func() {
+		setUp(2)
+		commit()
+
+		_, err := suite.msgServer.RevealBid(suite.ctx, revealMsg)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("bid that does not match the commitment cannot be revealed", func() {
+		setUp(2)
+		commit()
+
+		ctx := suite.ctx.WithBlockHeight(11)
+		_, err := suite.msgServer.RevealBid(ctx, types.NewMsgRevealBid(bidder.Address, bid.AddAmount(bid.Amount), transactions, salt))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("revealed bid returns the collateral and executes the bid", func() {
+		setUp(2)
+		commit()
+
+		ctx := suite.ctx.WithBlockHeight(12)
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, bidder.Address, sdk.NewCoins(collateral)).
+			Return(nil)
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, escrow.Address, sdk.NewCoins(bid)).
+			Return(nil)
+
+		_, err := suite.msgServer.RevealBid(ctx, revealMsg)
+		suite.Require().NoError(err)
+
+		_, err = suite.builderKeeper.GetBidCommitment(ctx, bidder.Address, commitment)
+		suite.Require().Error(err)
+
+		results, err := suite.builderKeeper.GetAuctionResultsAtHeight(ctx, 12)
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 1)
+		suite.Require().Equal(bid, results[0].Bid)
+
+		// Nothing is slashed since the bid was revealed.
+		suite.Require().NoError(suite.builderKeeper.SlashExpiredBidCommitments(ctx))
+	})
+
+	suite.Run("bid cannot be revealed after the reveal window", func() {
+		setUp(2)
+		commit()
+
+		ctx := suite.ctx.WithBlockHeight(13)
+		_, err := suite.msgServer.RevealBid(ctx, revealMsg)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("unrevealed commitments are slashed after the reveal window", func() {
+		setUp(2)
+		commit()
+
+		// The bid can still be revealed in the last block of the reveal window.
+		suite.Require().NoError(suite.builderKeeper.SlashExpiredBidCommitments(suite.ctx.WithBlockHeight(11)))
+
+		ctx := suite.ctx.WithBlockHeight(12)
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, escrow.Address, sdk.NewCoins(collateral)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.SlashExpiredBidCommitments(ctx))
+
+		commitments, err := suite.builderKeeper.GetAllBidCommitments(ctx)
+		suite.Require().NoError(err)
+		suite.Require().Empty(commitments)
+	})
+
+	suite.Run("losing reveals in the block are released after the reveal window", func() {
+		setUp(2)
+		commit()
+
+		suite.builderKeeper = suite.builderKeeper.WithTxDecoder(suite.encCfg.TxConfig.TxDecoder())
+
+		revealTx, err := testutils.CreateTx(suite.encCfg.TxConfig, bidder, 0, 12, []sdk.Msg{revealMsg})
+		suite.Require().NoError(err)
+
+		revealTxBz, err := suite.encCfg.TxConfig.TxEncoder()(revealTx)
+		suite.Require().NoError(err)
+
+		txs := [][]byte{revealTxBz}
+
+		// Reveals in the block of the commitment do not count.
+		suite.Require().NoError(suite.builderKeeper.MarkRevealedBidCommitments(suite.ctx, txs))
+		stored, err := suite.builderKeeper.GetBidCommitment(suite.ctx, bidder.Address, commitment)
+		suite.Require().NoError(err)
+		suite.Require().False(stored.Revealed)
+
+		ctx := suite.ctx.WithBlockHeight(11)
+		suite.Require().NoError(suite.builderKeeper.MarkRevealedBidCommitments(ctx, txs))
+		stored, err = suite.builderKeeper.GetBidCommitment(ctx, bidder.Address, commitment)
+		suite.Require().NoError(err)
+		suite.Require().True(stored.Revealed)
+
+		// The collateral is returned to the bidder rather than slashed.
+		ctx = suite.ctx.WithBlockHeight(12)
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, bidder.Address, sdk.NewCoins(collateral)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.SlashExpiredBidCommitments(ctx))
+
+		commitments, err := suite.builderKeeper.GetAllBidCommitments(ctx)
+		suite.Require().NoError(err)
+		suite.Require().Empty(commitments)
+	})
+
+	suite.Run("commitments revealed without bidding are released after the reveal window", func() {
+		setUp(2)
+		commit()
+
+		revealCommitmentMsg := types.NewMsgRevealBidCommitment(bidder.Address, bid, transactions, salt)
+
+		// The bid cannot be revealed in the block of the commitment.
+		_, err := suite.msgServer.RevealBidCommitment(suite.ctx, revealCommitmentMsg)
+		suite.Require().Error(err)
+
+		ctx := suite.ctx.WithBlockHeight(11)
+		_, err = suite.msgServer.RevealBidCommitment(ctx, revealCommitmentMsg)
+		suite.Require().NoError(err)
+
+		stored, err := suite.builderKeeper.GetBidCommitment(ctx, bidder.Address, commitment)
+		suite.Require().NoError(err)
+		suite.Require().True(stored.Revealed)
+
+		// The commitment can only be revealed once.
+		_, err = suite.msgServer.RevealBidCommitment(ctx, revealCommitmentMsg)
+		suite.Require().Error(err)
+
+		// The collateral is returned to the bidder rather than slashed.
+		ctx = suite.ctx.WithBlockHeight(12)
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, bidder.Address, sdk.NewCoins(collateral)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.SlashExpiredBidCommitments(ctx))
+	})
+
+	suite.Run("bid info must reveal a commitment when sealed bids are enabled", func() {
+		setUp(2)
+		commit()
+
+		suite.bankKeeper.EXPECT().GetBalance(gomock.Any(), bidder.Address, "stake").Return(bid).AnyTimes()
+
+		bidInfo := &types.BidInfo{
+			Bidder:       bidder.Address,
+			Bid:          bid,
+			Transactions: transactions,
+		}
+
+		// An open bid is rejected.
+		err := suite.builderKeeper.ValidateBidInfo(suite.ctx, sdk.Coin{}, bidInfo)
+		suite.Require().Error(err)
+
+		// A revealed bid does not need to outbid the highest bid in the mempool.
+		bidInfo.Commitment = commitment
+		err = suite.builderKeeper.ValidateBidInfo(suite.ctx.WithBlockHeight(11), bid.AddAmount(bid.Amount), bidInfo)
+		suite.Require().NoError(err)
+
+		// CheckTx validates the reveal against the next block height.
+		err = suite.builderKeeper.ValidateBidInfo(suite.ctx.WithIsCheckTx(true), sdk.Coin{}, bidInfo)
+		suite.Require().NoError(err)
+	})
+}
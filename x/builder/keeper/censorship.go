@@ -0,0 +1,407 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	blockbusterabci "github.com/skip-mev/pob/blockbuster/abci"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetCensorshipReport stores the censorship report for the height and bid transaction included
+// in the report.
+func (k Keeper) SetCensorshipReport(ctx sdk.Context, report types.CensorshipReport) error {
+	bidTxHash, err := hex.DecodeString(report.BidTxHash)
+	if err != nil {
+		return err
+	}
+
+	bz, err := report.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetCensorshipReportKey(report.Height, bidTxHash), bz)
+
+	return nil
+}
+
+// HasCensorshipReport returns true if the bid transaction with the given hash has already been
+// reported as censored at the given height.
+func (k Keeper) HasCensorshipReport(ctx sdk.Context, height uint64, bidTxHash []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetCensorshipReportKey(height, bidTxHash))
+}
+
+// HasCensorshipReportsAtHeight returns true if any bid has been reported as censored at the
+// given height.
+func (k Keeper) HasCensorshipReportsAtHeight(ctx sdk.Context, height uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCensorshipReportHeightPrefix(height))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// GetAllCensorshipReports returns all of the censorship reports retained in state.
+func (k Keeper) GetAllCensorshipReports(ctx sdk.Context) ([]types.CensorshipReport, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCensorshipReport)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	reports := make([]types.CensorshipReport, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		report := types.CensorshipReport{}
+		if err := report.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// SetCensorshipScore stores the censorship score of the validator.
+func (k Keeper) SetCensorshipScore(ctx sdk.Context, score types.CensorshipScore) error {
+	validator, err := sdk.ConsAddressFromBech32(score.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	bz, err := score.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetCensorshipScoreKey(validator), bz)
+
+	return nil
+}
+
+// GetCensorshipScore returns the censorship score of the validator with the given consensus
+// address. Validators that have never been reported have a score of zero.
+func (k Keeper) GetCensorshipScore(ctx sdk.Context, validator sdk.ConsAddress) (types.CensorshipScore, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCensorshipScoreKey(validator))
+	if len(bz) == 0 {
+		return types.CensorshipScore{ValidatorAddress: validator.String()}, nil
+	}
+
+	score := types.CensorshipScore{}
+	if err := score.Unmarshal(bz); err != nil {
+		return types.CensorshipScore{}, err
+	}
+
+	return score, nil
+}
+
+// GetAllCensorshipScores returns the censorship scores of all validators that have been reported.
+func (k Keeper) GetAllCensorshipScores(ctx sdk.Context) ([]types.CensorshipScore, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCensorshipScore)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	scores := make([]types.CensorshipScore, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		score := types.CensorshipScore{}
+		if err := score.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		scores = append(scores, score)
+	}
+
+	return scores, nil
+}
+
+// SetAuctionEvidence stores the vote extension auction of the block at the height included in
+// the evidence.
+func (k Keeper) SetAuctionEvidence(ctx sdk.Context, evidence types.AuctionEvidence) error {
+	bz, err := evidence.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetAuctionEvidenceKey(evidence.Height), bz)
+
+	return nil
+}
+
+// GetAuctionEvidence returns the vote extension auction of the block at the given height.
+func (k Keeper) GetAuctionEvidence(ctx sdk.Context, height uint64) (types.AuctionEvidence, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAuctionEvidenceKey(height))
+	if len(bz) == 0 {
+		return types.AuctionEvidence{}, fmt.Errorf("no vote extension auction is retained at height %d", height)
+	}
+
+	evidence := types.AuctionEvidence{}
+	if err := evidence.Unmarshal(bz); err != nil {
+		return types.AuctionEvidence{}, err
+	}
+
+	return evidence, nil
+}
+
+// GetAllAuctionEvidence returns the vote extension auctions of all of the blocks retained in state.
+func (k Keeper) GetAllAuctionEvidence(ctx sdk.Context) ([]types.AuctionEvidence, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuctionEvidence)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	evidence := make([]types.AuctionEvidence, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		e := types.AuctionEvidence{}
+		if err := e.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		evidence = append(evidence, e)
+	}
+
+	return evidence, nil
+}
+
+// processAuctionInfo records the vote extension auction of the block that is being finalized,
+// marks the sealed bid commitments revealed in it and records the bids that failed
+// verification. Blocks without an auction info are ignored.
+func (k Keeper) processAuctionInfo(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) error {
+	if len(req.Txs) == 0 || !types.IsAuctionInfo(req.Txs[0]) {
+		return nil
+	}
+
+	// Auction infos are verified when the block proposal is processed, so an invalid auction
+	// info is ignored rather than halting the chain.
+	info, err := types.DecodeAuctionInfo(req.Txs[0])
+	if err != nil {
+		k.Logger(ctx).Error("failed to decode auction info", "err", err)
+		return nil
+	}
+
+	if err := k.recordAuctionEvidence(ctx, req, info); err != nil {
+		return err
+	}
+
+	if err := k.MarkRevealedBidCommitments(ctx, info.BidTxs); err != nil {
+		return err
+	}
+
+	return k.RecordFailedBids(ctx, info)
+}
+
+// recordAuctionEvidence stores the vote extension auction of the block that is being finalized,
+// i.e. the bids of the auction info, the proposer and the validators whose vote extensions the
+// proposer received in the commit of the previous block.
+func (k Keeper) recordAuctionEvidence(ctx sdk.Context, req *cometabci.RequestFinalizeBlock, info types.AuctionInfo) error {
+	evidence := types.AuctionEvidence{
+		Height:      uint64(req.Height),
+		Proposer:    sdk.ConsAddress(req.ProposerAddress).String(),
+		Round:       req.DecidedLastCommit.Round,
+		BidTxHashes: make([][]byte, len(info.BidTxs)),
+	}
+
+	for i, bidTx := range info.BidTxs {
+		hash := sha256.Sum256(bidTx)
+		evidence.BidTxHashes[i] = hash[:]
+	}
+
+	// Vote extensions are only included in the commit for validators that voted for the block.
+	for _, vote := range req.DecidedLastCommit.Votes {
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			evidence.Validators = append(evidence.Validators, sdk.ConsAddress(vote.Validator.Address).String())
+		}
+	}
+
+	return k.SetAuctionEvidence(ctx, evidence)
+}
+
+// ReportCensoredBid verifies that the reporter's bid transaction was included in the vote
+// extension of a validator in the commit of the block before the given height, but omitted from
+// the auction info of the block at the given height. The proposer of a block runs the auction
+// over the bids of all of the vote extensions it received, so such a bid was censored by the
+// proposer. If so, the report is stored and the censorship score of the proposer is incremented
+// once per height, so the score counts the blocks in which the proposer censored a bid.
+func (k Keeper) ReportCensoredBid(
+	ctx sdk.Context,
+	reporter sdk.AccAddress,
+	msg *types.MsgReportCensoredBid,
+) (types.CensorshipReport, types.CensorshipScore, error) {
+	if k.txDecoder == nil || k.stakingKeeper == nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, fmt.Errorf("censorship reports are not supported; no tx decoder or staking keeper is set")
+	}
+
+	height := msg.Height
+	if height >= uint64(ctx.BlockHeight()) {
+		return types.CensorshipReport{}, types.CensorshipScore{}, fmt.Errorf(
+			"censorship can only be reported for previous blocks (height: %d, current block height: %d)",
+			height,
+			ctx.BlockHeight(),
+		)
+	}
+
+	hash := sha256.Sum256(msg.BidTx)
+	if k.HasCensorshipReport(ctx, height, hash[:]) {
+		return types.CensorshipReport{}, types.CensorshipScore{}, fmt.Errorf("bid has already been reported as censored at height %d", height)
+	}
+
+	evidence, err := k.GetAuctionEvidence(ctx, height)
+	if err != nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, err
+	}
+
+	proposer, err := sdk.ConsAddressFromBech32(evidence.Proposer)
+	if err != nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, fmt.Errorf("proposer of height %d is unknown: %w", height, err)
+	}
+
+	if err := k.verifyVoteExtension(ctx, evidence, msg); err != nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, err
+	}
+
+	for _, bidTxHash := range evidence.BidTxHashes {
+		if bytes.Equal(bidTxHash, hash[:]) {
+			return types.CensorshipReport{}, types.CensorshipScore{}, fmt.Errorf("bid was included in the auction at height %d", height)
+		}
+	}
+
+	bid, err := k.validateCensoredBid(reporter, msg.BidTx)
+	if err != nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, err
+	}
+
+	// The score counts blocks rather than bids, so it is only incremented for the first report
+	// at the height.
+	firstReport := !k.HasCensorshipReportsAtHeight(ctx, height)
+
+	report := types.CensorshipReport{
+		Height:           height,
+		Proposer:         proposer.String(),
+		Bidder:           reporter.String(),
+		Bid:              bid,
+		BidTxHash:        hex.EncodeToString(hash[:]),
+		ValidatorAddress: msg.ValidatorAddress,
+	}
+	if err := k.SetCensorshipReport(ctx, report); err != nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, err
+	}
+
+	score, err := k.GetCensorshipScore(ctx, proposer)
+	if err != nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, err
+	}
+
+	if !firstReport {
+		return report, score, nil
+	}
+
+	score.Score++
+	if height > score.LastReportHeight {
+		score.LastReportHeight = height
+	}
+
+	if err := k.SetCensorshipScore(ctx, score); err != nil {
+		return types.CensorshipReport{}, types.CensorshipScore{}, err
+	}
+
+	return report, score, nil
+}
+
+// verifyVoteExtension verifies that the vote extension of the report was signed by a validator
+// whose vote was included in the commit of the block before the reported height, and that it
+// includes the reported bid transaction.
+func (k Keeper) verifyVoteExtension(ctx sdk.Context, evidence types.AuctionEvidence, msg *types.MsgReportCensoredBid) error {
+	committed := false
+	for _, validator := range evidence.Validators {
+		committed = committed || validator == msg.ValidatorAddress
+	}
+
+	if !committed {
+		return fmt.Errorf("vote of validator %s was not included in the commit of height %d", msg.ValidatorAddress, evidence.Height-1)
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return fmt.Errorf("failed to get validator %s: %w", consAddr, err)
+	}
+
+	pubKeyProto, err := validator.CmtConsPublicKey()
+	if err != nil {
+		return fmt.Errorf("failed to get public key of validator %s: %w", consAddr, err)
+	}
+
+	pubKey, err := cryptoenc.PubKeyFromProto(pubKeyProto)
+	if err != nil {
+		return fmt.Errorf("failed to convert public key of validator %s: %w", consAddr, err)
+	}
+
+	// Vote extensions are signed together with the height and round of the vote and the chain
+	// id, so a vote extension cannot be replayed for a different block.
+	var signBytes bytes.Buffer
+	if err := protoio.NewDelimitedWriter(&signBytes).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: msg.VoteExtension,
+		Height:    int64(evidence.Height) - 1,
+		Round:     int64(evidence.Round),
+		ChainId:   ctx.ChainID(),
+	}); err != nil {
+		return fmt.Errorf("failed to encode vote extension: %w", err)
+	}
+
+	if !pubKey.VerifySignature(signBytes.Bytes(), msg.ExtensionSignature) {
+		return fmt.Errorf("invalid vote extension signature of validator %s", consAddr)
+	}
+
+	info := blockbusterabci.VoteExtensionInfo{}
+	if err := info.Unmarshal(msg.VoteExtension); err != nil {
+		return fmt.Errorf("failed to decode vote extension: %w", err)
+	}
+
+	hash := sha256.Sum256(msg.BidTx)
+	if !bytes.Equal(info.Registry[hex.EncodeToString(hash[:])], msg.BidTx) {
+		return fmt.Errorf("bid transaction is not included in the vote extension of validator %s", consAddr)
+	}
+
+	return nil
+}
+
+// validateCensoredBid validates that the bid transaction can be decoded and is a bid of the
+// reporter. The proposer includes every bid of the vote extensions that can be decoded in the
+// auction info, so any such bid that was omitted was censored. It returns the bid.
+func (k Keeper) validateCensoredBid(reporter sdk.AccAddress, bidTxBz []byte) (sdk.Coin, error) {
+	bidTx, err := k.txDecoder(bidTxBz)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to decode bid transaction: %w", err)
+	}
+
+	var bidMsgs []*types.MsgAuctionBid
+	for _, msg := range bidTx.GetMsgs() {
+		if bidMsg, ok := msg.(*types.MsgAuctionBid); ok {
+			bidMsgs = append(bidMsgs, bidMsg)
+		}
+	}
+
+	if len(bidMsgs) != 1 {
+		return sdk.Coin{}, fmt.Errorf("bid transaction must include exactly one MsgAuctionBid; got %d", len(bidMsgs))
+	}
+
+	bidMsg := bidMsgs[0]
+	if bidMsg.Bidder != reporter.String() {
+		return sdk.Coin{}, fmt.Errorf("reporter (%s) is not the bidder (%s)", reporter, bidMsg.Bidder)
+	}
+
+	return bidMsg.Bid, nil
+}
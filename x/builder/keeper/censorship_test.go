@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"time"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/golang/mock/gomock"
+	blockbusterabci "github.com/skip-mev/pob/blockbuster/abci"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestReportCensoredBid() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 3)
+
+	winner := accounts[0]
+	reporter := accounts[1]
+	otherReporter := accounts[2]
+	proposer := sdk.ConsAddress([]byte("proposer"))
+
+	valKey := ed25519.GenPrivKey()
+	valConsAddr := sdk.ConsAddress(valKey.PubKey().Address())
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(valConsAddr), valKey.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+
+	const (
+		height  uint64 = 10
+		round   int32  = 1
+		chainID        = "test-chain"
+	)
+
+	bidTx := func(bidder testutils.Account, bid sdk.Coin) []byte {
+		tx, _, err := testutils.CreateAuctionTx(suite.encCfg.TxConfig, bidder, bid, 0, height, nil)
+		suite.Require().NoError(err)
+
+		bz, err := suite.encCfg.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		return bz
+	}
+
+	winnerBidTx := bidTx(winner, sdk.NewInt64Coin("stake", 100))
+	reporterBidTx := bidTx(reporter, sdk.NewInt64Coin("stake", 200))
+	otherBidTx := bidTx(otherReporter, sdk.NewInt64Coin("stake", 300))
+
+	voteExtension := func(bidTxs ...[]byte) []byte {
+		info := blockbusterabci.VoteExtensionInfo{Registry: make(map[string][]byte)}
+		for _, bidTx := range bidTxs {
+			hash := sha256.Sum256(bidTx)
+			info.Registry[hex.EncodeToString(hash[:])] = bidTx
+		}
+
+		bz, err := info.Marshal()
+		suite.Require().NoError(err)
+
+		return bz
+	}
+
+	sign := func(key *ed25519.PrivKey, extension []byte, round int32) []byte {
+		var signBytes bytes.Buffer
+		err := protoio.NewDelimitedWriter(&signBytes).WriteMsg(&cmtproto.CanonicalVoteExtension{
+			Extension: extension,
+			Height:    int64(height) - 1,
+			Round:     int64(round),
+			ChainId:   chainID,
+		})
+		suite.Require().NoError(err)
+
+		signature, err := key.Sign(signBytes.Bytes())
+		suite.Require().NoError(err)
+
+		return signature
+	}
+
+	report := func(bidder testutils.Account, bidTx []byte, extension []byte) *types.MsgReportCensoredBid {
+		return types.NewMsgReportCensoredBid(bidder.Address, height, bidTx, valConsAddr, extension, sign(valKey, extension, round))
+	}
+
+	setUp := func() {
+		suite.SetupTest()
+
+		suite.builderKeeper = suite.builderKeeper.WithTxDecoder(suite.encCfg.TxConfig.TxDecoder())
+		suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper)
+		suite.stakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valConsAddr).Return(validator, nil).AnyTimes()
+
+		// The auction info of the block only includes the winning bid.
+		infoBz, err := types.EncodeAuctionInfo(types.AuctionInfo{BidTxs: [][]byte{winnerBidTx}, MaxTxBytes: 1000, NumTxs: 1})
+		suite.Require().NoError(err)
+
+		err = suite.builderKeeper.PreFinalizeBlockHook()(suite.ctx.WithBlockHeight(int64(height)), &cometabci.RequestFinalizeBlock{
+			Height:          int64(height),
+			ProposerAddress: proposer,
+			Txs:             [][]byte{infoBz, winnerBidTx},
+			DecidedLastCommit: cometabci.CommitInfo{
+				Round: round,
+				Votes: []cometabci.VoteInfo{
+					{Validator: cometabci.Validator{Address: valConsAddr, Power: 1}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+					{Validator: cometabci.Validator{Address: []byte("absent"), Power: 1}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+				},
+			},
+		})
+		suite.Require().NoError(err)
+
+		suite.ctx = suite.ctx.WithBlockHeight(int64(height) + 1).WithChainID(chainID)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgReportCensoredBid
+		pass     bool
+	}{
+		{
+			"bid in the vote extension of a committed validator that was omitted from the auction",
+			func() *types.MsgReportCensoredBid {
+				return report(reporter, reporterBidTx, voteExtension(winnerBidTx, reporterBidTx))
+			},
+			true,
+		},
+		{
+			"bid that was included in the auction",
+			func() *types.MsgReportCensoredBid {
+				return report(winner, winnerBidTx, voteExtension(winnerBidTx, reporterBidTx))
+			},
+			false,
+		},
+		{
+			"bid that is not included in the vote extension",
+			func() *types.MsgReportCensoredBid {
+				return report(reporter, reporterBidTx, voteExtension(winnerBidTx))
+			},
+			false,
+		},
+		{
+			"vote extension signed by a different key",
+			func() *types.MsgReportCensoredBid {
+				msg := report(reporter, reporterBidTx, voteExtension(reporterBidTx))
+				msg.ExtensionSignature = sign(ed25519.GenPrivKey(), msg.VoteExtension, round)
+				return msg
+			},
+			false,
+		},
+		{
+			"vote extension signed for a different round",
+			func() *types.MsgReportCensoredBid {
+				msg := report(reporter, reporterBidTx, voteExtension(reporterBidTx))
+				msg.ExtensionSignature = sign(valKey, msg.VoteExtension, round+1)
+				return msg
+			},
+			false,
+		},
+		{
+			"validator whose vote was not included in the commit",
+			func() *types.MsgReportCensoredBid {
+				msg := report(reporter, reporterBidTx, voteExtension(reporterBidTx))
+				msg.ValidatorAddress = sdk.ConsAddress([]byte("absent")).String()
+				return msg
+			},
+			false,
+		},
+		{
+			"reporter is not the bidder",
+			func() *types.MsgReportCensoredBid {
+				return report(reporter, otherBidTx, voteExtension(otherBidTx))
+			},
+			false,
+		},
+		{
+			"no vote extension auction at the height",
+			func() *types.MsgReportCensoredBid {
+				msg := report(reporter, reporterBidTx, voteExtension(reporterBidTx))
+				msg.Height = height - 1
+				return msg
+			},
+			false,
+		},
+		{
+			"height of the current block",
+			func() *types.MsgReportCensoredBid {
+				msg := report(reporter, reporterBidTx, voteExtension(reporterBidTx))
+				msg.Height = height + 1
+				return msg
+			},
+			false,
+		},
+		{
+			"tx decoder is not set",
+			func() *types.MsgReportCensoredBid {
+				suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper.WithTxDecoder(nil))
+				return report(reporter, reporterBidTx, voteExtension(reporterBidTx))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			setUp()
+
+			msg := tc.malleate()
+			_, err := suite.msgServer.ReportCensoredBid(suite.ctx, msg)
+
+			score, scoreErr := suite.builderKeeper.GetCensorshipScore(suite.ctx, proposer)
+			suite.Require().NoError(scoreErr)
+
+			if !tc.pass {
+				suite.Require().Error(err)
+				suite.Require().Zero(score.Score)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), score.Score)
+			suite.Require().Equal(height, score.LastReportHeight)
+
+			// A bid can only be reported once.
+			_, err = suite.msgServer.ReportCensoredBid(suite.ctx, msg)
+			suite.Require().Error(err)
+
+			// Other bids censored in the same block can be reported, but the score counts blocks.
+			_, err = suite.msgServer.ReportCensoredBid(suite.ctx, report(otherReporter, otherBidTx, voteExtension(otherBidTx)))
+			suite.Require().NoError(err)
+
+			score, err = suite.builderKeeper.GetCensorshipScore(suite.ctx, proposer)
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), score.Score)
+
+			reports, err := suite.builderKeeper.GetAllCensorshipReports(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Len(reports, 2)
+			for _, report := range reports {
+				suite.Require().Equal(proposer.String(), report.Proposer)
+				suite.Require().Equal(valConsAddr.String(), report.ValidatorAddress)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAuctionResultProposer() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	bidder := testutils.RandomAccounts(rng, 1)[0]
+	proposer := sdk.ConsAddress([]byte("proposer"))
+
+	suite.SetupTest()
+
+	header := suite.ctx.BlockHeader()
+	header.ProposerAddress = proposer
+	suite.ctx = suite.ctx.WithBlockHeader(header).WithBlockHeight(10)
+
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), bidder.Address, types.DefaultEscrowAccountAddress, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).
+		Return(nil)
+
+	_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, sdk.NewInt64Coin("stake", 100), nil))
+	suite.Require().NoError(err)
+
+	result, err := suite.builderKeeper.GetAuctionResult(suite.ctx, 10, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposer.String(), result.Proposer)
+}
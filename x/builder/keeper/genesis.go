@@ -8,9 +8,76 @@ import (
 // InitGenesis initializes the builder module's state from a given genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	// Set the builder module's parameters.
+	if err := k.ValidateParams(gs.Params); err != nil {
+		panic(err)
+	}
+
 	if err := k.SetParams(ctx, gs.Params); err != nil {
 		panic(err)
 	}
+
+	// Set the historical auction results.
+	for _, result := range gs.AuctionResults {
+		if err := k.SetAuctionResult(ctx, result); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the sealed bid commitments that have not been revealed.
+	for _, commitment := range gs.BidCommitments {
+		if err := k.SetBidCommitment(ctx, commitment); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the registered searchers.
+	for _, searcher := range gs.Searchers {
+		if err := k.SetSearcher(ctx, searcher); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the auction proceeds that have not been paid out.
+	for _, reward := range gs.PendingRewards {
+		if err := k.SetPendingReward(ctx, reward); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the proposer rewards that have not been withdrawn by validators.
+	for _, rewards := range gs.ValidatorRewards {
+		if err := k.SetValidatorRewards(ctx, rewards); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the addresses that validators withdraw their proposer rewards to.
+	for _, withdrawAddress := range gs.WithdrawAddresses {
+		if err := k.SetRewardsWithdrawAddress(ctx, withdrawAddress); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the verified censorship reports.
+	for _, report := range gs.CensorshipReports {
+		if err := k.SetCensorshipReport(ctx, report); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the censorship scores of validators.
+	for _, score := range gs.CensorshipScores {
+		if err := k.SetCensorshipScore(ctx, score); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the vote extension auctions of previous blocks.
+	for _, evidence := range gs.AuctionEvidence {
+		if err := k.SetAuctionEvidence(ctx, evidence); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -21,5 +88,70 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params)
+	// Get the historical auction results.
+	results, err := k.GetAllAuctionResults(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the sealed bid commitments that have not been revealed.
+	commitments, err := k.GetAllBidCommitments(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the registered searchers.
+	searchers, err := k.GetAllSearchers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the auction proceeds that have not been paid out.
+	pendingRewards, err := k.GetAllPendingRewards(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the proposer rewards that have not been withdrawn by validators.
+	validatorRewards, err := k.GetAllValidatorRewards(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the addresses that validators withdraw their proposer rewards to.
+	withdrawAddresses, err := k.GetAllRewardsWithdrawAddresses(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the verified censorship reports.
+	censorshipReports, err := k.GetAllCensorshipReports(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the censorship scores of validators.
+	censorshipScores, err := k.GetAllCensorshipScores(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the vote extension auctions of previous blocks.
+	auctionEvidence, err := k.GetAllAuctionEvidence(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(
+		params,
+		results,
+		commitments,
+		searchers,
+		pendingRewards,
+		validatorRewards,
+		withdrawAddresses,
+		censorshipReports,
+		censorshipScores,
+		auctionEvidence,
+	)
 }
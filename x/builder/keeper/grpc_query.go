@@ -3,8 +3,12 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/skip-mev/pob/x/builder/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = QueryServer{}
@@ -30,3 +34,139 @@ func (q QueryServer) Params(c context.Context, _ *types.QueryParamsRequest) (*ty
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// AuctionResult queries the auction results at a given block height.
+func (q QueryServer) AuctionResult(c context.Context, req *types.QueryAuctionResultRequest) (*types.QueryAuctionResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	results, err := q.keeper.GetAuctionResultsAtHeight(ctx, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(results) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no auction result found for height %d", req.Height)
+	}
+
+	return &types.QueryAuctionResultResponse{AuctionResults: results}, nil
+}
+
+// AuctionResults queries all of the auction results retained in state.
+func (q QueryServer) AuctionResults(c context.Context, req *types.QueryAuctionResultsRequest) (*types.QueryAuctionResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.KeyPrefixAuctionResult)
+
+	results := make([]types.AuctionResult, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		result := types.AuctionResult{}
+		if err := result.Unmarshal(value); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuctionResultsResponse{AuctionResults: results, Pagination: pageRes}, nil
+}
+
+// Searcher queries a registered searcher.
+func (q QueryServer) Searcher(c context.Context, req *types.QuerySearcherRequest) (*types.QuerySearcherResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	searcher, err := q.keeper.GetSearcher(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySearcherResponse{Searcher: searcher}, nil
+}
+
+// PendingRewards queries the auction proceeds that have been accrued for a recipient but not
+// paid out yet.
+func (q QueryServer) PendingRewards(c context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rewards, err := q.keeper.GetPendingReward(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingRewardsResponse{Rewards: rewards}, nil
+}
+
+// ValidatorRewards queries the proposer rewards accrued by a validator that have not been
+// withdrawn yet.
+func (q QueryServer) ValidatorRewards(c context.Context, req *types.QueryValidatorRewardsRequest) (*types.QueryValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	validator, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rewards, err := q.keeper.GetValidatorRewards(ctx, validator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorRewardsResponse{
+		Rewards:         rewards,
+		WithdrawAddress: q.keeper.GetRewardsWithdrawAddress(ctx, validator).String(),
+	}, nil
+}
+
+// CensorshipScore queries the number of blocks in which a validator was reported to have
+// censored a higher bid.
+func (q QueryServer) CensorshipScore(c context.Context, req *types.QueryCensorshipScoreRequest) (*types.QueryCensorshipScoreResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	score, err := q.keeper.GetCensorshipScore(ctx, validator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCensorshipScoreResponse{CensorshipScore: score}, nil
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// RegisterInvariants registers all of the invariants of the builder module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+}
+
+// ModuleAccountBalanceInvariant checks that the builder module account holds all of the funds
+// that the module accounts for: the collateral of sealed bid commitments, the bonded and
+// unbonding collateral of registered searchers, the pending rewards and the rewards accrued by
+// validators.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		commitments, searchers, pendingRewards, validatorRewards, err := k.GetModuleAccountHoldings(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(
+				types.ModuleName, "module-account-balance",
+				fmt.Sprintf("\tfailed to get the holdings of the module account: %s\n", err),
+			), true
+		}
+
+		expected := commitments.Add(searchers...).Add(pendingRewards...).Add(validatorRewards...)
+
+		balance := sdk.NewCoins()
+		for _, coin := range expected {
+			balance = balance.Add(k.bankKeeper.GetBalance(ctx, k.moduleAddress, coin.Denom))
+		}
+
+		broken := !expected.IsAllLTE(balance)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account-balance",
+			fmt.Sprintf(
+				"\tmodule account balance: %s\n"+
+					"\tbid commitment collateral: %s\n"+
+					"\tsearcher collateral: %s\n"+
+					"\tpending rewards: %s\n"+
+					"\tvalidator rewards: %s\n",
+				balance, commitments, searchers, pendingRewards, validatorRewards,
+			),
+		), broken
+	}
+}
+
+// GetModuleAccountHoldings returns the funds held by the builder module account on behalf of
+// others, by purpose: the collateral of sealed bid commitments, the bonded and unbonding
+// collateral of registered searchers, the auction proceeds that are pending payout and the
+// proposer rewards accrued by validators.
+func (k Keeper) GetModuleAccountHoldings(ctx sdk.Context) (
+	commitments, searchers, pendingRewards, validatorRewards sdk.Coins,
+	err error,
+) {
+	allCommitments, err := k.GetAllBidCommitments(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	commitments = sdk.NewCoins()
+	for _, commitment := range allCommitments {
+		commitments = commitments.Add(commitment.Collateral)
+	}
+
+	allSearchers, err := k.GetAllSearchers(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	searchers = sdk.NewCoins()
+	for _, searcher := range allSearchers {
+		searchers = searchers.Add(searcher.Collateral).Add(searcher.Unbonding...)
+	}
+
+	allPendingRewards, err := k.GetAllPendingRewards(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	pendingRewards = sdk.NewCoins()
+	for _, reward := range allPendingRewards {
+		pendingRewards = pendingRewards.Add(reward.Amount...)
+	}
+
+	allValidatorRewards, err := k.GetAllValidatorRewards(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	validatorRewards = sdk.NewCoins()
+	for _, rewards := range allValidatorRewards {
+		validatorRewards = validatorRewards.Add(rewards.Rewards...)
+	}
+
+	return commitments, searchers, pendingRewards, validatorRewards, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestModuleAccountBalanceInvariant() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	searcher := accounts[0]
+	recipient := accounts[1]
+
+	setUp := func() {
+		suite.SetupTest()
+
+		registered := types.NewSearcher(searcher.Address, sdk.NewInt64Coin("stake", 100))
+		registered.Unbonding = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+		suite.Require().NoError(suite.builderKeeper.SetSearcher(suite.ctx, registered))
+
+		reward := types.NewPendingReward(recipient.Address, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))
+		suite.Require().NoError(suite.builderKeeper.SetPendingReward(suite.ctx, reward))
+	}
+
+	suite.Run("holdings are accounted for by purpose", func() {
+		setUp()
+
+		commitments, searchers, pendingRewards, validatorRewards, err := suite.builderKeeper.GetModuleAccountHoldings(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().True(commitments.IsZero())
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 120)), searchers)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), pendingRewards)
+		suite.Require().True(validatorRewards.IsZero())
+	})
+
+	suite.Run("invariant holds if the module account holds all of its holdings", func() {
+		setUp()
+
+		suite.bankKeeper.EXPECT().
+			GetBalance(gomock.Any(), sdk.AccAddress{}, "stake").
+			Return(sdk.NewInt64Coin("stake", 150))
+
+		_, broken := keeper.ModuleAccountBalanceInvariant(suite.builderKeeper)(suite.ctx)
+		suite.Require().False(broken)
+	})
+
+	suite.Run("invariant is broken if the module account balance is insufficient", func() {
+		setUp()
+
+		suite.bankKeeper.EXPECT().
+			GetBalance(gomock.Any(), sdk.AccAddress{}, "stake").
+			Return(sdk.NewInt64Coin("stake", 149))
+
+		_, broken := keeper.ModuleAccountBalanceInvariant(suite.builderKeeper)(suite.ctx)
+		suite.Require().True(broken)
+	})
+}
@@ -9,6 +9,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/skip-mev/pob/x/builder/prices"
 	"github.com/skip-mev/pob/x/builder/rewards"
 	"github.com/skip-mev/pob/x/builder/types"
 )
@@ -20,6 +22,47 @@ type Keeper struct {
 	bankKeeper             types.BankKeeper
 	rewardsAddressProvider types.RewardsAddressProvider
 
+	// moduleAddress is the address of the builder module account, which holds the
+	// collateral of sealed bid commitments until they are revealed.
+	moduleAddress sdk.AccAddress
+
+	// canBurn is true if the builder module account has the Burner permission, which is
+	// required to burn the auction proceeds.
+	canBurn bool
+
+	// priceConverter is used to rank bids that are denominated in different denoms.
+	priceConverter types.PriceConverter
+
+	// hasPriceConverter is true if the price converter was set explicitly. The default
+	// price converter values one unit of every denom equally, so bids can only be placed
+	// in multiple denoms if it is true.
+	hasPriceConverter bool
+
+	// distrKeeper is used to fund the community pool with the auction proceeds. It is
+	// only required if the community pool is a recipient of the revenue split.
+	distrKeeper types.DistributionKeeper
+
+	// txDecoder is used to decode the bid transactions of censorship reports and of auction
+	// infos. Censorship reports are rejected and the losing reveals of sealed bids are slashed
+	// if it is not set.
+	txDecoder sdk.TxDecoder
+
+	// stakingKeeper is used to verify the vote extension signatures of censorship reports.
+	// Censorship reports are rejected if it is not set.
+	stakingKeeper types.StakingKeeper
+
+	// bundleDecrypter is used to decrypt the encrypted bundled transactions of winning bids.
+	// Bids with encrypted bundles are rejected if it is not set.
+	bundleDecrypter types.BundleDecrypter
+
+	// bundleExecutor is used to execute the bundled transactions of bundles that are submitted
+	// without a bid. Bundles are rejected if it is not set.
+	bundleExecutor types.BundleExecutor
+
+	// feeCollectorAddress is the address of the fee collector module account, whose
+	// balance is distributed to stakers.
+	feeCollectorAddress sdk.AccAddress
+
 	// The address that is capable of executing a MsgUpdateParams message.
 	// Typically this will be the governance module's address.
 	authority string
@@ -35,7 +78,7 @@ func NewKeeper(
 	stakingKeeper types.StakingKeeper,
 	authority string,
 ) Keeper {
-	// Build a rewards address provider
+	// Build a rewards address provider that rewards the proposer of the previous block.
 	rewardsAddressProvider := rewards.NewProposerRewardsAddressProvider(
 		distrKeeper,
 		stakingKeeper,
@@ -48,7 +91,7 @@ func NewKeeper(
 		bankKeeper,
 		rewardsAddressProvider,
 		authority,
-	)
+	).WithDistributionKeeper(distrKeeper).WithStakingKeeper(stakingKeeper)
 }
 
 func NewKeeperWithRewardsAddressProvider(
@@ -65,19 +108,88 @@ func NewKeeperWithRewardsAddressProvider(
 	}
 
 	// Ensure that the builder module account exists.
-	if accountKeeper.GetModuleAddress(types.ModuleName) == nil {
+	moduleAddress, permissions := accountKeeper.GetModuleAddressAndPermissions(types.ModuleName)
+	if moduleAddress == nil {
 		panic("builder module account has not been set")
 	}
 
+	canBurn := false
+	for _, permission := range permissions {
+		if permission == authtypes.Burner {
+			canBurn = true
+		}
+	}
+
 	return Keeper{
 		cdc:                    cdc,
 		storeKey:               storeKey,
 		bankKeeper:             bankKeeper,
 		rewardsAddressProvider: rewardsAddressProvider,
+		moduleAddress:          moduleAddress,
+		canBurn:                canBurn,
+		priceConverter:         prices.NewDefaultPriceConverter(),
+		feeCollectorAddress:    authtypes.NewModuleAddress(authtypes.FeeCollectorName),
 		authority:              authority,
 	}
 }
 
+// WithPriceConverter returns a copy of the keeper that uses the given price converter
+// to rank bids that are denominated in different denoms.
+func (k Keeper) WithPriceConverter(priceConverter types.PriceConverter) Keeper {
+	k.priceConverter = priceConverter
+	k.hasPriceConverter = true
+	return k
+}
+
+// GetPriceConverter returns the price converter of the keeper. It should be passed to the
+// top-of-block lane so that bids are ranked the same way by the lane and the keeper.
+func (k Keeper) GetPriceConverter() types.PriceConverter {
+	return k.priceConverter
+}
+
+// WithRewardsAddressProvider returns a copy of the keeper that uses the given rewards address
+// provider to determine where the proposer's portion of the auction proceeds is sent.
+func (k Keeper) WithRewardsAddressProvider(rewardsAddressProvider types.RewardsAddressProvider) Keeper {
+	k.rewardsAddressProvider = rewardsAddressProvider
+	return k
+}
+
+// WithDistributionKeeper returns a copy of the keeper that uses the given distribution keeper
+// to fund the community pool with the auction proceeds.
+func (k Keeper) WithDistributionKeeper(distrKeeper types.DistributionKeeper) Keeper {
+	k.distrKeeper = distrKeeper
+	return k
+}
+
+// WithTxDecoder returns a copy of the keeper that uses the given tx decoder to decode the bid
+// transactions of censorship reports and of auction infos.
+func (k Keeper) WithTxDecoder(txDecoder sdk.TxDecoder) Keeper {
+	k.txDecoder = txDecoder
+	return k
+}
+
+// WithStakingKeeper returns a copy of the keeper that uses the given staking keeper to verify
+// the vote extension signatures of censorship reports.
+func (k Keeper) WithStakingKeeper(stakingKeeper types.StakingKeeper) Keeper {
+	k.stakingKeeper = stakingKeeper
+	return k
+}
+
+// WithBundleDecrypter returns a copy of the keeper that uses the given decrypter to decrypt the
+// encrypted bundled transactions of winning bids, so that the decrypted transactions are covered
+// by revert protection and searcher tracking.
+func (k Keeper) WithBundleDecrypter(bundleDecrypter types.BundleDecrypter) Keeper {
+	k.bundleDecrypter = bundleDecrypter
+	return k
+}
+
+// WithBundleExecutor returns a copy of the keeper that uses the given executor to execute the
+// bundled transactions of bundles that are submitted without a bid.
+func (k Keeper) WithBundleExecutor(bundleExecutor types.BundleExecutor) Keeper {
+	k.bundleExecutor = bundleExecutor
+	return k
+}
+
 // Logger returns a builder module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -88,6 +200,42 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// ValidateParams validates the parameters along with the dependencies of the keeper that they
+// require. The community pool can only receive a share of the auction proceeds if the keeper
+// has a distribution keeper, the auction proceeds can only be burned if the builder module
+// account has the Burner permission, bids can only be placed in multiple denoms if the keeper
+// has an explicitly set price converter, and proposer rewards can only be claimable if the
+// rewards address provider rewards a validator.
+func (k Keeper) ValidateParams(params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if len(params.BidDenoms) > 0 && !k.hasPriceConverter {
+		return fmt.Errorf("bids cannot be placed in multiple denoms without a price converter")
+	}
+
+	if _, ok := k.rewardsAddressProvider.(types.ValidatorRewardsAddressProvider); params.ClaimableProposerRewards && !ok {
+		return fmt.Errorf("proposer rewards cannot be claimable; the rewards address provider does not reward a validator")
+	}
+
+	for _, share := range params.RevenueSplit {
+		switch share.Recipient {
+		case types.RecipientCommunityPool:
+			if k.distrKeeper == nil {
+				return fmt.Errorf("the community pool cannot be a recipient of the revenue split without a distribution keeper")
+			}
+
+		case types.RecipientBurn:
+			if !k.canBurn {
+				return fmt.Errorf("the auction proceeds cannot be burned; the %s module account does not have the %s permission", types.ModuleName, authtypes.Burner)
+			}
+		}
+	}
+
+	return nil
+}
+
 // GetParams returns the builder module's parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -131,6 +279,16 @@ func (k Keeper) GetMaxBundleSize(ctx sdk.Context) (uint32, error) {
 	return params.MaxBundleSize, nil
 }
 
+// GetMaxBundlesPerBlock returns the maximum number of winning bundles that can be included in a block.
+func (k Keeper) GetMaxBundlesPerBlock(ctx sdk.Context) (uint32, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.MaxBundlesPerBlock, nil
+}
+
 // GetEscrowAccount returns the builder module's escrow account.
 func (k Keeper) GetEscrowAccount(ctx sdk.Context) (sdk.AccAddress, error) {
 	params, err := k.GetParams(ctx)
@@ -151,6 +309,21 @@ func (k Keeper) GetReserveFee(ctx sdk.Context) (sdk.Coin, error) {
 	return params.ReserveFee, nil
 }
 
+// GetAuctionFees returns the reserve fee and minimum bid increment for the given bid denom.
+func (k Keeper) GetAuctionFees(ctx sdk.Context, denom string) (sdk.Coin, sdk.Coin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	reserveFee, minBidIncrement, found := params.GetAuctionFees(denom)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("denom %s is not an allowed bid denom; allowed denoms are %v", denom, params.GetAllowedBidDenoms())
+	}
+
+	return reserveFee, minBidIncrement, nil
+}
+
 // GetMinBidIncrement returns the minimum bid increment for the builder.
 func (k Keeper) GetMinBidIncrement(ctx sdk.Context) (sdk.Coin, error) {
 	params, err := k.GetParams(ctx)
@@ -180,3 +353,23 @@ func (k Keeper) FrontRunningProtectionEnabled(ctx sdk.Context) (bool, error) {
 
 	return params.FrontRunningProtection, nil
 }
+
+// GetPricingRule returns the pricing rule that determines the amount the winners of the auction pay.
+func (k Keeper) GetPricingRule(ctx sdk.Context) (types.PricingRule, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.PricingRule_PRICING_RULE_FIRST_PRICE, err
+	}
+
+	return params.PricingRule, nil
+}
+
+// SealedBidsEnabled returns true if bids must be committed to before they are revealed.
+func (k Keeper) SealedBidsEnabled(ctx sdk.Context) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.SealedBidsEnabled(), nil
+}
@@ -3,12 +3,16 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	testutils "github.com/skip-mev/pob/testutils"
 	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/prices"
+	"github.com/skip-mev/pob/x/builder/rewards"
 	"github.com/skip-mev/pob/x/builder/types"
 
 	"github.com/stretchr/testify/suite"
@@ -42,7 +46,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	ctrl := gomock.NewController(suite.T())
 
 	suite.accountKeeper = testutils.NewMockAccountKeeper(ctrl)
-	suite.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{}).AnyTimes()
+	suite.accountKeeper.EXPECT().GetModuleAddressAndPermissions(types.ModuleName).Return(sdk.AccAddress{}, []string{authtypes.Burner}).AnyTimes()
 
 	suite.bankKeeper = testutils.NewMockBankKeeper(ctrl)
 	suite.distrKeeper = testutils.NewMockDistributionKeeper(ctrl)
@@ -63,3 +67,64 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper)
 }
+
+func (suite *KeeperTestSuite) TestValidateParams() {
+	paramsWithRecipient := func(recipient string) types.Params {
+		params := types.DefaultParams()
+		params.RevenueSplit = []types.RevenueShare{
+			types.NewRevenueShare(recipient, math.LegacyOneDec()),
+		}
+
+		return params
+	}
+
+	suite.Run("community pool and burn are valid recipients", func() {
+		suite.Require().NoError(suite.builderKeeper.ValidateParams(paramsWithRecipient(types.RecipientCommunityPool)))
+		suite.Require().NoError(suite.builderKeeper.ValidateParams(paramsWithRecipient(types.RecipientBurn)))
+	})
+
+	suite.Run("community pool requires a distribution keeper", func() {
+		builderKeeper := keeper.NewKeeperWithRewardsAddressProvider(
+			suite.encCfg.Codec,
+			suite.key,
+			suite.accountKeeper,
+			suite.bankKeeper,
+			rewards.NewFixedAddressRewardsAddressProvider(suite.authorityAccount),
+			suite.authorityAccount.String(),
+		)
+
+		suite.Require().Error(builderKeeper.ValidateParams(paramsWithRecipient(types.RecipientCommunityPool)))
+	})
+
+	suite.Run("burn requires the burner permission", func() {
+		accountKeeper := testutils.NewMockAccountKeeper(gomock.NewController(suite.T()))
+		accountKeeper.EXPECT().GetModuleAddressAndPermissions(types.ModuleName).Return(sdk.AccAddress{}, nil).AnyTimes()
+
+		builderKeeper := keeper.NewKeeper(
+			suite.encCfg.Codec,
+			suite.key,
+			accountKeeper,
+			suite.bankKeeper,
+			suite.distrKeeper,
+			suite.stakingKeeper,
+			suite.authorityAccount.String(),
+		)
+
+		suite.Require().Error(builderKeeper.ValidateParams(paramsWithRecipient(types.RecipientBurn)))
+	})
+
+	suite.Run("multiple bid denoms require a price converter", func() {
+		params := types.DefaultParams()
+		params.BidDenoms = []types.BidDenom{
+			{
+				ReserveFee:      sdk.NewInt64Coin("usdc", 1),
+				MinBidIncrement: sdk.NewInt64Coin("usdc", 1),
+			},
+		}
+
+		suite.Require().Error(suite.builderKeeper.ValidateParams(params))
+
+		builderKeeper := suite.builderKeeper.WithPriceConverter(prices.NewDefaultPriceConverter())
+		suite.Require().NoError(builderKeeper.ValidateParams(params))
+	})
+}
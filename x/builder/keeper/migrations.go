@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/skip-mev/pob/x/builder/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator instance.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/builder store from consensus version 1 to 2. The params that were
+// added since version 1 are set to their defaults. The auction results, bid commitments,
+// searchers, pending and validator rewards, withdraw addresses and censorship records that were
+// added since are stored under new prefixes and start out empty, so they are not migrated.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params = v2.MigrateParams(params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
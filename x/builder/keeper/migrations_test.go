@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	escrow := sdk.AccAddress([]byte("escrow"))
+
+	// Version 1 params only define the fields up to and including the proposer fee.
+	v1Params := types.Params{
+		MaxBundleSize:          5,
+		EscrowAccountAddress:   escrow,
+		ReserveFee:             sdk.NewInt64Coin("foo", 10),
+		MinBidIncrement:        sdk.NewInt64Coin("foo", 2),
+		FrontRunningProtection: false,
+		ProposerFee:            math.LegacyMustNewDecFromStr("0.1"),
+	}
+	suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, v1Params))
+
+	params, err := suite.builderKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Error(params.Validate())
+
+	suite.Require().NoError(keeper.NewMigrator(suite.builderKeeper).Migrate1to2(suite.ctx))
+
+	expected := types.DefaultParams()
+	expected.MaxBundleSize = v1Params.MaxBundleSize
+	expected.EscrowAccountAddress = v1Params.EscrowAccountAddress
+	expected.ReserveFee = v1Params.ReserveFee
+	expected.MinBidIncrement = v1Params.MinBidIncrement
+	expected.FrontRunningProtection = v1Params.FrontRunningProtection
+	expected.ProposerFee = v1Params.ProposerFee
+
+	// Empty lists are decoded as nil.
+	expected.BidDenoms = nil
+	expected.RevenueSplit = nil
+
+	params, err = suite.builderKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, params)
+	suite.Require().Equal(uint32(1), params.MaxBundlesPerBlock)
+	suite.Require().Equal(types.DefaultSearcherUnbondingPeriod, params.SearcherUnbondingPeriod)
+	suite.Require().NoError(params.Validate())
+}
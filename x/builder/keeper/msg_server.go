@@ -38,61 +38,488 @@ func (m MsgServer) AuctionBid(goCtx context.Context, msg *types.MsgAuctionBid) (
 		return nil, err
 	}
 
-	if uint32(len(msg.Transactions)) > params.MaxBundleSize {
-		return nil, fmt.Errorf("the number of transactions in the bid is greater than the maximum allowed; expected <= %d, got %d", params.MaxBundleSize, len(msg.Transactions))
+	if params.SealedBidsEnabled() {
+		return nil, fmt.Errorf("sealed bids are enabled; bids must be committed to and revealed")
 	}
 
-	escrowAddress := params.EscrowAccountAddress
+	if err := m.executeBid(ctx, params, bidder, msg.Bid, msg.Transactions); err != nil {
+		return nil, err
+	}
 
-	var proposerReward sdk.Coins
-	if params.ProposerFee.IsZero() {
-		// send the entire bid to the escrow account when no proposer fee is set
-		if err := m.bankKeeper.SendCoins(ctx, bidder, escrowAddress, sdk.NewCoins(msg.Bid)); err != nil {
-			return nil, err
-		}
-	} else {
-		rewardsAddress, err := m.rewardsAddressProvider.GetRewardsAddress(ctx)
-		if err != nil {
-			// In the case where the rewards address provider returns an error, the
-			// escrow account will receive the entire bid.
-			rewardsAddress = escrowAddress
-		}
+	return &types.MsgAuctionBidResponse{}, nil
+}
 
-		// determine the amount of the bid that goes to the (previous) proposer
-		bid := sdk.NewDecCoinsFromCoins(msg.Bid)
-		proposerReward, _ = bid.MulDecTruncate(params.ProposerFee).TruncateDecimal()
+func (m MsgServer) CommitBid(goCtx context.Context, msg *types.MsgCommitBid) (*types.MsgCommitBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-		if err := m.bankKeeper.SendCoins(ctx, bidder, rewardsAddress, proposerReward); err != nil {
-			return nil, err
-		}
+	// This should never return an error because the address was validated when
+	// the message was ingressed.
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
 
-		// Determine the amount of the remaining bid that goes to the escrow account.
-		// If a decimal remainder exists, it'll stay with the bidding account.
-		escrowTotal := bid.Sub(sdk.NewDecCoinsFromCoins(proposerReward...))
-		escrowReward, _ := escrowTotal.TruncateDecimal()
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := m.bankKeeper.SendCoins(ctx, bidder, escrowAddress, escrowReward); err != nil {
-			return nil, err
-		}
+	if !params.SealedBidsEnabled() {
+		return nil, fmt.Errorf("sealed bids are disabled")
+	}
+
+	minCollateral := params.MinCommitmentCollateral
+	if msg.Collateral.Denom != minCollateral.Denom || !msg.Collateral.IsGTE(minCollateral) {
+		return nil, fmt.Errorf("collateral (%s) is less than the minimum commitment collateral (%s)", msg.Collateral, minCollateral)
+	}
+
+	if _, err := m.GetBidCommitment(ctx, bidder, msg.Commitment); err == nil {
+		return nil, fmt.Errorf("bid commitment %X already exists for bidder %s", msg.Commitment, msg.Bidder)
+	}
+
+	// Lock the collateral in the builder module account until the bid is revealed.
+	if err := m.bankKeeper.SendCoins(ctx, bidder, m.moduleAddress, sdk.NewCoins(msg.Collateral)); err != nil {
+		return nil, err
+	}
+
+	height := uint64(ctx.BlockHeight())
+	commitment := types.BidCommitment{
+		Bidder:           msg.Bidder,
+		Commitment:       msg.Commitment,
+		Collateral:       msg.Collateral,
+		Height:           height,
+		ExpirationHeight: height + params.RevealWindow,
+	}
+	if err := m.SetBidCommitment(ctx, commitment); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommitBid,
+			sdk.NewAttribute(types.EventAttrBidder, msg.Bidder),
+			sdk.NewAttribute(types.EventAttrCommitment, fmt.Sprintf("%X", msg.Commitment)),
+			sdk.NewAttribute(types.EventAttrCollateral, msg.Collateral.String()),
+		),
+	)
+
+	return &types.MsgCommitBidResponse{}, nil
+}
+
+func (m MsgServer) RevealBid(goCtx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// This should never return an error because the address was validated when
+	// the message was ingressed.
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	if !params.SealedBidsEnabled() {
+		return nil, fmt.Errorf("sealed bids are disabled")
+	}
+
+	commitment, err := m.ValidateBidCommitment(ctx, bidder, types.ComputeBidCommitment(bidder, msg.Bid, msg.Transactions, msg.Salt))
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the collateral to the bidder now that the bid has been revealed.
+	if err := m.bankKeeper.SendCoins(ctx, m.moduleAddress, bidder, sdk.NewCoins(commitment.Collateral)); err != nil {
+		return nil, err
+	}
+
+	if err := m.DeleteBidCommitment(ctx, commitment); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevealBid,
+			sdk.NewAttribute(types.EventAttrBidder, msg.Bidder),
+			sdk.NewAttribute(types.EventAttrCommitment, fmt.Sprintf("%X", commitment.Commitment)),
+			sdk.NewAttribute(types.EventAttrCollateral, commitment.Collateral.String()),
+		),
+	)
+
+	if err := m.executeBid(ctx, params, bidder, msg.Bid, msg.Transactions); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealBidResponse{}, nil
+}
+
+// RevealBidCommitment reveals a sealed bid without bidding. The commitment is marked as revealed
+// and its collateral is returned to the bidder once the commitment expires, so a bidder whose
+// revealed bid lost the auction is not slashed. The bid can still be revealed with RevealBid
+// until the commitment expires.
+func (m MsgServer) RevealBidCommitment(goCtx context.Context, msg *types.MsgRevealBidCommitment) (*types.MsgRevealBidCommitmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment, err := m.ValidateBidCommitment(ctx, bidder, types.ComputeBidCommitment(bidder, msg.Bid, msg.Transactions, msg.Salt))
+	if err != nil {
+		return nil, err
+	}
+
+	if commitment.Revealed {
+		return nil, fmt.Errorf("bid commitment %X has already been revealed", commitment.Commitment)
+	}
+
+	if err := m.markBidCommitmentRevealed(ctx, commitment); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevealCommitment,
+			sdk.NewAttribute(types.EventAttrBidder, msg.Bidder),
+			sdk.NewAttribute(types.EventAttrCommitment, fmt.Sprintf("%X", commitment.Commitment)),
+		),
+	)
+
+	return &types.MsgRevealBidCommitmentResponse{}, nil
+}
+
+// SubmitBundle executes a bundle that is submitted without a bid. The bundled transactions are
+// executed in order against a branch of the state that is only written if all of them succeed,
+// so either all of the bundled transactions are executed or none of them are.
+func (m MsgServer) SubmitBundle(goCtx context.Context, msg *types.MsgSubmitBundle) (*types.MsgSubmitBundleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.bundleExecutor == nil {
+		return nil, fmt.Errorf("bundle execution is not enabled")
+	}
+
+	maxBundleSize, err := m.GetMaxBundleSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint32(len(msg.Transactions)) > maxBundleSize {
+		return nil, fmt.Errorf("the number of transactions in the bundle is greater than the maximum allowed; expected <= %d, got %d", maxBundleSize, len(msg.Transactions))
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := m.bundleExecutor.ExecuteBundle(cacheCtx, msg.Transactions); err != nil {
+		return nil, fmt.Errorf("failed to execute bundle: %w", err)
+	}
+
+	write()
+
 	bundledTxHashes := make([]string, len(msg.Transactions))
 	for i, refTxRaw := range msg.Transactions {
 		hash := sha256.Sum256(refTxRaw)
 		bundledTxHashes[i] = hex.EncodeToString(hash[:])
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitBundle,
+			sdk.NewAttribute(types.EventAttrSender, msg.Sender),
+			sdk.NewAttribute(types.EventAttrBundledTxs, strings.Join(bundledTxHashes, ",")),
+		),
+	)
+
+	return &types.MsgSubmitBundleResponse{}, nil
+}
+
+// executeBid pays out the winning bid to the recipients of the auction proceeds and records the
+// outcome of the auction.
+func (m MsgServer) executeBid(
+	ctx sdk.Context,
+	params types.Params,
+	bidder sdk.AccAddress,
+	bid sdk.Coin,
+	transactions [][]byte,
+) error {
+	if uint32(len(transactions)) > params.MaxBundleSize {
+		return fmt.Errorf("the number of transactions in the bid is greater than the maximum allowed; expected <= %d, got %d", params.MaxBundleSize, len(transactions))
+	}
+
+	if err := m.ValidateSearcher(ctx, params, bidder); err != nil {
+		return err
+	}
+
+	// Encrypted bundled transactions are included in the block as their decrypted transactions,
+	// so the decrypted transactions are recorded and tracked instead of their ciphertext.
+	transactions, err := m.decryptBundle(ctx, transactions)
+	if err != nil {
+		return err
+	}
+
+	// Determine the amount the bidder pays, which is less than the bid if the
+	// second price pricing rule is used.
+	price, err := m.GetClearingPrice(ctx, params, bid)
+	if err != nil {
+		return err
+	}
+
+	// Pay out the price to the recipients of the auction proceeds.
+	proposerReward, escrowReward, err := m.distributeRevenue(ctx, params, bidder, price)
+	if err != nil {
+		return err
+	}
+
+	bundledTxHashes := make([]string, len(transactions))
+	for i, refTxRaw := range transactions {
+		hash := sha256.Sum256(refTxRaw)
+		bundledTxHashes[i] = hex.EncodeToString(hash[:])
+	}
+
+	// Record the outcome of the auction so that it can be queried later. Winning bids are
+	// executed in order, so the bundle index is the number of results already recorded
+	// at this height.
+	height := uint64(ctx.BlockHeight())
+	resultsAtHeight, err := m.GetAuctionResultsAtHeight(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	result := types.AuctionResult{
+		Height:          height,
+		Bidder:          bidder.String(),
+		Bid:             bid,
+		ProposerReward:  proposerReward,
+		EscrowReward:    escrowReward,
+		BundledTxHashes: bundledTxHashes,
+		BundleIndex:     uint32(len(resultsAtHeight)),
+		Price:           &price,
+		Proposer:        sdk.ConsAddress(ctx.BlockHeader().ProposerAddress).String(),
+	}
+	if err := m.SetAuctionResult(ctx, result); err != nil {
+		return err
+	}
+
+	if err := m.recordBidWon(ctx, bidder); err != nil {
+		return err
+	}
+
+	// Track the bundled transactions so that the bidder can be refunded at the end of the block
+	// if any of them fail.
+	if params.RevertProtectionEnabled() {
+		m.SetPendingBundledTxs(ctx, result.BundleIndex, transactions)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeAuctionBid,
-			sdk.NewAttribute(types.EventAttrBidder, msg.Bidder),
-			sdk.NewAttribute(types.EventAttrBid, msg.Bid.String()),
+			sdk.NewAttribute(types.EventAttrBidder, bidder.String()),
+			sdk.NewAttribute(types.EventAttrBid, bid.String()),
+			sdk.NewAttribute(types.EventAttrPrice, price.String()),
 			sdk.NewAttribute(types.EventAttrProposerReward, proposerReward.String()),
 			sdk.NewAttribute(types.EventAttrBundledTxs, strings.Join(bundledTxHashes, ",")),
 		),
 	)
 
-	return &types.MsgAuctionBidResponse{}, nil
+	return nil
+}
+
+func (m MsgServer) RegisterSearcher(goCtx context.Context, msg *types.MsgRegisterSearcher) (*types.MsgRegisterSearcherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// This should never return an error because the address was validated when
+	// the message was ingressed.
+	address, err := sdk.AccAddressFromBech32(msg.Searcher)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Collateral.Denom != params.MinSearcherCollateral.Denom {
+		return nil, fmt.Errorf(
+			"collateral denom (%s) does not match the minimum searcher collateral denom (%s)",
+			msg.Collateral.Denom,
+			params.MinSearcherCollateral.Denom,
+		)
+	}
+
+	// Searchers that are already registered add to their bonded collateral. Searchers whose
+	// collateral is bonded in a different denom, e.g. because the denom of the minimum searcher
+	// collateral changed, must unbond it before they can bond collateral in the new denom.
+	searcher, err := m.GetSearcher(ctx, address)
+	switch {
+	case err != nil:
+		searcher = types.NewSearcher(address, msg.Collateral)
+
+	case searcher.Collateral.Denom != msg.Collateral.Denom && searcher.Collateral.IsZero():
+		searcher.Collateral = msg.Collateral
+
+	case searcher.Collateral.Denom != msg.Collateral.Denom:
+		return nil, fmt.Errorf(
+			"collateral denom (%s) does not match the bonded collateral (%s); unbond it first",
+			msg.Collateral.Denom,
+			searcher.Collateral,
+		)
+
+	default:
+		searcher.Collateral = searcher.Collateral.Add(msg.Collateral)
+	}
+
+	// Bond the collateral in the builder module account.
+	if err := m.bankKeeper.SendCoins(ctx, address, m.moduleAddress, sdk.NewCoins(msg.Collateral)); err != nil {
+		return nil, err
+	}
+
+	if err := m.SetSearcher(ctx, searcher); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterSearcher,
+			sdk.NewAttribute(types.EventAttrSearcher, msg.Searcher),
+			sdk.NewAttribute(types.EventAttrCollateral, searcher.Collateral.String()),
+		),
+	)
+
+	return &types.MsgRegisterSearcherResponse{}, nil
+}
+
+func (m MsgServer) UnbondSearcherCollateral(goCtx context.Context, msg *types.MsgUnbondSearcherCollateral) (*types.MsgUnbondSearcherCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// This should never return an error because the address was validated when
+	// the message was ingressed.
+	address, err := sdk.AccAddressFromBech32(msg.Searcher)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searcher, err := m.UnbondSearcher(ctx, params, address, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbondSearcher,
+			sdk.NewAttribute(types.EventAttrSearcher, msg.Searcher),
+			sdk.NewAttribute(types.EventAttrAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.EventAttrCompletion, fmt.Sprint(searcher.UnbondingCompletionHeight)),
+		),
+	)
+
+	return &types.MsgUnbondSearcherCollateralResponse{CompletionHeight: searcher.UnbondingCompletionHeight}, nil
+}
+
+func (m MsgServer) WithdrawSearcherCollateral(goCtx context.Context, msg *types.MsgWithdrawSearcherCollateral) (*types.MsgWithdrawSearcherCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// This should never return an error because the address was validated when
+	// the message was ingressed.
+	address, err := sdk.AccAddressFromBech32(msg.Searcher)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := m.WithdrawUnbondedCollateral(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawSearcher,
+			sdk.NewAttribute(types.EventAttrSearcher, msg.Searcher),
+			sdk.NewAttribute(types.EventAttrAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawSearcherCollateralResponse{Amount: amount}, nil
+}
+
+func (m MsgServer) WithdrawBuilderRewards(goCtx context.Context, msg *types.MsgWithdrawBuilderRewards) (*types.MsgWithdrawBuilderRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// This should never return an error because the address was validated when
+	// the message was ingressed.
+	validator, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := m.WithdrawValidatorRewards(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawBuilderRewardsResponse{Amount: amount}, nil
+}
+
+func (m MsgServer) SetBuilderRewardsWithdrawAddress(
+	goCtx context.Context,
+	msg *types.MsgSetBuilderRewardsWithdrawAddress,
+) (*types.MsgSetBuilderRewardsWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawAddress := types.RewardsWithdrawAddress{
+		ValidatorAddress: msg.ValidatorAddress,
+		WithdrawAddress:  msg.WithdrawAddress,
+	}
+	if err := m.SetRewardsWithdrawAddress(ctx, withdrawAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddr,
+			sdk.NewAttribute(types.EventAttrValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.EventAttrWithdrawAddr, msg.WithdrawAddress),
+		),
+	)
+
+	return &types.MsgSetBuilderRewardsWithdrawAddressResponse{}, nil
+}
+
+func (m MsgServer) ReportCensoredBid(goCtx context.Context, msg *types.MsgReportCensoredBid) (*types.MsgReportCensoredBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// This should never return an error because the address was validated when
+	// the message was ingressed.
+	reporter, err := sdk.AccAddressFromBech32(msg.Reporter)
+	if err != nil {
+		return nil, err
+	}
+
+	report, score, err := m.Keeper.ReportCensoredBid(ctx, reporter, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCensoredBid,
+			sdk.NewAttribute(types.EventAttrHeight, fmt.Sprintf("%d", report.Height)),
+			sdk.NewAttribute(types.EventAttrProposer, report.Proposer),
+			sdk.NewAttribute(types.EventAttrBidder, report.Bidder),
+			sdk.NewAttribute(types.EventAttrBid, report.Bid.String()),
+			sdk.NewAttribute(types.EventAttrBidTx, report.BidTxHash),
+			sdk.NewAttribute(types.EventAttrValidator, report.ValidatorAddress),
+			sdk.NewAttribute(types.EventAttrScore, fmt.Sprintf("%d", score.Score)),
+		),
+	)
+
+	return &types.MsgReportCensoredBidResponse{}, nil
 }
 
 func (m MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -103,9 +530,45 @@ func (m MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, fmt.Errorf("this message can only be executed by the authority; expected %s, got %s", m.Keeper.GetAuthority(), msg.Authority)
 	}
 
+	if err := m.Keeper.ValidateParams(msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := m.Keeper.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// decryptBundle returns the bundled transactions with the encrypted bundled transactions
+// decrypted with the keeper's bundle decrypter.
+func (m MsgServer) decryptBundle(ctx sdk.Context, transactions [][]byte) ([][]byte, error) {
+	if !types.IsEncryptedBundle(transactions) {
+		return transactions, nil
+	}
+
+	if m.bundleDecrypter == nil {
+		return nil, fmt.Errorf("encrypted bundled transactions require a bundle decrypter")
+	}
+
+	decryptedTxs := make([][]byte, len(transactions))
+	for index, tx := range transactions {
+		if !types.IsEncryptedBundleTx(tx) {
+			decryptedTxs[index] = tx
+			continue
+		}
+
+		_, ciphertext, err := types.DecodeEncryptedBundleTx(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		decryptedTxs[index], err = m.bundleDecrypter.Decrypt(ctx, ciphertext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt bundled tx %d: %w", index, err)
+		}
+	}
+
+	return decryptedTxs, nil
+}
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -8,9 +11,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
 	"github.com/skip-mev/pob/x/builder/types"
 )
 
+// plaintextDecrypter is a bundle decrypter whose ciphertexts are the plaintext transactions.
+type plaintextDecrypter struct{}
+
+func (plaintextDecrypter) Decrypt(_ sdk.Context, ciphertext []byte) ([]byte, error) {
+	return ciphertext, nil
+}
+
 func (suite *KeeperTestSuite) TestMsgAuctionBid() {
 	rng := rand.New(rand.NewSource(time.Now().Unix()))
 	accounts := testutils.RandomAccounts(rng, 4)
@@ -108,6 +119,21 @@ func (suite *KeeperTestSuite) TestMsgAuctionBid() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "encrypted bundle without a bundle decrypter",
+			msg: &types.MsgAuctionBid{
+				Bidder:       bidder.Address.String(),
+				Bid:          sdk.NewInt64Coin("stake", 1024),
+				Transactions: [][]byte{types.EncodeEncryptedBundleTx(0, []byte{0xFF})},
+			},
+			malleate: func() {
+				params := types.DefaultParams()
+				params.ProposerFee = math.LegacyZeroDec()
+				params.EscrowAccountAddress = escrow.Address
+				suite.builderKeeper.SetParams(suite.ctx, params)
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -119,11 +145,162 @@ func (suite *KeeperTestSuite) TestMsgAuctionBid() {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
+
+				results, err := suite.builderKeeper.GetAuctionResultsAtHeight(suite.ctx, uint64(suite.ctx.BlockHeight()))
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(results)
+
+				result := results[len(results)-1]
+				suite.Require().Equal(uint32(len(results)-1), result.BundleIndex)
+				suite.Require().Equal(tc.msg.Bidder, result.Bidder)
+				suite.Require().Equal(tc.msg.Bid, result.Bid)
+				suite.Require().Len(result.BundledTxHashes, len(tc.msg.Transactions))
+				suite.Require().Equal(tc.msg.Bid.Amount, result.ProposerReward.Add(result.EscrowReward...).AmountOf(tc.msg.Bid.Denom))
 			}
 		})
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgAuctionBidEncryptedBundle() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+
+	params := types.DefaultParams()
+	params.ProposerFee = math.LegacyZeroDec()
+	params.EscrowAccountAddress = escrow.Address
+	params.RevertProtectionRefund = math.LegacyMustNewDecFromStr("0.5")
+	suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+	// The escrow account's reward is held by the builder module account for refunds.
+	suite.bankKeeper.EXPECT().
+		SendCoins(suite.ctx, bidder.Address, sdk.AccAddress{}, sdk.NewCoins(sdk.NewInt64Coin("stake", 1024))).
+		Return(nil)
+
+	plaintext := []byte{0xFF}
+	msg := &types.MsgAuctionBid{
+		Bidder:       bidder.Address.String(),
+		Bid:          sdk.NewInt64Coin("stake", 1024),
+		Transactions: [][]byte{types.EncodeEncryptedBundleTx(0, plaintext)},
+	}
+
+	msgServer := keeper.NewMsgServerImpl(suite.builderKeeper.WithBundleDecrypter(plaintextDecrypter{}))
+	_, err := msgServer.AuctionBid(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// The auction result records the hash of the decrypted transaction.
+	results, err := suite.builderKeeper.GetAuctionResultsAtHeight(suite.ctx, uint64(suite.ctx.BlockHeight()))
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+
+	hash := sha256.Sum256(plaintext)
+	suite.Require().Equal([]string{hex.EncodeToString(hash[:])}, results[0].BundledTxHashes)
+
+	// The decrypted transaction is tracked by revert protection, so the bundle is not refunded
+	// once the decrypted transaction is executed.
+	suite.builderKeeper.MarkBundledTxExecuted(suite.ctx, plaintext)
+	suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+}
+
+// bundleExecutor is a bundle executor that sets the max bundle size to the number of bundled
+// transactions before it returns its error.
+type bundleExecutor struct {
+	keeper keeper.Keeper
+	err    error
+}
+
+func (e bundleExecutor) ExecuteBundle(ctx sdk.Context, txs [][]byte) error {
+	params, err := e.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.MaxBundleSize = uint32(len(txs))
+	if err := e.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	return e.err
+}
+
+func (suite *KeeperTestSuite) TestMsgSubmitBundle() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	sender := testutils.RandomAccounts(rng, 1)[0]
+
+	// setup resets the keeper and enables bundle execution with an executor that returns the
+	// given error.
+	setup := func(enabled bool, executorErr error) {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.MaxBundleSize = 3
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		if enabled {
+			executor := bundleExecutor{keeper: suite.builderKeeper, err: executorErr}
+			suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper.WithBundleExecutor(executor))
+		}
+	}
+
+	maxBundleSize := func() uint32 {
+		params, err := suite.builderKeeper.GetParams(suite.ctx)
+		suite.Require().NoError(err)
+
+		return params.MaxBundleSize
+	}
+
+	suite.Run("bundle execution is not enabled", func() {
+		setup(false, nil)
+
+		msg := types.NewMsgSubmitBundle(sender.Address, [][]byte{{0xFF}})
+		_, err := suite.msgServer.SubmitBundle(suite.ctx, msg)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("too many bundled transactions", func() {
+		setup(true, nil)
+
+		msg := types.NewMsgSubmitBundle(sender.Address, [][]byte{{0xFF}, {0xFF}, {0xFF}, {0xFF}})
+		_, err := suite.msgServer.SubmitBundle(suite.ctx, msg)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("state changes of a failed bundle are not applied", func() {
+		setup(true, fmt.Errorf("tx failed"))
+
+		msg := types.NewMsgSubmitBundle(sender.Address, [][]byte{{0xFF}, {0xFE}})
+		_, err := suite.msgServer.SubmitBundle(suite.ctx, msg)
+		suite.Require().Error(err)
+		suite.Require().Equal(uint32(3), maxBundleSize())
+	})
+
+	suite.Run("valid bundle is executed and does not pay a bid", func() {
+		setup(true, nil)
+
+		// The bank keeper mock fails the test if any coins are sent.
+		msg := types.NewMsgSubmitBundle(sender.Address, [][]byte{{0xFF}, {0xFE}})
+		_, err := suite.msgServer.SubmitBundle(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint32(2), maxBundleSize())
+
+		events := suite.ctx.EventManager().Events()
+		suite.Require().NotEmpty(events)
+
+		event := events[len(events)-1]
+		suite.Require().Equal(types.EventTypeSubmitBundle, event.Type)
+
+		sender, ok := event.GetAttribute(types.EventAttrSender)
+		suite.Require().True(ok)
+		suite.Require().Equal(msg.Sender, sender.Value)
+
+		results, err := suite.builderKeeper.GetAuctionResultsAtHeight(suite.ctx, uint64(suite.ctx.BlockHeight()))
+		suite.Require().NoError(err)
+		suite.Require().Empty(results)
+	})
+}
+
 func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	rng := rand.New(rand.NewSource(time.Now().Unix()))
 	account := testutils.RandomAccounts(rng, 1)[0]
@@ -144,7 +321,7 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 				},
 			},
 			passBasic: false,
-			pass:      true,
+			pass:      false,
 		},
 		{
 			name: "invalid auction fees",
@@ -155,6 +332,15 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 				},
 			},
 			passBasic: false,
+			pass:      false,
+		},
+		{
+			name: "valid params",
+			msg: &types.MsgUpdateParams{
+				Authority: suite.authorityAccount.String(),
+				Params:    types.DefaultParams(),
+			},
+			passBasic: true,
 			pass:      true,
 		},
 		{
@@ -167,6 +353,7 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 					EscrowAccountAddress: suite.authorityAccount,
 					MinBidIncrement:      sdk.NewInt64Coin("stake", 100),
 					ReserveFee:           sdk.NewInt64Coin("stake", 100),
+					MaxBundlesPerBlock:   1,
 				},
 			},
 			passBasic: true,
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetPendingReward stores the pending reward of the recipient. Zero rewards are removed.
+func (k Keeper) SetPendingReward(ctx sdk.Context, reward types.PendingReward) error {
+	recipient, err := sdk.AccAddressFromBech32(reward.Address)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if reward.Amount.IsZero() {
+		store.Delete(types.GetPendingRewardKey(recipient))
+		return nil
+	}
+
+	bz, err := reward.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetPendingRewardKey(recipient), bz)
+
+	return nil
+}
+
+// GetPendingReward returns the rewards that have been accrued for the recipient but not
+// paid out yet.
+func (k Keeper) GetPendingReward(ctx sdk.Context, recipient sdk.AccAddress) (sdk.Coins, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingRewardKey(recipient))
+	if len(bz) == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	reward := types.PendingReward{}
+	if err := reward.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return reward.Amount, nil
+}
+
+// GetAllPendingRewards returns the pending rewards of all recipients.
+func (k Keeper) GetAllPendingRewards(ctx sdk.Context) ([]types.PendingReward, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingReward)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	rewards := make([]types.PendingReward, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		reward := types.PendingReward{}
+		if err := reward.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		rewards = append(rewards, reward)
+	}
+
+	return rewards, nil
+}
+
+// PayoutPendingRewards pays out the pending rewards from the builder module account if the
+// current block is a payout block. Pending rewards are also paid out if deferred payouts
+// have been disabled since they were accrued. At most MaxPendingRewardPayoutsPerBlock rewards
+// are paid out per block; the payout is continued in the following blocks until all rewards
+// have been paid out. Rewards that fail to be paid out are left pending until the next payout.
+func (k Keeper) PayoutPendingRewards(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	start := store.Get(types.KeyPendingRewardPayoutCursor)
+	if start == nil {
+		if params.DeferredPayoutEnabled() && uint64(ctx.BlockHeight())%params.RewardPayoutInterval != 0 {
+			return nil
+		}
+
+		start = types.KeyPrefixPendingReward
+	}
+
+	// The rewards are read before any of them is paid out so that the store is not written
+	// to while it is iterated.
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes(types.KeyPrefixPendingReward))
+
+	rewards := make([]types.PendingReward, 0)
+	for ; iterator.Valid() && len(rewards) < types.MaxPendingRewardPayoutsPerBlock; iterator.Next() {
+		reward := types.PendingReward{}
+		if err := reward.Unmarshal(iterator.Value()); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		rewards = append(rewards, reward)
+	}
+
+	var next []byte
+	if iterator.Valid() {
+		next = append([]byte{}, iterator.Key()...)
+	}
+
+	iterator.Close()
+
+	for _, reward := range rewards {
+		if err := k.payoutPendingReward(ctx, reward); err != nil {
+			k.Logger(ctx).Error(
+				"failed to pay out pending reward",
+				"recipient", reward.Address,
+				"amount", reward.Amount.String(),
+				"err", err,
+			)
+		}
+	}
+
+	if next == nil {
+		store.Delete(types.KeyPendingRewardPayoutCursor)
+	} else {
+		store.Set(types.KeyPendingRewardPayoutCursor, next)
+	}
+
+	return nil
+}
+
+// payoutPendingReward sends the pending reward to its recipient and removes it. Nothing is
+// written if the reward cannot be paid out.
+func (k Keeper) payoutPendingReward(ctx sdk.Context, reward types.PendingReward) error {
+	recipient, err := sdk.AccAddressFromBech32(reward.Address)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoins(cacheCtx, k.moduleAddress, recipient, reward.Amount); err != nil {
+		return err
+	}
+
+	cacheCtx.KVStore(k.storeKey).Delete(types.GetPendingRewardKey(recipient))
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayoutReward,
+			sdk.NewAttribute(types.EventAttrRecipient, reward.Address),
+			sdk.NewAttribute(types.EventAttrAmount, reward.Amount.String()),
+		),
+	)
+
+	write()
+
+	return nil
+}
+
+// sendReward sends the reward from the sender to the recipient. If deferred payouts are
+// enabled, the reward is held by the builder module account instead and accrued for the
+// recipient until the next payout. If revert protection is enabled, the escrow account's
+// reward is always accrued so that refunds are paid from funds held by the module; it is
+// paid out at the end of the block otherwise. Rewards that the module account has already
+// collected are only credited to the recipient.
+func (k Keeper) sendReward(
+	ctx sdk.Context,
+	params types.Params,
+	sender, recipient sdk.AccAddress,
+	reward sdk.Coins,
+) error {
+	holdForRefunds := params.RevertProtectionEnabled() && recipient.Equals(sdk.AccAddress(params.EscrowAccountAddress))
+	if !params.DeferredPayoutEnabled() && !holdForRefunds {
+		return k.bankKeeper.SendCoins(ctx, sender, recipient, reward)
+	}
+
+	if reward.IsZero() {
+		return nil
+	}
+
+	if !sender.Equals(k.moduleAddress) {
+		if err := k.bankKeeper.SendCoins(ctx, sender, k.moduleAddress, reward); err != nil {
+			return err
+		}
+	}
+
+	pending, err := k.GetPendingReward(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	return k.SetPendingReward(ctx, types.NewPendingReward(recipient, pending.Add(reward...)))
+}
+
+// claimPendingReward removes up to the given amount from the pending reward of the recipient
+// and returns the amount that was removed. The removed amount is held by the builder module
+// account.
+func (k Keeper) claimPendingReward(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	pending, err := k.GetPendingReward(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := amount.Min(pending)
+	if claimed.IsZero() {
+		return claimed, nil
+	}
+
+	if err := k.SetPendingReward(ctx, types.NewPendingReward(recipient, pending.Sub(claimed...))); err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/rewards"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestDeferredPayout() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 3)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+	proposer := accounts[2]
+
+	bid := sdk.NewInt64Coin("stake", 100)
+	transactions := [][]byte{{0x01}}
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	setUp := func(revertProtectionRefund math.LegacyDec) {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.EscrowAccountAddress = escrow.Address
+		params.ProposerFee = math.LegacyMustNewDecFromStr("0.4")
+		params.RewardPayoutInterval = 2
+		params.RevertProtectionRefund = revertProtectionRefund
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		suite.builderKeeper = suite.builderKeeper.WithRewardsAddressProvider(rewards.NewFixedAddressRewardsAddressProvider(proposer.Address))
+		suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper)
+
+		suite.ctx = suite.ctx.WithBlockHeight(3).WithExecMode(sdk.ExecModeFinalize)
+
+		// The proceeds are accrued in the builder module account.
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, sdk.AccAddress{}, coins(100)).
+			Return(nil)
+
+		_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, transactions))
+		suite.Require().NoError(err)
+	}
+
+	suite.Run("proceeds are paid out at the payout interval", func() {
+		setUp(math.LegacyZeroDec())
+
+		pending, err := suite.builderKeeper.GetPendingReward(suite.ctx, proposer.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(40), pending)
+
+		pending, err = suite.builderKeeper.GetPendingReward(suite.ctx, escrow.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(60), pending)
+
+		// Nothing is paid out outside of the payout interval.
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+
+		suite.ctx = suite.ctx.WithBlockHeight(4)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, proposer.Address, coins(40)).
+			Return(nil)
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, escrow.Address, coins(60)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+
+		remaining, err := suite.builderKeeper.GetAllPendingRewards(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().Empty(remaining)
+	})
+
+	suite.Run("refunds are deducted from the pending escrow reward", func() {
+		setUp(math.LegacyMustNewDecFromStr("0.5"))
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, bidder.Address, coins(50)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+
+		pending, err := suite.builderKeeper.GetPendingReward(suite.ctx, escrow.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(10), pending)
+	})
+}
+
+func (suite *KeeperTestSuite) TestPayoutPendingRewards() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	setUp := func(numRewards int) []testutils.Account {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.RewardPayoutInterval = 2
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		accounts := testutils.RandomAccounts(rng, numRewards)
+		for _, account := range accounts {
+			suite.Require().NoError(suite.builderKeeper.SetPendingReward(suite.ctx, types.NewPendingReward(account.Address, coins(10))))
+		}
+
+		suite.ctx = suite.ctx.WithBlockHeight(4)
+
+		return accounts
+	}
+
+	suite.Run("failed payouts are left pending", func() {
+		accounts := setUp(2)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, accounts[0].Address, coins(10)).
+			Return(fmt.Errorf("recipient is blocked"))
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, accounts[1].Address, coins(10)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+
+		remaining, err := suite.builderKeeper.GetAllPendingRewards(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.PendingReward{types.NewPendingReward(accounts[0].Address, coins(10))}, remaining)
+	})
+
+	suite.Run("payouts are capped per block and continued in the next block", func() {
+		setUp(types.MaxPendingRewardPayoutsPerBlock + 1)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, gomock.Any(), coins(10)).
+			Return(nil).
+			Times(types.MaxPendingRewardPayoutsPerBlock)
+
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+
+		remaining, err := suite.builderKeeper.GetAllPendingRewards(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().Len(remaining, 1)
+
+		// The payout is continued outside of the payout interval.
+		suite.ctx = suite.ctx.WithBlockHeight(5)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, gomock.Any(), coins(10)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+
+		remaining, err = suite.builderKeeper.GetAllPendingRewards(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().Empty(remaining)
+
+		// Nothing is paid out once the payout is complete.
+		suite.ctx = suite.ctx.WithBlockHeight(7)
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+	})
+}
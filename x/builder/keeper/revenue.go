@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// distributeRevenue pays out the price of a winning bid. If no revenue split is configured,
+// the proposer fee is sent to the proposer and the rest of the price to the escrow account.
+// Otherwise, the price is distributed according to the revenue split. If the builder module
+// account holds any of the proceeds, the price is collected by the module account once and
+// the shares are paid out of it or credited to their recipients. The amounts received by the
+// proposer and the escrow account are returned.
+func (k Keeper) distributeRevenue(
+	ctx sdk.Context,
+	params types.Params,
+	bidder sdk.AccAddress,
+	price sdk.Coin,
+) (proposerReward, escrowReward sdk.Coins, err error) {
+	if len(params.RevenueSplit) == 0 {
+		return k.distributeProposerFee(ctx, params, bidder, price)
+	}
+
+	payer, err := k.collectProceeds(ctx, params, bidder, sdk.NewCoins(price))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decPrice := sdk.NewDecCoinsFromCoins(price)
+	remaining := sdk.NewCoins(price)
+
+	for i, share := range params.RevenueSplit {
+		// The last recipient receives the remainder so that the entire price is paid out.
+		amount := remaining
+		if i < len(params.RevenueSplit)-1 {
+			amount, _ = decPrice.MulDecTruncate(share.Weight).TruncateDecimal()
+			remaining = remaining.Sub(amount...)
+		}
+
+		if amount.IsZero() {
+			continue
+		}
+
+		if err := k.payRevenueShare(ctx, params, bidder, payer, share.Recipient, amount); err != nil {
+			return nil, nil, err
+		}
+
+		switch share.Recipient {
+		case types.RecipientProposer:
+			proposerReward = proposerReward.Add(amount...)
+		case types.RecipientEscrow:
+			escrowReward = escrowReward.Add(amount...)
+		}
+	}
+
+	return proposerReward, escrowReward, nil
+}
+
+// distributeProposerFee sends the proposer fee portion of the price to the proposer and the
+// rest to the escrow account.
+func (k Keeper) distributeProposerFee(
+	ctx sdk.Context,
+	params types.Params,
+	bidder sdk.AccAddress,
+	price sdk.Coin,
+) (proposerReward, escrowReward sdk.Coins, err error) {
+	escrowAddress := params.EscrowAccountAddress
+
+	if params.ProposerFee.IsZero() {
+		// send the entire price to the escrow account when no proposer fee is set
+		escrowReward = sdk.NewCoins(price)
+
+		payer, err := k.collectProceeds(ctx, params, bidder, escrowReward)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := k.sendReward(ctx, params, payer, escrowAddress, escrowReward); err != nil {
+			return nil, nil, err
+		}
+
+		return nil, escrowReward, nil
+	}
+
+	// determine the amount of the price that goes to the proposer
+	decPrice := sdk.NewDecCoinsFromCoins(price)
+	proposerReward, _ = decPrice.MulDecTruncate(params.ProposerFee).TruncateDecimal()
+
+	// Determine the amount of the remaining price that goes to the escrow account.
+	// If a decimal remainder exists, it'll stay with the bidding account.
+	escrowTotal := decPrice.Sub(sdk.NewDecCoinsFromCoins(proposerReward...))
+	escrowReward, _ = escrowTotal.TruncateDecimal()
+
+	payer, err := k.collectProceeds(ctx, params, bidder, proposerReward.Add(escrowReward...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.payProposer(ctx, params, payer, proposerReward); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.sendReward(ctx, params, payer, escrowAddress, escrowReward); err != nil {
+		return nil, nil, err
+	}
+
+	return proposerReward, escrowReward, nil
+}
+
+// holdsProceeds returns true if the builder module account holds any portion of the auction
+// proceeds before it is paid out, i.e. if payouts are deferred, if the escrow account's
+// portion is held for refunds or if proposer rewards are claimable.
+func (k Keeper) holdsProceeds(params types.Params) bool {
+	return params.DeferredPayoutEnabled() || params.RevertProtectionEnabled() || params.ClaimableProposerRewards
+}
+
+// collectProceeds sends the given amount from the bidder to the builder module account in a
+// single transfer if the module account holds any portion of the proceeds. It returns the
+// account that pays out the proceeds, which is either the bidder or the module account.
+func (k Keeper) collectProceeds(
+	ctx sdk.Context,
+	params types.Params,
+	bidder sdk.AccAddress,
+	amount sdk.Coins,
+) (sdk.AccAddress, error) {
+	if !k.holdsProceeds(params) || amount.IsZero() {
+		return bidder, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, bidder, k.moduleAddress, amount); err != nil {
+		return nil, err
+	}
+
+	return k.moduleAddress, nil
+}
+
+// payRevenueShare sends the given amount from the payer, i.e. the bidder or the builder module
+// account that collected the price, to the recipient of a revenue share.
+func (k Keeper) payRevenueShare(
+	ctx sdk.Context,
+	params types.Params,
+	bidder, payer sdk.AccAddress,
+	recipient string,
+	amount sdk.Coins,
+) error {
+	switch recipient {
+	case types.RecipientProposer:
+		if err := k.payProposer(ctx, params, payer, amount); err != nil {
+			return err
+		}
+
+	case types.RecipientEscrow:
+		if err := k.sendReward(ctx, params, payer, params.EscrowAccountAddress, amount); err != nil {
+			return err
+		}
+
+	case types.RecipientCommunityPool:
+		if k.distrKeeper == nil {
+			return fmt.Errorf("cannot fund the community pool without a distribution keeper")
+		}
+
+		if err := k.distrKeeper.FundCommunityPool(ctx, amount, payer); err != nil {
+			return err
+		}
+
+	case types.RecipientBurn:
+		if !payer.Equals(k.moduleAddress) {
+			if err := k.bankKeeper.SendCoins(ctx, payer, k.moduleAddress, amount); err != nil {
+				return err
+			}
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+			return err
+		}
+
+	case types.RecipientFeeCollector:
+		if err := k.sendReward(ctx, params, payer, k.feeCollectorAddress, amount); err != nil {
+			return err
+		}
+
+	default:
+		address, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.sendReward(ctx, params, payer, address, amount); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeBid,
+			sdk.NewAttribute(types.EventAttrBidder, bidder.String()),
+			sdk.NewAttribute(types.EventAttrRecipient, recipient),
+			sdk.NewAttribute(types.EventAttrAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// payProposer sends the proposer's reward from the payer to the address returned by the
+// rewards address provider. If the provider is a RewardsDistributor, it pays out part of the
+// reward itself. If proposer rewards are claimable and the provider is a
+// ValidatorRewardsAddressProvider, the reward is accrued for the validator it returns instead.
+func (k Keeper) payProposer(ctx sdk.Context, params types.Params, payer sdk.AccAddress, reward sdk.Coins) error {
+	rewardsAddress, err := k.rewardsAddressProvider.GetRewardsAddress(ctx)
+	if err != nil {
+		// In the case where the rewards address provider returns an error, the
+		// escrow account will receive the proposer's reward.
+		return k.sendReward(ctx, params, payer, params.EscrowAccountAddress, reward)
+	}
+
+	if distributor, ok := k.rewardsAddressProvider.(types.RewardsDistributor); ok {
+		if reward, err = distributor.DistributeRewards(ctx, payer, reward); err != nil {
+			return err
+		}
+	}
+
+	if validatorProvider, ok := k.rewardsAddressProvider.(types.ValidatorRewardsAddressProvider); ok && params.ClaimableProposerRewards {
+		validator, err := validatorProvider.GetRewardsValidator(ctx)
+		if err != nil {
+			return err
+		}
+
+		return k.accrueValidatorRewards(ctx, payer, validator, reward)
+	}
+
+	return k.sendReward(ctx, params, payer, rewardsAddress, reward)
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/rewards"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestRevenueSplit() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 3)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+	recipient := accounts[2]
+
+	bid := sdk.NewInt64Coin("stake", 101)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	setUp := func(split []types.RevenueShare) {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.EscrowAccountAddress = escrow.Address
+		params.RevenueSplit = split
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+	}
+
+	suite.Run("price is distributed to all recipients of the revenue split", func() {
+		setUp([]types.RevenueShare{
+			types.NewRevenueShare(types.RecipientBurn, math.LegacyMustNewDecFromStr("0.4")),
+			types.NewRevenueShare(types.RecipientCommunityPool, math.LegacyMustNewDecFromStr("0.3")),
+			types.NewRevenueShare(types.RecipientFeeCollector, math.LegacyMustNewDecFromStr("0.1")),
+			types.NewRevenueShare(recipient.Address.String(), math.LegacyMustNewDecFromStr("0.1")),
+			types.NewRevenueShare(types.RecipientEscrow, math.LegacyMustNewDecFromStr("0.1")),
+		})
+
+		gomock.InOrder(
+			suite.bankKeeper.EXPECT().
+				SendCoins(gomock.Any(), bidder.Address, sdk.AccAddress{}, coins(40)).
+				Return(nil),
+			suite.bankKeeper.EXPECT().
+				BurnCoins(gomock.Any(), types.ModuleName, coins(40)).
+				Return(nil),
+			suite.distrKeeper.EXPECT().
+				FundCommunityPool(gomock.Any(), coins(30), bidder.Address).
+				Return(nil),
+			suite.bankKeeper.EXPECT().
+				SendCoins(gomock.Any(), bidder.Address, authtypes.NewModuleAddress(authtypes.FeeCollectorName), coins(10)).
+				Return(nil),
+			suite.bankKeeper.EXPECT().
+				SendCoins(gomock.Any(), bidder.Address, recipient.Address, coins(10)).
+				Return(nil),
+			// The last recipient receives the remainder of the price.
+			suite.bankKeeper.EXPECT().
+				SendCoins(gomock.Any(), bidder.Address, escrow.Address, coins(11)).
+				Return(nil),
+		)
+
+		_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, nil))
+		suite.Require().NoError(err)
+
+		results, err := suite.builderKeeper.GetAuctionResultsAtHeight(suite.ctx, uint64(suite.ctx.BlockHeight()))
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 1)
+		suite.Require().Empty(results[0].ProposerReward)
+		suite.Require().Equal(coins(11), results[0].EscrowReward)
+	})
+
+	suite.Run("community pool cannot be funded without a distribution keeper", func() {
+		setUp([]types.RevenueShare{
+			types.NewRevenueShare(types.RecipientCommunityPool, math.LegacyOneDec()),
+		})
+
+		suite.builderKeeper = suite.builderKeeper.WithDistributionKeeper(nil)
+		suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper)
+
+		_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, nil))
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *KeeperTestSuite) TestCurrentProposerRewards() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 4)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+	proposerCons := accounts[2]
+	proposerOperator := accounts[3]
+
+	proposer := stakingtypes.Validator{
+		OperatorAddress: sdk.ValAddress(proposerOperator.Address).String(),
+	}
+	proposerConsAddr := sdk.ConsAddress(proposerCons.ConsKey.PubKey().Address().Bytes())
+
+	bid := sdk.NewInt64Coin("stake", 100)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	suite.SetupTest()
+
+	params := types.DefaultParams()
+	params.EscrowAccountAddress = escrow.Address
+	params.ProposerFee = math.LegacyMustNewDecFromStr("0.5")
+	suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+	provider := rewards.NewCurrentProposerRewardsAddressProvider(
+		suite.bankKeeper,
+		suite.distrKeeper,
+		suite.stakingKeeper,
+		math.LegacyMustNewDecFromStr("0.2"),
+	)
+	suite.builderKeeper = suite.builderKeeper.WithRewardsAddressProvider(provider)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper)
+
+	suite.ctx = suite.ctx.WithBlockHeader(cmtproto.Header{ProposerAddress: proposerConsAddr})
+
+	suite.stakingKeeper.EXPECT().
+		GetValidatorByConsAddr(gomock.Any(), proposerConsAddr).
+		Return(proposer, nil).
+		AnyTimes()
+
+	gomock.InOrder(
+		// The delegator share of the proposer's reward is allocated via the distribution module.
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, authtypes.NewModuleAddress(distrtypes.ModuleName), coins(10)).
+			Return(nil),
+		suite.distrKeeper.EXPECT().
+			AllocateTokensToValidator(gomock.Any(), proposer, sdk.NewDecCoinsFromCoins(coins(10)...)).
+			Return(nil),
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, proposerOperator.Address, coins(40)).
+			Return(nil),
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, escrow.Address, coins(50)).
+			Return(nil),
+	)
+
+	_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, nil))
+	suite.Require().NoError(err)
+}
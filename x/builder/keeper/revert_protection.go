@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetPendingBundledTxs marks the bundled transactions of the winning bundle with the given
+// bundle index as pending. Each transaction is unmarked once it is executed successfully.
+func (k Keeper) SetPendingBundledTxs(ctx sdk.Context, bundleIndex uint32, transactions [][]byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, tx := range transactions {
+		hash := sha256.Sum256(tx)
+		store.Set(types.GetPendingBundledTxKey(hash[:]), binary.BigEndian.AppendUint32(nil, bundleIndex))
+	}
+}
+
+// MarkBundledTxExecuted unmarks the given transaction if it is a pending bundled transaction.
+func (k Keeper) MarkBundledTxExecuted(ctx sdk.Context, txBytes []byte) {
+	hash := sha256.Sum256(txBytes)
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingBundledTxKey(hash[:]))
+}
+
+// ProcessFailedBundles handles all winning bundles of the current block whose bundled
+// transactions did not all execute successfully. If revert protection is enabled, the bidder
+// is refunded the configured portion of the price paid for the bundle from the escrow
+// account's pending reward. Bundled transactions can fail because of the other transactions
+// in the block, so the bidders are not penalized. All pending bundled transactions are removed.
+func (k Keeper) ProcessFailedBundles(ctx sdk.Context) error {
+	// Group the hashes of the failed transactions by the bundle they belong to.
+	failedTxs := make(map[uint32][]string)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixPendingBundledTx)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		bundleIndex := binary.BigEndian.Uint32(iterator.Value())
+		txHash := iterator.Key()[len(types.KeyPrefixPendingBundledTx):]
+
+		failedTxs[bundleIndex] = append(failedTxs[bundleIndex], hex.EncodeToString(txHash))
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if len(failedTxs) == 0 {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.RevertProtectionEnabled() {
+		return nil
+	}
+
+	bundleIndices := make([]uint32, 0, len(failedTxs))
+	for bundleIndex := range failedTxs {
+		bundleIndices = append(bundleIndices, bundleIndex)
+	}
+	sort.Slice(bundleIndices, func(i, j int) bool { return bundleIndices[i] < bundleIndices[j] })
+
+	height := uint64(ctx.BlockHeight())
+	for _, bundleIndex := range bundleIndices {
+		result, err := k.GetAuctionResult(ctx, height, bundleIndex)
+		if err != nil {
+			return err
+		}
+
+		if err := k.refundBundle(ctx, params, result, failedTxs[bundleIndex]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refundBundle refunds the bidder of the given auction result. The refund never exceeds the
+// portion of the price that was paid to the escrow account and is only paid from the escrow
+// account's pending reward, which is held by the builder module account.
+func (k Keeper) refundBundle(ctx sdk.Context, params types.Params, result types.AuctionResult, failedTxs []string) error {
+	bidder, err := sdk.AccAddressFromBech32(result.Bidder)
+	if err != nil {
+		return err
+	}
+
+	price := result.Bid
+	if result.Price != nil {
+		price = *result.Price
+	}
+
+	refund, _ := sdk.NewDecCoinsFromCoins(price).MulDecTruncate(params.RevertProtectionRefund).TruncateDecimal()
+	if !refund.IsAllLTE(result.EscrowReward) {
+		k.Logger(ctx).Info(
+			"capping refund at the escrow reward of the bundle",
+			"bidder", result.Bidder,
+			"refund", refund,
+			"escrow_reward", result.EscrowReward,
+		)
+
+		refund = refund.Min(result.EscrowReward)
+	}
+
+	if refund.IsZero() {
+		return nil
+	}
+
+	claimed, err := k.claimPendingReward(ctx, params.EscrowAccountAddress, refund)
+	if err != nil {
+		return err
+	}
+
+	if remaining := refund.Sub(claimed...); !remaining.IsZero() {
+		k.Logger(ctx).Error(
+			"skipping refund that is not held by the builder module",
+			"bidder", result.Bidder,
+			"refund", refund,
+			"skipped", remaining,
+		)
+
+		refund = claimed
+	}
+
+	if refund.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, k.moduleAddress, bidder, refund); err != nil {
+		return err
+	}
+
+	result.Refund = refund
+	if err := k.SetAuctionResult(ctx, result); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundBid,
+			sdk.NewAttribute(types.EventAttrBidder, result.Bidder),
+			sdk.NewAttribute(types.EventAttrRefund, refund.String()),
+			sdk.NewAttribute(types.EventAttrFailedTxs, strings.Join(failedTxs, ",")),
+		),
+	)
+
+	return nil
+}
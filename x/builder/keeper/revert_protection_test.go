@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/post"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestRevertProtection() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+
+	bid := sdk.NewInt64Coin("stake", 100)
+	transactions := [][]byte{{0x01}, {0x02}}
+
+	setUp := func(refund math.LegacyDec) {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.EscrowAccountAddress = escrow.Address
+		params.RevertProtectionRefund = refund
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		suite.ctx = suite.ctx.WithBlockHeight(10).WithExecMode(sdk.ExecModeFinalize)
+
+		// The escrow account's reward is held by the builder module account until the end of
+		// the block when revert protection is enabled.
+		recipient := escrow.Address
+		if refund.IsPositive() {
+			recipient = sdk.AccAddress{}
+		}
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, recipient, sdk.NewCoins(bid)).
+			Return(nil)
+
+		_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, transactions))
+		suite.Require().NoError(err)
+	}
+
+	// execute runs the post handler for a successfully executed transaction.
+	execute := func(txBytes []byte) {
+		postHandler := sdk.ChainPostDecorators(post.NewRevertProtectionDecorator(suite.builderKeeper))
+		_, err := postHandler(suite.ctx.WithTxBytes(txBytes), nil, false, true)
+		suite.Require().NoError(err)
+	}
+
+	suite.Run("bidder is not refunded if all bundled transactions succeed", func() {
+		setUp(math.LegacyMustNewDecFromStr("0.5"))
+
+		execute(transactions[0])
+		execute(transactions[1])
+
+		suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+
+		result, err := suite.builderKeeper.GetAuctionResult(suite.ctx, 10, 0)
+		suite.Require().NoError(err)
+		suite.Require().Empty(result.Refund)
+
+		// The escrow account's reward is paid out at the end of the block.
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, escrow.Address, sdk.NewCoins(bid)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+	})
+
+	suite.Run("bidder is refunded if a bundled transaction fails", func() {
+		setUp(math.LegacyMustNewDecFromStr("0.5"))
+
+		execute(transactions[0])
+
+		refund := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, bidder.Address, refund).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+
+		result, err := suite.builderKeeper.GetAuctionResult(suite.ctx, 10, 0)
+		suite.Require().NoError(err)
+		suite.Require().Equal(refund, result.Refund)
+
+		// Pending bundled transactions are removed, so the bidder is refunded only once.
+		suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+
+		pending, err := suite.builderKeeper.GetPendingReward(suite.ctx, escrow.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewCoins(bid).Sub(refund...), pending)
+	})
+
+	suite.Run("refund is skipped if the escrow reward is not held by the module", func() {
+		setUp(math.LegacyMustNewDecFromStr("0.5"))
+
+		// The escrow account's pending reward was already paid out.
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, escrow.Address, sdk.NewCoins(bid)).
+			Return(nil)
+		suite.Require().NoError(suite.builderKeeper.PayoutPendingRewards(suite.ctx))
+
+		suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+
+		result, err := suite.builderKeeper.GetAuctionResult(suite.ctx, 10, 0)
+		suite.Require().NoError(err)
+		suite.Require().Empty(result.Refund)
+	})
+
+	suite.Run("transactions are not tracked when revert protection is disabled", func() {
+		setUp(math.LegacyZeroDec())
+
+		suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+
+		result, err := suite.builderKeeper.GetAuctionResult(suite.ctx, 10, 0)
+		suite.Require().NoError(err)
+		suite.Require().Empty(result.Refund)
+	})
+
+	suite.Run("transactions executed outside of finalize block are ignored", func() {
+		setUp(math.LegacyOneDec())
+
+		suite.ctx = suite.ctx.WithExecMode(sdk.ExecModeCheck)
+		execute(transactions[0])
+		execute(transactions[1])
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, bidder.Address, sdk.NewCoins(bid)).
+			Return(nil)
+
+		suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+	})
+}
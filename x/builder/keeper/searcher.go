@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetSearcher stores the registered searcher.
+func (k Keeper) SetSearcher(ctx sdk.Context, searcher types.Searcher) error {
+	address, err := sdk.AccAddressFromBech32(searcher.Address)
+	if err != nil {
+		return err
+	}
+
+	bz, err := searcher.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetSearcherKey(address), bz)
+
+	return nil
+}
+
+// GetSearcher returns the registered searcher with the given address.
+func (k Keeper) GetSearcher(ctx sdk.Context, address sdk.AccAddress) (types.Searcher, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSearcherKey(address))
+	if len(bz) == 0 {
+		return types.Searcher{}, fmt.Errorf("searcher %s is not registered", address)
+	}
+
+	searcher := types.Searcher{}
+	if err := searcher.Unmarshal(bz); err != nil {
+		return types.Searcher{}, err
+	}
+
+	return searcher, nil
+}
+
+// GetAllSearchers returns all of the registered searchers.
+func (k Keeper) GetAllSearchers(ctx sdk.Context) ([]types.Searcher, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSearcher)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	searchers := make([]types.Searcher, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		searcher := types.Searcher{}
+		if err := searcher.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		searchers = append(searchers, searcher)
+	}
+
+	return searchers, nil
+}
+
+// ValidateSearcher validates that the bidder is a registered searcher with at least the
+// minimum searcher collateral bonded if searcher registration is required.
+func (k Keeper) ValidateSearcher(ctx sdk.Context, params types.Params, bidder sdk.AccAddress) error {
+	if !params.RequireSearcherRegistration {
+		return nil
+	}
+
+	searcher, err := k.GetSearcher(ctx, bidder)
+	if err != nil {
+		return err
+	}
+
+	minCollateral := params.MinSearcherCollateral
+	if searcher.Collateral.Denom != minCollateral.Denom || !searcher.Collateral.IsGTE(minCollateral) {
+		return fmt.Errorf(
+			"searcher collateral (%s) is less than the minimum searcher collateral (%s)",
+			searcher.Collateral,
+			minCollateral,
+		)
+	}
+
+	return nil
+}
+
+// UnbondSearcher moves the given amount of the searcher's bonded collateral to its
+// unbonding collateral. The unbonding collateral can be withdrawn once the searcher unbonding
+// period has passed since the most recent unbonding. It returns the updated searcher.
+func (k Keeper) UnbondSearcher(
+	ctx sdk.Context,
+	params types.Params,
+	address sdk.AccAddress,
+	amount sdk.Coin,
+) (types.Searcher, error) {
+	searcher, err := k.GetSearcher(ctx, address)
+	if err != nil {
+		return types.Searcher{}, err
+	}
+
+	if searcher.Collateral.Denom != amount.Denom || searcher.Collateral.IsLT(amount) {
+		return types.Searcher{}, fmt.Errorf(
+			"unbonding amount (%s) exceeds the bonded collateral (%s)",
+			amount,
+			searcher.Collateral,
+		)
+	}
+
+	searcher.Collateral = searcher.Collateral.Sub(amount)
+	searcher.Unbonding = searcher.Unbonding.Add(amount)
+	searcher.UnbondingCompletionHeight = uint64(ctx.BlockHeight()) + params.SearcherUnbondingPeriod
+
+	if err := k.SetSearcher(ctx, searcher); err != nil {
+		return types.Searcher{}, err
+	}
+
+	return searcher, nil
+}
+
+// WithdrawUnbondedCollateral sends the unbonding collateral of the searcher back to the
+// searcher once the unbonding period has passed. It returns the amount that was withdrawn.
+func (k Keeper) WithdrawUnbondedCollateral(ctx sdk.Context, address sdk.AccAddress) (sdk.Coins, error) {
+	searcher, err := k.GetSearcher(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if searcher.Unbonding.IsZero() {
+		return nil, fmt.Errorf("searcher %s has no unbonding collateral", searcher.Address)
+	}
+
+	if height := uint64(ctx.BlockHeight()); height < searcher.UnbondingCompletionHeight {
+		return nil, fmt.Errorf(
+			"unbonding collateral cannot be withdrawn before height %d (current block height: %d)",
+			searcher.UnbondingCompletionHeight,
+			height,
+		)
+	}
+
+	amount := searcher.Unbonding
+	if err := k.bankKeeper.SendCoins(ctx, k.moduleAddress, address, amount); err != nil {
+		return nil, err
+	}
+
+	searcher.Unbonding = sdk.NewCoins()
+	searcher.UnbondingCompletionHeight = 0
+
+	if err := k.SetSearcher(ctx, searcher); err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
+
+// recordBidWon increments the number of winning bids of the bidder if it is a registered
+// searcher.
+func (k Keeper) recordBidWon(ctx sdk.Context, bidder sdk.AccAddress) error {
+	searcher, err := k.GetSearcher(ctx, bidder)
+	if err != nil {
+		return nil
+	}
+
+	searcher.BidsWon++
+	return k.SetSearcher(ctx, searcher)
+}
+
+// RecordFailedBids records a failed bundle for the bidders of all of the bids that failed
+// verification when the auction of the given auction info was run. The failed bids are
+// verified by all validators when the block proposal is processed, but a bid can fail because
+// of the transactions that the proposer or other validators include, e.g. a replayed bid, so
+// the bidders are not slashed. Bids that cannot be decoded or whose bidder is not a registered
+// searcher are ignored.
+func (k Keeper) RecordFailedBids(ctx sdk.Context, info types.AuctionInfo) error {
+	if k.txDecoder == nil || len(info.FailedBidTxHashes) == 0 {
+		return nil
+	}
+
+	bidTxs := make(map[string][]byte, len(info.BidTxs))
+	for _, bidTxBz := range info.BidTxs {
+		hash := sha256.Sum256(bidTxBz)
+		bidTxs[string(hash[:])] = bidTxBz
+	}
+
+	for _, failedBidTxHash := range info.FailedBidTxHashes {
+		bidTx, err := k.txDecoder(bidTxs[string(failedBidTxHash)])
+		if err != nil {
+			continue
+		}
+
+		bidder, ok := getBidder(bidTx)
+		if !ok {
+			continue
+		}
+
+		if err := k.recordBundleFailed(ctx, bidder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getBidder returns the bidder of the given bid transaction.
+func getBidder(bidTx sdk.Tx) (sdk.AccAddress, bool) {
+	for _, msg := range bidTx.GetMsgs() {
+		var bidder string
+		switch msg := msg.(type) {
+		case *types.MsgAuctionBid:
+			bidder = msg.Bidder
+		case *types.MsgRevealBid:
+			bidder = msg.Bidder
+		default:
+			continue
+		}
+
+		address, err := sdk.AccAddressFromBech32(bidder)
+		return address, err == nil
+	}
+
+	return nil, false
+}
+
+// recordBundleFailed increments the number of failed bundles of the bidder if it is a
+// registered searcher.
+func (k Keeper) recordBundleFailed(ctx sdk.Context, bidder sdk.AccAddress) error {
+	searcher, err := k.GetSearcher(ctx, bidder)
+	if err != nil {
+		return nil
+	}
+
+	searcher.BundlesFailed++
+	return k.SetSearcher(ctx, searcher)
+}
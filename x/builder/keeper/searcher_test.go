@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgRegisterSearcher() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	searcher := testutils.RandomAccounts(rng, 1)[0]
+
+	collateral := sdk.NewInt64Coin("stake", 100)
+
+	suite.Run("searcher is registered with the bonded collateral", func() {
+		suite.SetupTest()
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), searcher.Address, sdk.AccAddress{}, sdk.NewCoins(collateral)).
+			Return(nil).
+			Times(2)
+
+		_, err := suite.msgServer.RegisterSearcher(suite.ctx, types.NewMsgRegisterSearcher(searcher.Address, collateral))
+		suite.Require().NoError(err)
+
+		registered, err := suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.NewSearcher(searcher.Address, collateral), registered)
+
+		// Registering again adds to the bonded collateral.
+		_, err = suite.msgServer.RegisterSearcher(suite.ctx, types.NewMsgRegisterSearcher(searcher.Address, collateral))
+		suite.Require().NoError(err)
+
+		registered, err = suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(collateral.Add(collateral), registered.Collateral)
+	})
+
+	suite.Run("collateral must be in the minimum searcher collateral denom", func() {
+		suite.SetupTest()
+
+		_, err := suite.msgServer.RegisterSearcher(suite.ctx, types.NewMsgRegisterSearcher(searcher.Address, sdk.NewInt64Coin("atom", 100)))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("searcher can bond collateral in a new denom once the old collateral is unbonded", func() {
+		suite.SetupTest()
+
+		old := sdk.NewInt64Coin("atom", 100)
+		suite.Require().NoError(suite.builderKeeper.SetSearcher(suite.ctx, types.NewSearcher(searcher.Address, old)))
+
+		// The bonded collateral is in the old denom.
+		_, err := suite.msgServer.RegisterSearcher(suite.ctx, types.NewMsgRegisterSearcher(searcher.Address, collateral))
+		suite.Require().Error(err)
+
+		_, err = suite.msgServer.UnbondSearcherCollateral(suite.ctx, types.NewMsgUnbondSearcherCollateral(searcher.Address, old))
+		suite.Require().NoError(err)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), searcher.Address, sdk.AccAddress{}, sdk.NewCoins(collateral)).
+			Return(nil)
+
+		_, err = suite.msgServer.RegisterSearcher(suite.ctx, types.NewMsgRegisterSearcher(searcher.Address, collateral))
+		suite.Require().NoError(err)
+
+		registered, err := suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(collateral, registered.Collateral)
+		suite.Require().Equal(sdk.NewCoins(old), registered.Unbonding)
+	})
+}
+
+func (suite *KeeperTestSuite) TestSearcherUnbonding() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	searcher := accounts[0]
+	escrow := accounts[1]
+
+	collateral := sdk.NewInt64Coin("stake", 1000)
+	unbonding := sdk.NewInt64Coin("stake", 400)
+
+	setup := func() {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.EscrowAccountAddress = escrow.Address
+		params.SearcherUnbondingPeriod = 10
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+		suite.Require().NoError(suite.builderKeeper.SetSearcher(suite.ctx, types.NewSearcher(searcher.Address, collateral)))
+
+		suite.ctx = suite.ctx.WithBlockHeight(5)
+	}
+
+	suite.Run("unbonded collateral can be withdrawn after the unbonding period", func() {
+		setup()
+
+		resp, err := suite.msgServer.UnbondSearcherCollateral(suite.ctx, types.NewMsgUnbondSearcherCollateral(searcher.Address, unbonding))
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(15), resp.CompletionHeight)
+
+		registered, err := suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(collateral.Sub(unbonding), registered.Collateral)
+		suite.Require().Equal(sdk.NewCoins(unbonding), registered.Unbonding)
+
+		// The collateral cannot be withdrawn before the unbonding period has passed.
+		_, err = suite.msgServer.WithdrawSearcherCollateral(suite.ctx.WithBlockHeight(14), types.NewMsgWithdrawSearcherCollateral(searcher.Address))
+		suite.Require().Error(err)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, searcher.Address, sdk.NewCoins(unbonding)).
+			Return(nil)
+
+		withdrawn, err := suite.msgServer.WithdrawSearcherCollateral(suite.ctx.WithBlockHeight(15), types.NewMsgWithdrawSearcherCollateral(searcher.Address))
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewCoins(unbonding), withdrawn.Amount)
+
+		registered, err = suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+		suite.Require().NoError(err)
+		suite.Require().True(registered.Unbonding.IsZero())
+
+		// There is nothing left to withdraw.
+		_, err = suite.msgServer.WithdrawSearcherCollateral(suite.ctx.WithBlockHeight(15), types.NewMsgWithdrawSearcherCollateral(searcher.Address))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("cannot unbond more than the bonded collateral", func() {
+		setup()
+
+		_, err := suite.msgServer.UnbondSearcherCollateral(suite.ctx, types.NewMsgUnbondSearcherCollateral(searcher.Address, collateral.Add(collateral)))
+		suite.Require().Error(err)
+
+		_, err = suite.msgServer.UnbondSearcherCollateral(suite.ctx, types.NewMsgUnbondSearcherCollateral(searcher.Address, sdk.NewInt64Coin("atom", 1)))
+		suite.Require().Error(err)
+	})
+}
+
+func (suite *KeeperTestSuite) TestRecordFailedBids() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	searcher := accounts[0]
+	other := accounts[1]
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.builderKeeper.SetSearcher(suite.ctx, types.NewSearcher(searcher.Address, sdk.NewInt64Coin("stake", 100))))
+
+	// Bids of bidders that are not registered searchers are ignored.
+	info := suite.failedBidAuctionInfo(searcher)
+	otherInfo := suite.failedBidAuctionInfo(other)
+	suite.Require().NoError(suite.builderKeeper.RecordFailedBids(suite.ctx, otherInfo))
+
+	// Bids that did not fail are not recorded.
+	info.FailedBidTxHashes = nil
+	suite.Require().NoError(suite.builderKeeper.RecordFailedBids(suite.ctx, info))
+
+	registered, err := suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+	suite.Require().NoError(err)
+	suite.Require().Zero(registered.BundlesFailed)
+
+	// The failed bid is recorded, but the searcher is not slashed.
+	params := types.DefaultParams()
+	params.RequireSearcherRegistration = true
+	params.SearcherUnbondingPeriod = 10
+	suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(suite.builderKeeper.RecordFailedBids(suite.ctx, suite.failedBidAuctionInfo(searcher)))
+
+	registered, err = suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), registered.BundlesFailed)
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 100), registered.Collateral)
+}
+
+// failedBidAuctionInfo returns an auction info with a single bid of the given bidder that failed
+// verification. The keeper is configured to decode the bid.
+func (suite *KeeperTestSuite) failedBidAuctionInfo(bidder testutils.Account) types.AuctionInfo {
+	suite.builderKeeper = suite.builderKeeper.WithTxDecoder(suite.encCfg.TxConfig.TxDecoder())
+	suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper)
+
+	bidTx, _, err := testutils.CreateAuctionTx(suite.encCfg.TxConfig, bidder, sdk.NewInt64Coin("stake", 100), 0, 10, nil)
+	suite.Require().NoError(err)
+
+	bidTxBz, err := suite.encCfg.TxConfig.TxEncoder()(bidTx)
+	suite.Require().NoError(err)
+
+	hash := sha256.Sum256(bidTxBz)
+	return types.AuctionInfo{
+		BidTxs:            [][]byte{bidTxBz},
+		MaxTxBytes:        1000,
+		FailedBidTxHashes: [][]byte{hash[:]},
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidateSearcher() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	searcher := testutils.RandomAccounts(rng, 1)[0]
+
+	minCollateral := sdk.NewInt64Coin("stake", 100)
+
+	testCases := []struct {
+		name       string
+		required   bool
+		collateral *sdk.Coin
+		pass       bool
+	}{
+		{
+			"unregistered bidder is valid if registration is not required",
+			false,
+			nil,
+			true,
+		},
+		{
+			"unregistered bidder is invalid if registration is required",
+			true,
+			nil,
+			false,
+		},
+		{
+			"searcher with insufficient collateral is invalid",
+			true,
+			&sdk.Coin{Denom: "stake", Amount: math.NewInt(99)},
+			false,
+		},
+		{
+			"searcher with sufficient collateral is valid",
+			true,
+			&minCollateral,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := types.DefaultParams()
+			params.RequireSearcherRegistration = tc.required
+			params.MinSearcherCollateral = minCollateral
+
+			if tc.collateral != nil {
+				suite.Require().NoError(suite.builderKeeper.SetSearcher(suite.ctx, types.NewSearcher(searcher.Address, *tc.collateral)))
+			}
+
+			err := suite.builderKeeper.ValidateSearcher(suite.ctx, params, searcher.Address)
+			if tc.pass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSearcherStats() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 2)
+
+	searcher := accounts[0]
+	escrow := accounts[1]
+
+	bid := sdk.NewInt64Coin("stake", 100)
+	collateral := sdk.NewInt64Coin("stake", 1000)
+	transactions := [][]byte{{0x01}, {0x02}}
+
+	suite.SetupTest()
+
+	params := types.DefaultParams()
+	params.EscrowAccountAddress = escrow.Address
+	params.RequireSearcherRegistration = true
+	params.MinSearcherCollateral = sdk.NewInt64Coin("stake", 500)
+	params.SearcherUnbondingPeriod = 10
+	suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(suite.builderKeeper.SetSearcher(suite.ctx, types.NewSearcher(searcher.Address, collateral)))
+
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithExecMode(sdk.ExecModeFinalize)
+
+	suite.bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), searcher.Address, escrow.Address, sdk.NewCoins(bid)).
+		Return(nil)
+
+	_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(searcher.Address, bid, transactions))
+	suite.Require().NoError(err)
+
+	// Only the first bundled transaction is executed successfully. The bundle may have failed
+	// because of the other transactions in the block, so the searcher is not penalized.
+	suite.builderKeeper.MarkBundledTxExecuted(suite.ctx, transactions[0])
+	suite.Require().NoError(suite.builderKeeper.ProcessFailedBundles(suite.ctx))
+
+	registered, err := suite.builderKeeper.GetSearcher(suite.ctx, searcher.Address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), registered.BidsWon)
+	suite.Require().Zero(registered.BundlesFailed)
+	suite.Require().Equal(collateral, registered.Collateral)
+}
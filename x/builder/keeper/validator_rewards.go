@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// SetValidatorRewards stores the proposer rewards accrued by the validator. Zero rewards are
+// removed.
+func (k Keeper) SetValidatorRewards(ctx sdk.Context, rewards types.ValidatorRewards) error {
+	validator, err := sdk.ValAddressFromBech32(rewards.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if rewards.Rewards.IsZero() {
+		store.Delete(types.GetValidatorRewardsKey(validator))
+		return nil
+	}
+
+	bz, err := rewards.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetValidatorRewardsKey(validator), bz)
+
+	return nil
+}
+
+// GetValidatorRewards returns the proposer rewards accrued by the validator that have not been
+// withdrawn yet.
+func (k Keeper) GetValidatorRewards(ctx sdk.Context, validator sdk.ValAddress) (sdk.Coins, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorRewardsKey(validator))
+	if len(bz) == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	rewards := types.ValidatorRewards{}
+	if err := rewards.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return rewards.Rewards, nil
+}
+
+// GetAllValidatorRewards returns the proposer rewards accrued by all validators.
+func (k Keeper) GetAllValidatorRewards(ctx sdk.Context) ([]types.ValidatorRewards, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorRewards)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allRewards := make([]types.ValidatorRewards, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		rewards := types.ValidatorRewards{}
+		if err := rewards.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		allRewards = append(allRewards, rewards)
+	}
+
+	return allRewards, nil
+}
+
+// SetRewardsWithdrawAddress stores the address that the validator withdraws its proposer
+// rewards to.
+func (k Keeper) SetRewardsWithdrawAddress(ctx sdk.Context, withdrawAddress types.RewardsWithdrawAddress) error {
+	validator, err := sdk.ValAddressFromBech32(withdrawAddress.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(withdrawAddress.WithdrawAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetRewardsWithdrawAddressKey(validator), recipient)
+
+	return nil
+}
+
+// GetRewardsWithdrawAddress returns the address that the validator withdraws its proposer
+// rewards to. It defaults to the account of the validator's operator.
+func (k Keeper) GetRewardsWithdrawAddress(ctx sdk.Context, validator sdk.ValAddress) sdk.AccAddress {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRewardsWithdrawAddressKey(validator))
+	if len(bz) == 0 {
+		return sdk.AccAddress(validator)
+	}
+
+	return sdk.AccAddress(bz)
+}
+
+// GetAllRewardsWithdrawAddresses returns the withdraw addresses set by all validators.
+func (k Keeper) GetAllRewardsWithdrawAddresses(ctx sdk.Context) ([]types.RewardsWithdrawAddress, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardsWithdrawAddress)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	withdrawAddresses := make([]types.RewardsWithdrawAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// The key is the length-prefixed validator address.
+		validator := sdk.ValAddress(iterator.Key()[1:])
+
+		withdrawAddresses = append(
+			withdrawAddresses,
+			types.NewRewardsWithdrawAddress(validator, sdk.AccAddress(iterator.Value())),
+		)
+	}
+
+	return withdrawAddresses, nil
+}
+
+// WithdrawValidatorRewards sends all of the proposer rewards accrued by the validator from the
+// builder module account to the validator's withdraw address and returns the withdrawn amount.
+func (k Keeper) WithdrawValidatorRewards(ctx sdk.Context, validator sdk.ValAddress) (sdk.Coins, error) {
+	rewards, err := k.GetValidatorRewards(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if rewards.IsZero() {
+		return nil, fmt.Errorf("no rewards to withdraw for validator %s", validator)
+	}
+
+	withdrawAddress := k.GetRewardsWithdrawAddress(ctx, validator)
+	if err := k.bankKeeper.SendCoins(ctx, k.moduleAddress, withdrawAddress, rewards); err != nil {
+		return nil, err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetValidatorRewardsKey(validator))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(types.EventAttrValidator, validator.String()),
+			sdk.NewAttribute(types.EventAttrWithdrawAddr, withdrawAddress.String()),
+			sdk.NewAttribute(types.EventAttrAmount, rewards.String()),
+		),
+	)
+
+	return rewards, nil
+}
+
+// accrueValidatorRewards sends the proposer reward from the sender to the builder module
+// account, unless the module account already collected it, and accrues it for the validator
+// until it is withdrawn.
+func (k Keeper) accrueValidatorRewards(ctx sdk.Context, sender sdk.AccAddress, validator sdk.ValAddress, reward sdk.Coins) error {
+	if reward.IsZero() {
+		return nil
+	}
+
+	if !sender.Equals(k.moduleAddress) {
+		if err := k.bankKeeper.SendCoins(ctx, sender, k.moduleAddress, reward); err != nil {
+			return err
+		}
+	}
+
+	rewards, err := k.GetValidatorRewards(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetValidatorRewards(ctx, types.NewValidatorRewards(validator, rewards.Add(reward...))); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccrueRewards,
+			sdk.NewAttribute(types.EventAttrValidator, validator.String()),
+			sdk.NewAttribute(types.EventAttrAmount, reward.String()),
+		),
+	)
+
+	return nil
+}
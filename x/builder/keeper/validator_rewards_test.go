@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	testutils "github.com/skip-mev/pob/testutils"
+	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/rewards"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimableValidatorRewards() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	accounts := testutils.RandomAccounts(rng, 4)
+
+	bidder := accounts[0]
+	escrow := accounts[1]
+	operator := accounts[2]
+	recipient := accounts[3]
+
+	validator := sdk.ValAddress(operator.Address)
+	proposerConsAddr := sdk.ConsAddress(operator.ConsKey.PubKey().Address().Bytes())
+
+	bid := sdk.NewInt64Coin("stake", 100)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	setUp := func() {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.EscrowAccountAddress = escrow.Address
+		params.ProposerFee = math.LegacyMustNewDecFromStr("0.4")
+		params.ClaimableProposerRewards = true
+		suite.Require().NoError(suite.builderKeeper.SetParams(suite.ctx, params))
+
+		suite.builderKeeper = suite.builderKeeper.WithRewardsAddressProvider(rewards.NewProposerRewardsAddressProvider(suite.distrKeeper, suite.stakingKeeper))
+		suite.msgServer = keeper.NewMsgServerImpl(suite.builderKeeper)
+
+		suite.distrKeeper.EXPECT().
+			GetPreviousProposerConsAddr(gomock.Any()).
+			Return(proposerConsAddr, nil).
+			AnyTimes()
+		suite.stakingKeeper.EXPECT().
+			GetValidatorByConsAddr(gomock.Any(), proposerConsAddr).
+			Return(stakingtypes.Validator{OperatorAddress: validator.String()}, nil).
+			AnyTimes()
+
+		// The price is collected by the builder module account, which holds the proposer's
+		// reward and pays out the escrow account's reward.
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), bidder.Address, sdk.AccAddress{}, coins(100)).
+			Return(nil)
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, escrow.Address, coins(60)).
+			Return(nil)
+
+		_, err := suite.msgServer.AuctionBid(suite.ctx, types.NewMsgAuctionBid(bidder.Address, bid, nil))
+		suite.Require().NoError(err)
+	}
+
+	suite.Run("proposer rewards are accrued for the validator", func() {
+		setUp()
+
+		rewards, err := suite.builderKeeper.GetValidatorRewards(suite.ctx, validator)
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(40), rewards)
+	})
+
+	suite.Run("validator withdraws rewards to the operator account by default", func() {
+		setUp()
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, operator.Address, coins(40)).
+			Return(nil)
+
+		resp, err := suite.msgServer.WithdrawBuilderRewards(suite.ctx, types.NewMsgWithdrawBuilderRewards(operator.Address, validator))
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(40), resp.Amount)
+
+		rewards, err := suite.builderKeeper.GetValidatorRewards(suite.ctx, validator)
+		suite.Require().NoError(err)
+		suite.Require().True(rewards.IsZero())
+
+		// Rewards cannot be withdrawn twice.
+		_, err = suite.msgServer.WithdrawBuilderRewards(suite.ctx, types.NewMsgWithdrawBuilderRewards(operator.Address, validator))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("validator withdraws rewards to its withdraw address", func() {
+		setUp()
+
+		msg := types.NewMsgSetBuilderRewardsWithdrawAddress(validator, recipient.Address)
+		_, err := suite.msgServer.SetBuilderRewardsWithdrawAddress(suite.ctx, msg)
+		suite.Require().NoError(err)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, recipient.Address, coins(40)).
+			Return(nil)
+
+		resp, err := suite.msgServer.WithdrawBuilderRewards(suite.ctx, types.NewMsgWithdrawBuilderRewards(operator.Address, validator))
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(40), resp.Amount)
+	})
+
+	suite.Run("any account can withdraw rewards to the withdraw address", func() {
+		setUp()
+
+		msg := types.NewMsgSetBuilderRewardsWithdrawAddress(validator, recipient.Address)
+		_, err := suite.msgServer.SetBuilderRewardsWithdrawAddress(suite.ctx, msg)
+		suite.Require().NoError(err)
+
+		suite.bankKeeper.EXPECT().
+			SendCoins(gomock.Any(), sdk.AccAddress{}, recipient.Address, coins(40)).
+			Return(nil)
+
+		resp, err := suite.msgServer.WithdrawBuilderRewards(suite.ctx, types.NewMsgWithdrawBuilderRewards(recipient.Address, validator))
+		suite.Require().NoError(err)
+		suite.Require().Equal(coins(40), resp.Amount)
+	})
+
+	suite.Run("validator rewards and withdraw addresses are exported", func() {
+		setUp()
+
+		msg := types.NewMsgSetBuilderRewardsWithdrawAddress(validator, recipient.Address)
+		_, err := suite.msgServer.SetBuilderRewardsWithdrawAddress(suite.ctx, msg)
+		suite.Require().NoError(err)
+
+		gs := suite.builderKeeper.ExportGenesis(suite.ctx)
+		suite.Require().Equal([]types.ValidatorRewards{types.NewValidatorRewards(validator, coins(40))}, gs.ValidatorRewards)
+		suite.Require().Equal(
+			[]types.RewardsWithdrawAddress{types.NewRewardsWithdrawAddress(validator, recipient.Address)},
+			gs.WithdrawAddresses,
+		)
+		suite.Require().NoError(gs.Validate())
+	})
+
+	suite.Run("claimable proposer rewards require a validator rewards address provider", func() {
+		suite.SetupTest()
+
+		params := types.DefaultParams()
+		params.ClaimableProposerRewards = true
+		suite.Require().NoError(suite.builderKeeper.ValidateParams(params))
+
+		builderKeeper := suite.builderKeeper.WithRewardsAddressProvider(rewards.NewFixedAddressRewardsAddressProvider(operator.Address))
+		suite.Require().Error(builderKeeper.ValidateParams(params))
+	})
+}
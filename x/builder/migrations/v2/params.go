@@ -0,0 +1,29 @@
+package v2
+
+import (
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// MigrateParams migrates the x/builder params from consensus version 1 to 2. Version 1 params
+// only define the fields up to and including the proposer fee, so all of the params that were
+// added since are unset and decode to their zero values, some of which are invalid, e.g. a
+// maximum of zero bundles per block. They are set to their defaults instead.
+func MigrateParams(params types.Params) types.Params {
+	defaults := types.DefaultParams()
+
+	params.AuctionResultRetention = defaults.AuctionResultRetention
+	params.BidDenoms = defaults.BidDenoms
+	params.MaxBundlesPerBlock = defaults.MaxBundlesPerBlock
+	params.RevealWindow = defaults.RevealWindow
+	params.MinCommitmentCollateral = defaults.MinCommitmentCollateral
+	params.PricingRule = defaults.PricingRule
+	params.RevertProtectionRefund = defaults.RevertProtectionRefund
+	params.RevenueSplit = defaults.RevenueSplit
+	params.RequireSearcherRegistration = defaults.RequireSearcherRegistration
+	params.MinSearcherCollateral = defaults.MinSearcherCollateral
+	params.RewardPayoutInterval = defaults.RewardPayoutInterval
+	params.ClaimableProposerRewards = defaults.ClaimableProposerRewards
+	params.SearcherUnbondingPeriod = defaults.SearcherUnbondingPeriod
+
+	return params
+}
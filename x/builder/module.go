@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -21,6 +22,7 @@ import (
 	modulev1 "github.com/skip-mev/pob/api/pob/builder/module/v1"
 	"github.com/skip-mev/pob/x/builder/client/cli"
 	"github.com/skip-mev/pob/x/builder/keeper"
+	"github.com/skip-mev/pob/x/builder/rewards"
 	"github.com/skip-mev/pob/x/builder/types"
 	"github.com/spf13/cobra"
 )
@@ -31,7 +33,7 @@ var (
 )
 
 // ConsensusVersion defines the current x/builder module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 // AppModuleBasic defines the basic application module used by the builder module.
 type AppModuleBasic struct {
@@ -91,7 +93,10 @@ type AppModule struct {
 	keeper keeper.Keeper
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // NewAppModule creates a new AppModule object.
 func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
@@ -115,6 +120,11 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 func (am AppModule) RegisterServices(cfc module.Configurator) {
 	types.RegisterMsgServer(cfc.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 func (a AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
@@ -122,7 +132,32 @@ func (a AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
 // RegisterInvariants registers the invariants of the module. If an invariant
 // deviates from its predicted value, the InvariantRegistry triggers appropriate
 // logic (most often the chain will be halted).
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+// EndBlock refunds the bidders of winning bundles whose transactions failed, prunes the auction
+// results that fall outside of the retention window, pays out the accrued auction proceeds,
+// slashes the collateral of sealed bid commitments that were not revealed in time and removes
+// the auction settlement of the block.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	am.keeper.DeleteAuctionSettlement(ctx)
+
+	if err := am.keeper.ProcessFailedBundles(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.PruneAuctionResults(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.PayoutPendingRewards(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.SlashExpiredBidCommitments(ctx)
+}
 
 // InitGenesis performs the module's genesis initialization for the builder
 // module. It returns no validator updates.
@@ -159,6 +194,16 @@ type Inputs struct {
 	BankKeeper         types.BankKeeper
 	DistributionKeeper types.DistributionKeeper
 	StakingKeeper      types.StakingKeeper
+
+	// PriceConverter is optional and is used to rank bids that are denominated in different denoms.
+	PriceConverter types.PriceConverter `optional:"true"`
+
+	// TxConfig is optional and is used to decode the bid transactions of censorship reports.
+	TxConfig client.TxConfig `optional:"true"`
+
+	// BundleExecutor is optional and is used to execute the bundled transactions of bundles that
+	// are submitted without a bid. Bundles are rejected if it is not provided.
+	BundleExecutor types.BundleExecutor `optional:"true"`
 }
 
 type Outputs struct {
@@ -185,6 +230,41 @@ func ProvideModule(in Inputs) Outputs {
 		authority.String(),
 	)
 
+	// Reward the proposer of the current block, and distribute a share of its reward to its
+	// delegators, if configured.
+	if in.Config.RewardCurrentProposer || in.Config.DelegatorRewardShare != "" {
+		delegatorShare := math.LegacyZeroDec()
+		if in.Config.DelegatorRewardShare != "" {
+			share, err := math.LegacyNewDecFromStr(in.Config.DelegatorRewardShare)
+			if err != nil {
+				panic(fmt.Errorf("invalid delegator reward share: %w", err))
+			}
+
+			delegatorShare = share
+		}
+
+		builderKeeper = builderKeeper.WithRewardsAddressProvider(
+			rewards.NewCurrentProposerRewardsAddressProvider(
+				in.BankKeeper,
+				in.DistributionKeeper,
+				in.StakingKeeper,
+				delegatorShare,
+			),
+		)
+	}
+
+	if in.PriceConverter != nil {
+		builderKeeper = builderKeeper.WithPriceConverter(in.PriceConverter)
+	}
+
+	if in.TxConfig != nil {
+		builderKeeper = builderKeeper.WithTxDecoder(in.TxConfig.TxDecoder())
+	}
+
+	if in.BundleExecutor != nil {
+		builderKeeper = builderKeeper.WithBundleExecutor(in.BundleExecutor)
+	}
+
 	m := NewAppModule(in.Cdc, builderKeeper)
 
 	return Outputs{BuilderKeeper: builderKeeper, Module: m}
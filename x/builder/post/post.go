@@ -0,0 +1,33 @@
+package post
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/x/builder/keeper"
+)
+
+var _ sdk.PostDecorator = RevertProtectionDecorator{}
+
+// RevertProtectionDecorator is a PostDecorator that records the successful execution of the
+// bundled transactions of winning bundles. The bidders of winning bundles whose transactions
+// were not all executed successfully are refunded at the end of the block if revert protection
+// is enabled.
+type RevertProtectionDecorator struct {
+	builderKeeper keeper.Keeper
+}
+
+func NewRevertProtectionDecorator(builderKeeper keeper.Keeper) RevertProtectionDecorator {
+	return RevertProtectionDecorator{
+		builderKeeper: builderKeeper,
+	}
+}
+
+// PostHandle marks the transaction as executed if it was executed successfully while the
+// block is being finalized. Post handlers are only executed for successful transactions, so
+// bundled transactions that fail remain pending.
+func (d RevertProtectionDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if success && !simulate && ctx.ExecMode() == sdk.ExecModeFinalize {
+		d.builderKeeper.MarkBundledTxExecuted(ctx, ctx.TxBytes())
+	}
+
+	return next(ctx, tx, simulate, success)
+}
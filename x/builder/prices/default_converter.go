@@ -0,0 +1,31 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+var _ types.PriceConverter = (*DefaultPriceConverter)(nil)
+
+// DefaultPriceConverter values one unit of every denomination equally. It is
+// used by chains that only accept bids in a single denomination.
+type DefaultPriceConverter struct{}
+
+// NewDefaultPriceConverter creates a price converter that does not apply any
+// conversion to the bid amount.
+func NewDefaultPriceConverter() *DefaultPriceConverter {
+	return &DefaultPriceConverter{}
+}
+
+func (c *DefaultPriceConverter) ConvertBid(_ context.Context, bid sdk.Coin) (math.LegacyDec, error) {
+	if bid.IsNil() {
+		return math.LegacyDec{}, fmt.Errorf("bid cannot be nil")
+	}
+
+	return math.LegacyNewDecFromInt(bid.Amount), nil
+}
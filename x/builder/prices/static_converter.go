@@ -0,0 +1,40 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+var _ types.PriceConverter = (*StaticPriceConverter)(nil)
+
+// StaticPriceConverter converts bids using a fixed price per denomination. The
+// price of a denomination is the value of one unit of that denomination in the
+// common unit of account.
+type StaticPriceConverter struct {
+	prices map[string]math.LegacyDec
+}
+
+// NewStaticPriceConverter creates a price converter with a fixed set of prices.
+func NewStaticPriceConverter(prices map[string]math.LegacyDec) *StaticPriceConverter {
+	return &StaticPriceConverter{
+		prices: prices,
+	}
+}
+
+func (c *StaticPriceConverter) ConvertBid(_ context.Context, bid sdk.Coin) (math.LegacyDec, error) {
+	if bid.IsNil() {
+		return math.LegacyDec{}, fmt.Errorf("bid cannot be nil")
+	}
+
+	price, ok := c.prices[bid.Denom]
+	if !ok || price.IsNil() {
+		return math.LegacyDec{}, fmt.Errorf("no price found for denom %s", bid.Denom)
+	}
+
+	return price.MulInt(bid.Amount), nil
+}
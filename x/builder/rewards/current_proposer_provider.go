@@ -0,0 +1,109 @@
+package rewards
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+var (
+	_ types.RewardsDistributor              = (*CurrentProposerRewardsAddressProvider)(nil)
+	_ types.ValidatorRewardsAddressProvider = (*CurrentProposerRewardsAddressProvider)(nil)
+)
+
+// CurrentProposerRewardsAddressProvider provides a portion of auction profits to the
+// proposer of the block that includes the winning bid. Optionally, a share of the
+// rewards is distributed to the delegators of the proposer via the distribution module.
+type CurrentProposerRewardsAddressProvider struct {
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	stakingKeeper types.StakingKeeper
+
+	// delegatorShare is the portion of the rewards that is allocated to the proposer
+	// via the distribution module, where it is split between the proposer's commission
+	// and its delegators.
+	delegatorShare math.LegacyDec
+
+	distributionAddress sdk.AccAddress
+}
+
+// NewCurrentProposerRewardsAddressProvider creates a reward provider for the proposer of
+// the current block. The delegator share must be between zero and one.
+func NewCurrentProposerRewardsAddressProvider(
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
+	delegatorShare math.LegacyDec,
+) *CurrentProposerRewardsAddressProvider {
+	if delegatorShare.IsNil() || delegatorShare.IsNegative() || delegatorShare.GT(math.LegacyOneDec()) {
+		panic(fmt.Sprintf("invalid delegator share: %s", delegatorShare))
+	}
+
+	return &CurrentProposerRewardsAddressProvider{
+		bankKeeper:          bankKeeper,
+		distrKeeper:         distrKeeper,
+		stakingKeeper:       stakingKeeper,
+		delegatorShare:      delegatorShare,
+		distributionAddress: authtypes.NewModuleAddress(distrtypes.ModuleName),
+	}
+}
+
+// GetRewardsAddress returns the operator address of the proposer of the current block.
+func (p *CurrentProposerRewardsAddressProvider) GetRewardsAddress(ctx sdk.Context) (sdk.AccAddress, error) {
+	proposer, err := p.getProposer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.AccAddress(proposer.GetOperator()), nil
+}
+
+// GetRewardsValidator returns the operator address of the proposer of the current block.
+func (p *CurrentProposerRewardsAddressProvider) GetRewardsValidator(ctx sdk.Context) (sdk.ValAddress, error) {
+	proposer, err := p.getProposer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposer.GetOperator(), nil
+}
+
+// DistributeRewards allocates the delegator share of the rewards to the proposer of the
+// current block via the distribution module. The rest of the rewards is returned so that it
+// is sent to the proposer's operator address.
+func (p *CurrentProposerRewardsAddressProvider) DistributeRewards(ctx sdk.Context, sender sdk.AccAddress, rewards sdk.Coins) (sdk.Coins, error) {
+	proposer, err := p.getProposer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorRewards, _ := sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(p.delegatorShare).TruncateDecimal()
+	if !delegatorRewards.IsZero() {
+		// The distribution module must hold the tokens that are allocated to the proposer.
+		if err := p.bankKeeper.SendCoins(ctx, sender, p.distributionAddress, delegatorRewards); err != nil {
+			return nil, err
+		}
+
+		if err := p.distrKeeper.AllocateTokensToValidator(ctx, proposer, sdk.NewDecCoinsFromCoins(delegatorRewards...)); err != nil {
+			return nil, err
+		}
+	}
+
+	return rewards.Sub(delegatorRewards...), nil
+}
+
+// getProposer returns the validator that proposed the current block.
+func (p *CurrentProposerRewardsAddressProvider) getProposer(ctx sdk.Context) (stakingtypes.Validator, error) {
+	proposerConsAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
+	if proposerConsAddr.Empty() {
+		return stakingtypes.Validator{}, fmt.Errorf("block header has no proposer address")
+	}
+
+	return p.stakingKeeper.GetValidatorByConsAddr(ctx, proposerConsAddr)
+}
@@ -6,16 +6,18 @@ import (
 	"github.com/skip-mev/pob/x/builder/types"
 )
 
-var _ types.RewardsAddressProvider = (*ProposerRewardsAddressProvider)(nil)
+var _ types.ValidatorRewardsAddressProvider = (*ProposerRewardsAddressProvider)(nil)
 
 // ProposerRewardsAddressProvider provides a portion of
-// auction profits to the block proposer.
+// auction profits to the proposer of the previous block. Use
+// CurrentProposerRewardsAddressProvider to reward the proposer
+// of the block that includes the winning bid.
 type ProposerRewardsAddressProvider struct {
 	distrKeeper   types.DistributionKeeper
 	stakingKeeper types.StakingKeeper
 }
 
-// NewProposerRewardsAddressProvider creates a reward provider for block proposers.
+// NewProposerRewardsAddressProvider creates a reward provider for previous block proposers.
 func NewProposerRewardsAddressProvider(
 	distrKeeper types.DistributionKeeper,
 	stakingKeeper types.StakingKeeper,
@@ -27,6 +29,16 @@ func NewProposerRewardsAddressProvider(
 }
 
 func (p *ProposerRewardsAddressProvider) GetRewardsAddress(ctx sdk.Context) (sdk.AccAddress, error) {
+	operator, err := p.GetRewardsValidator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.AccAddress(operator), nil
+}
+
+// GetRewardsValidator returns the operator address of the proposer of the previous block.
+func (p *ProposerRewardsAddressProvider) GetRewardsValidator(ctx sdk.Context) (sdk.ValAddress, error) {
 	prevPropConsAddr, err := p.distrKeeper.GetPreviousProposerConsAddr(ctx)
 	if err != nil {
 		return nil, err
@@ -37,5 +49,5 @@ func (p *ProposerRewardsAddressProvider) GetRewardsAddress(ctx sdk.Context) (sdk
 		return nil, err
 	}
 
-	return sdk.AccAddress(prevProposer.GetOperator()), nil
+	return prevProposer.GetOperator(), nil
 }
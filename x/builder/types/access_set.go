@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccessSet defines the state that is read and written by a bundle. State is identified by
+// keys such as the address of an account, a denom or the id of a pool. Bundles whose access
+// sets conflict cannot be executed independently of one another.
+type AccessSet struct {
+	Reads  map[string]struct{}
+	Writes map[string]struct{}
+}
+
+// NewAccessSet returns an empty access set.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		Reads:  make(map[string]struct{}),
+		Writes: make(map[string]struct{}),
+	}
+}
+
+// AddRead marks the state identified by the key as read.
+func (s *AccessSet) AddRead(key string) {
+	s.Reads[key] = struct{}{}
+}
+
+// AddWrite marks the state identified by the key as written.
+func (s *AccessSet) AddWrite(key string) {
+	s.Writes[key] = struct{}{}
+}
+
+// ConflictsWith returns true if either access set writes state that is read or written by
+// the other access set.
+func (s *AccessSet) ConflictsWith(other *AccessSet) bool {
+	for key := range s.Writes {
+		if _, ok := other.Writes[key]; ok {
+			return true
+		}
+
+		if _, ok := other.Reads[key]; ok {
+			return true
+		}
+	}
+
+	for key := range s.Reads {
+		if _, ok := other.Writes[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccountAccessKey returns the access set key of an account.
+func AccountAccessKey(account sdk.AccAddress) string {
+	return "account/" + account.String()
+}
+
+// DenomAccessKey returns the access set key of a denom, e.g. its total supply.
+func DenomAccessKey(denom string) string {
+	return "denom/" + denom
+}
+
+// PoolAccessKey returns the access set key of a pool, e.g. a liquidity pool of a DEX.
+func PoolAccessKey(poolID uint64) string {
+	return "pool/" + strconv.FormatUint(poolID, 10)
+}
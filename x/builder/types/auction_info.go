@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// AuctionInfoPrefix is prepended to encoded auction infos. Protobuf encoded transactions
+// never start with a zero byte, so an auction info included in a block proposal can never
+// be mistaken for a transaction.
+var AuctionInfoPrefix = []byte("\x00auction_info")
+
+// IsAuctionInfo returns true if the given bytes of a block proposal entry encode an auction
+// info.
+func IsAuctionInfo(bz []byte) bool {
+	return bytes.HasPrefix(bz, AuctionInfoPrefix)
+}
+
+// EncodeAuctionInfo encodes the auction info so that it can be included in a block proposal.
+func EncodeAuctionInfo(info AuctionInfo) ([]byte, error) {
+	bz, err := info.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, AuctionInfoPrefix...), bz...), nil
+}
+
+// DecodeAuctionInfo decodes an auction info that was included in a block proposal.
+func DecodeAuctionInfo(bz []byte) (AuctionInfo, error) {
+	if !IsAuctionInfo(bz) {
+		return AuctionInfo{}, fmt.Errorf("proposal entry is not an auction info")
+	}
+
+	info := AuctionInfo{}
+	if err := info.Unmarshal(bz[len(AuctionInfoPrefix):]); err != nil {
+		return AuctionInfo{}, err
+	}
+
+	if err := info.Validate(); err != nil {
+		return AuctionInfo{}, err
+	}
+
+	return info, nil
+}
+
+// Validate performs basic validation on the auction info. The bid transactions must be
+// unique and sorted by their hash so that every set of bids has a single encoding. The failed
+// bid transactions must be unique bid transactions of the auction info.
+func (i AuctionInfo) Validate() error {
+	if i.MaxTxBytes <= 0 {
+		return fmt.Errorf("invalid max tx bytes in auction info: %d", i.MaxTxBytes)
+	}
+
+	var prevHash []byte
+	bidTxHashes := make(map[string]struct{}, len(i.BidTxs))
+	for _, bidTx := range i.BidTxs {
+		hash := sha256.Sum256(bidTx)
+		if prevHash != nil && bytes.Compare(prevHash, hash[:]) >= 0 {
+			return fmt.Errorf("bid txs of the auction info are not unique and sorted by hash")
+		}
+
+		prevHash = hash[:]
+		bidTxHashes[string(hash[:])] = struct{}{}
+	}
+
+	for _, failedBidTxHash := range i.FailedBidTxHashes {
+		if _, ok := bidTxHashes[string(failedBidTxHash)]; !ok {
+			return fmt.Errorf("failed bid tx %X is not a unique bid tx of the auction info", failedBidTxHash)
+		}
+
+		delete(bidTxHashes, string(failedBidTxHash))
+	}
+
+	return nil
+}
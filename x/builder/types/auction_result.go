@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation on the auction result.
+func (r AuctionResult) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Bidder); err != nil {
+		return errors.Wrap(err, "invalid bidder address")
+	}
+
+	if r.Bid.IsNil() {
+		return fmt.Errorf("auction result at height %d has no bid", r.Height)
+	}
+
+	if err := r.Bid.Validate(); err != nil {
+		return errors.Wrap(err, "invalid bid")
+	}
+
+	// Results recorded before the price was tracked do not have a price.
+	if r.Price != nil {
+		if err := r.Price.Validate(); err != nil {
+			return errors.Wrap(err, "invalid price")
+		}
+	}
+
+	if err := r.ProposerReward.Validate(); err != nil {
+		return errors.Wrap(err, "invalid proposer reward")
+	}
+
+	if err := r.EscrowReward.Validate(); err != nil {
+		return errors.Wrap(err, "invalid escrow reward")
+	}
+
+	if err := r.Refund.Validate(); err != nil {
+		return errors.Wrap(err, "invalid refund")
+	}
+
+	// Results recorded before the proposer was tracked do not have a proposer.
+	if r.Proposer != "" {
+		if _, err := sdk.ConsAddressFromBech32(r.Proposer); err != nil {
+			return errors.Wrap(err, "invalid proposer address")
+		}
+	}
+
+	for _, hash := range r.BundledTxHashes {
+		if _, err := hex.DecodeString(hash); err != nil {
+			return errors.Wrap(err, "invalid bundled transaction hash")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// AuctionSettlementPrefix is prepended to encoded auction settlements. Protobuf encoded
+// transactions never start with a zero byte, so an auction settlement included in a
+// block proposal can never be mistaken for a transaction.
+var AuctionSettlementPrefix = []byte("\x00auction_settlement")
+
+// IsAuctionSettlement returns true if the given bytes of a block proposal entry encode an
+// auction settlement.
+func IsAuctionSettlement(bz []byte) bool {
+	return bytes.HasPrefix(bz, AuctionSettlementPrefix)
+}
+
+// EncodeAuctionSettlement encodes the auction settlement so that it can be included in a
+// block proposal.
+func EncodeAuctionSettlement(settlement AuctionSettlement) ([]byte, error) {
+	bz, err := settlement.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, AuctionSettlementPrefix...), bz...), nil
+}
+
+// DecodeAuctionSettlement decodes an auction settlement that was included in a block
+// proposal.
+func DecodeAuctionSettlement(bz []byte) (AuctionSettlement, error) {
+	if !IsAuctionSettlement(bz) {
+		return AuctionSettlement{}, fmt.Errorf("proposal entry is not an auction settlement")
+	}
+
+	settlement := AuctionSettlement{}
+	if err := settlement.Unmarshal(bz[len(AuctionSettlementPrefix):]); err != nil {
+		return AuctionSettlement{}, err
+	}
+
+	if err := settlement.Validate(); err != nil {
+		return AuctionSettlement{}, err
+	}
+
+	return settlement, nil
+}
+
+// Validate performs basic validation on the auction settlement. A settlement without a
+// runner-up bid transaction hash must not include a runner-up bid.
+func (s AuctionSettlement) Validate() error {
+	if !s.HasRunnerUp() {
+		if s.RunnerUpBid.Denom != "" || !(s.RunnerUpBid.Amount.IsNil() || s.RunnerUpBid.Amount.IsZero()) {
+			return fmt.Errorf("auction settlement without a runner-up bid tx hash has a runner-up bid")
+		}
+
+		return nil
+	}
+
+	if s.RunnerUpBid.IsNil() {
+		return fmt.Errorf("auction settlement has no runner-up bid")
+	}
+
+	if err := s.RunnerUpBid.Validate(); err != nil {
+		return fmt.Errorf("invalid runner-up bid: %w", err)
+	}
+
+	if len(s.RunnerUpBidTxHash) != sha256.Size {
+		return fmt.Errorf("invalid runner-up bid tx hash length: %d", len(s.RunnerUpBidTxHash))
+	}
+
+	return nil
+}
+
+// HasRunnerUp returns true if the auction settlement includes a runner-up bid. The winners
+// of an auction without a runner-up bid pay the reserve fee.
+func (s AuctionSettlement) HasRunnerUp() bool {
+	return len(s.RunnerUpBidTxHash) > 0
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MinSaltLength is the minimum length of the salt used to compute a sealed bid commitment.
+// The salt prevents other bidders from recovering the bid by hashing candidate bids.
+const MinSaltLength = 16
+
+// ComputeBidCommitment returns the commitment of a sealed bid, which is the SHA-256 hash of
+// the bidder, the bid, the hashes of the bundled transactions and the salt. Each field is
+// length-prefixed so that distinct bids cannot produce the same preimage.
+func ComputeBidCommitment(bidder sdk.AccAddress, bid sdk.Coin, transactions [][]byte, salt []byte) []byte {
+	hasher := sha256.New()
+
+	writeLengthPrefixed(hasher, bidder)
+	writeLengthPrefixed(hasher, []byte(bid.String()))
+
+	writeLengthPrefixed(hasher, binary.BigEndian.AppendUint64(nil, uint64(len(transactions))))
+	for _, tx := range transactions {
+		txHash := sha256.Sum256(tx)
+		writeLengthPrefixed(hasher, txHash[:])
+	}
+
+	writeLengthPrefixed(hasher, salt)
+
+	return hasher.Sum(nil)
+}
+
+// writeLengthPrefixed writes the big-endian length of bz followed by bz to the hasher.
+func writeLengthPrefixed(hasher hash.Hash, bz []byte) {
+	hasher.Write(binary.BigEndian.AppendUint64(nil, uint64(len(bz))))
+	hasher.Write(bz)
+}
+
+// Validate performs basic validation on the bid commitment.
+func (c BidCommitment) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Bidder); err != nil {
+		return errors.Wrap(err, "invalid bidder address")
+	}
+
+	if len(c.Commitment) != sha256.Size {
+		return fmt.Errorf("invalid commitment length; expected %d, got %d", sha256.Size, len(c.Commitment))
+	}
+
+	if c.Collateral.IsNil() {
+		return fmt.Errorf("bid commitment has no collateral")
+	}
+
+	if err := c.Collateral.Validate(); err != nil {
+		return errors.Wrap(err, "invalid collateral")
+	}
+
+	if c.ExpirationHeight <= c.Height {
+		return fmt.Errorf(
+			"expiration height (%d) must be greater than the commitment height (%d)",
+			c.ExpirationHeight,
+			c.Height,
+		)
+	}
+
+	return nil
+}
@@ -9,4 +9,13 @@ type BidInfo struct {
 	Transactions [][]byte
 	Timeout      uint64
 	Signers      []map[string]struct{}
+
+	// Commitment is the commitment of the sealed bid that was revealed. It is nil if
+	// the bid was not sealed.
+	Commitment []byte
+
+	// AccessSet is the state that is read and written by the bid transaction and its
+	// bundled transactions. It is used to detect conflicting bundles. If it is nil, the
+	// bundle is assumed to write the accounts of the bidder and of all signers.
+	AccessSet *AccessSet
 }
@@ -0,0 +1,49 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BundleInfo defines the information about a bundle that is submitted without a bid.
+type BundleInfo struct {
+	Sender       sdk.AccAddress
+	Transactions [][]byte
+	Signers      []map[string]struct{}
+}
+
+// bundleExecutionKey is the context key that marks the execution of the bundled transactions
+// of a MsgSubmitBundle.
+type bundleExecutionKey struct{}
+
+// WithBundleExecution returns a copy of the context in which bundled transactions, i.e.
+// transactions with the ExtensionOptionBundledTx option, can be executed.
+func WithBundleExecution(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bundleExecutionKey{}, true)
+}
+
+// IsBundleExecution returns true if bundled transactions can be executed in the context.
+func IsBundleExecution(ctx sdk.Context) bool {
+	isBundleExecution, _ := ctx.Value(bundleExecutionKey{}).(bool)
+	return isBundleExecution
+}
+
+// IsBundledTx returns true if the transaction carries the ExtensionOptionBundledTx option, i.e.
+// if it can only be executed as part of a bundle.
+func IsBundledTx(tx sdk.Tx) bool {
+	extTx, ok := tx.(interface {
+		GetNonCriticalExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return false
+	}
+
+	typeURL := sdk.MsgTypeURL(&ExtensionOptionBundledTx{})
+	for _, option := range extTx.GetNonCriticalExtensionOptions() {
+		if option.GetTypeUrl() == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation on the censorship report.
+func (r CensorshipReport) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(r.Proposer); err != nil {
+		return errors.Wrap(err, "invalid proposer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Bidder); err != nil {
+		return errors.Wrap(err, "invalid bidder address")
+	}
+
+	if r.Bid.IsNil() {
+		return fmt.Errorf("censorship report at height %d has no bid", r.Height)
+	}
+
+	if err := r.Bid.Validate(); err != nil {
+		return errors.Wrap(err, "invalid bid")
+	}
+
+	if hash, err := hex.DecodeString(r.BidTxHash); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("invalid bid transaction hash %s", r.BidTxHash)
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(r.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	return nil
+}
+
+// Validate performs basic validation on the censorship score.
+func (s CensorshipScore) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(s.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if s.Score == 0 {
+		return fmt.Errorf("censorship score of validator %s cannot be zero", s.ValidatorAddress)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation on the auction evidence.
+func (e AuctionEvidence) Validate() error {
+	if e.Height <= 1 {
+		return fmt.Errorf("auction evidence height must be greater than one; got %d", e.Height)
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(e.Proposer); err != nil {
+		return errors.Wrap(err, "invalid proposer address")
+	}
+
+	for _, hash := range e.BidTxHashes {
+		if len(hash) != sha256.Size {
+			return fmt.Errorf("invalid bid transaction hash %X", hash)
+		}
+	}
+
+	for _, validator := range e.Validators {
+		if _, err := sdk.ConsAddressFromBech32(validator); err != nil {
+			return errors.Wrap(err, "invalid validator address")
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
@@ -25,6 +26,16 @@ func init() {
 // Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAuctionBid{}, "pob/x/builder/MsgAuctionBid")
+	legacy.RegisterAminoMsg(cdc, &MsgCommitBid{}, "pob/x/builder/MsgCommitBid")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealBid{}, "pob/x/builder/MsgRevealBid")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealBidCommitment{}, "pob/x/builder/MsgRevealBidCommitment")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitBundle{}, "pob/x/builder/MsgSubmitBundle")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterSearcher{}, "pob/x/builder/MsgRegisterSearcher")
+	legacy.RegisterAminoMsg(cdc, &MsgUnbondSearcherCollateral{}, "pob/x/builder/MsgUnbondCollateral")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawSearcherCollateral{}, "pob/x/builder/MsgWithdrawCollateral")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawBuilderRewards{}, "pob/x/builder/MsgWithdrawBuilderRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBuilderRewardsWithdrawAddress{}, "pob/x/builder/MsgSetRewardsWithdrawAddr")
+	legacy.RegisterAminoMsg(cdc, &MsgReportCensoredBid{}, "pob/x/builder/MsgReportCensoredBid")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "pob/x/builder/MsgUpdateParams")
 
 	cdc.RegisterConcrete(Params{}, "pob/builder/Params", nil)
@@ -36,8 +47,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgAuctionBid{},
+		&MsgCommitBid{},
+		&MsgRevealBid{},
+		&MsgRevealBidCommitment{},
+		&MsgSubmitBundle{},
+		&MsgRegisterSearcher{},
+		&MsgUnbondSearcherCollateral{},
+		&MsgWithdrawSearcherCollateral{},
+		&MsgWithdrawBuilderRewards{},
+		&MsgSetBuilderRewardsWithdrawAddress{},
+		&MsgReportCensoredBid{},
 		&MsgUpdateParams{},
 	)
 
+	registry.RegisterImplementations(
+		(*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionBundledTx{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
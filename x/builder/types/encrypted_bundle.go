@@ -0,0 +1,59 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// EncryptedBundleTxPrefix is prepended to encrypted bundled transactions. Protobuf encoded
+// transactions never start with a zero byte, so an encrypted bundled transaction can never
+// be mistaken for a plaintext transaction.
+var EncryptedBundleTxPrefix = []byte("\x00encrypted_bundle_tx")
+
+// IsEncryptedBundleTx returns true if the given bundled transaction is encrypted.
+func IsEncryptedBundleTx(bz []byte) bool {
+	return bytes.HasPrefix(bz, EncryptedBundleTxPrefix)
+}
+
+// IsEncryptedBundle returns true if any of the given bundled transactions is encrypted.
+func IsEncryptedBundle(transactions [][]byte) bool {
+	for _, tx := range transactions {
+		if IsEncryptedBundleTx(tx) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EncodeEncryptedBundleTx encodes the ciphertext of a bundled transaction so that it can be
+// included in a bid. The gas limit of the plaintext transaction is declared next to the
+// ciphertext, big-endian encoded, so that the bundle can be ranked by its gas before it is
+// decrypted.
+func EncodeEncryptedBundleTx(gasLimit uint64, ciphertext []byte) []byte {
+	bz := append([]byte{}, EncryptedBundleTxPrefix...)
+	bz = binary.BigEndian.AppendUint64(bz, gasLimit)
+
+	return append(bz, ciphertext...)
+}
+
+// DecodeEncryptedBundleTx returns the declared gas limit and the ciphertext of an encrypted
+// bundled transaction.
+func DecodeEncryptedBundleTx(bz []byte) (uint64, []byte, error) {
+	if !IsEncryptedBundleTx(bz) {
+		return 0, nil, fmt.Errorf("bundled transaction is not encrypted")
+	}
+
+	bz = bz[len(EncryptedBundleTxPrefix):]
+	if len(bz) < 8 {
+		return 0, nil, fmt.Errorf("encrypted bundled transaction has no gas limit")
+	}
+
+	gasLimit, ciphertext := binary.BigEndian.Uint64(bz[:8]), bz[8:]
+	if len(ciphertext) == 0 {
+		return 0, nil, fmt.Errorf("encrypted bundled transaction has no ciphertext")
+	}
+
+	return gasLimit, ciphertext, nil
+}
@@ -2,10 +2,42 @@ package types
 
 // Event types and attributes
 const (
-	EventTypeAuctionBid = "auction_bid"
+	EventTypeAuctionBid        = "auction_bid"
+	EventTypeCommitBid         = "commit_bid"
+	EventTypeRevealBid         = "reveal_bid"
+	EventTypeRevealCommitment  = "reveal_bid_commitment"
+	EventTypeSlashCommitment   = "slash_commitment"
+	EventTypeReleaseCommitment = "release_commitment"
+	EventTypeRefundBid         = "refund_bid"
+	EventTypeDistributeBid     = "distribute_bid"
+	EventTypeRegisterSearcher  = "register_searcher"
+	EventTypeUnbondSearcher    = "unbond_searcher_collateral"
+	EventTypeWithdrawSearcher  = "withdraw_searcher_collateral"
+	EventTypePayoutReward      = "payout_reward"
+	EventTypeAccrueRewards     = "accrue_validator_rewards"
+	EventTypeWithdrawRewards   = "withdraw_builder_rewards"
+	EventTypeSetWithdrawAddr   = "set_builder_rewards_withdraw_address"
+	EventTypeCensoredBid       = "report_censored_bid"
+	EventTypeSubmitBundle      = "submit_bundle"
 
 	EventAttrBidder         = "bidder"
 	EventAttrBid            = "bid"
+	EventAttrPrice          = "price"
 	EventAttrProposerReward = "proposer_reward"
 	EventAttrBundledTxs     = "bundled_txs"
+	EventAttrCommitment     = "commitment"
+	EventAttrCollateral     = "collateral"
+	EventAttrRefund         = "refund"
+	EventAttrFailedTxs      = "failed_txs"
+	EventAttrRecipient      = "recipient"
+	EventAttrAmount         = "amount"
+	EventAttrSearcher       = "searcher"
+	EventAttrValidator      = "validator"
+	EventAttrWithdrawAddr   = "withdraw_address"
+	EventAttrHeight         = "height"
+	EventAttrProposer       = "proposer"
+	EventAttrBidTx          = "bid_tx"
+	EventAttrScore          = "censorship_score"
+	EventAttrSender         = "sender"
+	EventAttrCompletion     = "completion_height"
 )
@@ -3,25 +3,29 @@ package types
 import (
 	context "context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// BankKeeper defines the expected API contract for the x/auth module.
+// AccountKeeper defines the expected API contract for the x/auth module.
 type AccountKeeper interface {
-	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAddressAndPermissions(moduleName string) (sdk.AccAddress, []string)
 }
 
 // BankKeeper defines the expected API contract for the x/bank module.
 type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
 // DistributionKeeper defines the expected API contract for the x/distribution
 // module.
 type DistributionKeeper interface {
 	GetPreviousProposerConsAddr(ctx context.Context) (sdk.ConsAddress, error)
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error
 }
 
 // StakingKeeper defines the expected API contract for the x/staking module.
@@ -33,3 +37,42 @@ type StakingKeeper interface {
 type RewardsAddressProvider interface {
 	GetRewardsAddress(context sdk.Context) (sdk.AccAddress, error)
 }
+
+// ValidatorRewardsAddressProvider is an optional extension of the RewardsAddressProvider that
+// rewards a validator. It returns the operator address of the validator, for which the proposer's
+// portion of the auction proceeds is accrued if proposer rewards are claimable.
+type ValidatorRewardsAddressProvider interface {
+	RewardsAddressProvider
+
+	GetRewardsValidator(ctx sdk.Context) (sdk.ValAddress, error)
+}
+
+// RewardsDistributor is an optional extension of the RewardsAddressProvider that pays out part
+// of the proposer's portion of the auction proceeds itself. The rewards that are returned are
+// sent to the rewards address. It is only used if GetRewardsAddress succeeds.
+type RewardsDistributor interface {
+	RewardsAddressProvider
+
+	DistributeRewards(ctx sdk.Context, sender sdk.AccAddress, rewards sdk.Coins) (sdk.Coins, error)
+}
+
+// BundleDecrypter is an interface that decrypts the encrypted bundled transactions of winning
+// bids so that the keeper can track the transactions that are executed. It is typically the
+// same decrypter as the one of the top-of-block lane's auction factory.
+type BundleDecrypter interface {
+	Decrypt(ctx sdk.Context, ciphertext []byte) ([]byte, error)
+}
+
+// BundleExecutor is an interface that executes the bundled transactions of a MsgSubmitBundle.
+// Each transaction is run through the ante handler and its messages are executed, in order.
+// It is typically the bundle lane's executor.
+type BundleExecutor interface {
+	ExecuteBundle(ctx sdk.Context, txs [][]byte) error
+}
+
+// PriceConverter is an interface that converts bids denominated in any of the allowed bid
+// denominations into a common unit of account so that bids in different denominations can
+// be ranked against one another.
+type PriceConverter interface {
+	ConvertBid(ctx context.Context, bid sdk.Coin) (math.LegacyDec, error)
+}
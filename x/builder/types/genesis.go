@@ -2,14 +2,36 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState instance.
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(
+	params Params,
+	auctionResults []AuctionResult,
+	bidCommitments []BidCommitment,
+	searchers []Searcher,
+	pendingRewards []PendingReward,
+	validatorRewards []ValidatorRewards,
+	withdrawAddresses []RewardsWithdrawAddress,
+	censorshipReports []CensorshipReport,
+	censorshipScores []CensorshipScore,
+	auctionEvidence []AuctionEvidence,
+) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:            params,
+		AuctionResults:    auctionResults,
+		BidCommitments:    bidCommitments,
+		Searchers:         searchers,
+		PendingRewards:    pendingRewards,
+		ValidatorRewards:  validatorRewards,
+		WithdrawAddresses: withdrawAddresses,
+		CensorshipReports: censorshipReports,
+		CensorshipScores:  censorshipScores,
+		AuctionEvidence:   auctionEvidence,
 	}
 }
 
@@ -22,7 +44,123 @@ func DefaultGenesisState() *GenesisState {
 
 // Validate performs basic validation of the builder module genesis state.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenResults := make(map[string]struct{})
+	for _, result := range gs.AuctionResults {
+		key := string(GetAuctionResultKey(result.Height, result.BundleIndex))
+		if _, ok := seenResults[key]; ok {
+			return fmt.Errorf("duplicate auction result for height %d and bundle index %d", result.Height, result.BundleIndex)
+		}
+		seenResults[key] = struct{}{}
+
+		if err := result.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenCommitments := make(map[string]struct{})
+	for _, commitment := range gs.BidCommitments {
+		if err := commitment.Validate(); err != nil {
+			return err
+		}
+
+		bidder, _ := sdk.AccAddressFromBech32(commitment.Bidder)
+		key := string(GetBidCommitmentKey(bidder, commitment.Commitment))
+		if _, ok := seenCommitments[key]; ok {
+			return fmt.Errorf("duplicate bid commitment %X for bidder %s", commitment.Commitment, commitment.Bidder)
+		}
+		seenCommitments[key] = struct{}{}
+	}
+
+	seenSearchers := make(map[string]struct{})
+	for _, searcher := range gs.Searchers {
+		if err := searcher.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenSearchers[searcher.Address]; ok {
+			return fmt.Errorf("duplicate searcher %s", searcher.Address)
+		}
+		seenSearchers[searcher.Address] = struct{}{}
+	}
+
+	seenRewards := make(map[string]struct{})
+	for _, reward := range gs.PendingRewards {
+		if err := reward.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenRewards[reward.Address]; ok {
+			return fmt.Errorf("duplicate pending reward for %s", reward.Address)
+		}
+		seenRewards[reward.Address] = struct{}{}
+	}
+
+	seenValidators := make(map[string]struct{})
+	for _, rewards := range gs.ValidatorRewards {
+		if err := rewards.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenValidators[rewards.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate rewards for validator %s", rewards.ValidatorAddress)
+		}
+		seenValidators[rewards.ValidatorAddress] = struct{}{}
+	}
+
+	seenWithdrawAddresses := make(map[string]struct{})
+	for _, withdrawAddress := range gs.WithdrawAddresses {
+		if err := withdrawAddress.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenWithdrawAddresses[withdrawAddress.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate withdraw address for validator %s", withdrawAddress.ValidatorAddress)
+		}
+		seenWithdrawAddresses[withdrawAddress.ValidatorAddress] = struct{}{}
+	}
+
+	seenReports := make(map[string]struct{})
+	for _, report := range gs.CensorshipReports {
+		if err := report.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%d/%s", report.Height, report.BidTxHash)
+		if _, ok := seenReports[key]; ok {
+			return fmt.Errorf("duplicate censorship report for height %d and bid tx %s", report.Height, report.BidTxHash)
+		}
+		seenReports[key] = struct{}{}
+	}
+
+	seenScores := make(map[string]struct{})
+	for _, score := range gs.CensorshipScores {
+		if err := score.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenScores[score.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate censorship score for validator %s", score.ValidatorAddress)
+		}
+		seenScores[score.ValidatorAddress] = struct{}{}
+	}
+
+	seenEvidence := make(map[uint64]struct{})
+	for _, evidence := range gs.AuctionEvidence {
+		if err := evidence.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenEvidence[evidence.Height]; ok {
+			return fmt.Errorf("duplicate auction evidence for height %d", evidence.Height)
+		}
+		seenEvidence[evidence.Height] = struct{}{}
+	}
+
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/builder GenesisState given raw application
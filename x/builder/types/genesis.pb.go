@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -27,9 +28,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PricingRule defines how the price paid by the winners of the auction is
+// determined.
+type PricingRule int32
+
+const (
+	// PRICING_RULE_FIRST_PRICE charges the winners of the auction their bid.
+	PricingRule_PRICING_RULE_FIRST_PRICE PricingRule = 0
+	// PRICING_RULE_SECOND_PRICE charges the winners of the auction the highest
+	// valid bid that did not win the auction plus the minimum bid increment, or
+	// the reserve fee if there is no such bid. Winners never pay more than their
+	// bid.
+	PricingRule_PRICING_RULE_SECOND_PRICE PricingRule = 1
+)
+
+var PricingRule_name = map[int32]string{
+	0: "PRICING_RULE_FIRST_PRICE",
+	1: "PRICING_RULE_SECOND_PRICE",
+}
+
+var PricingRule_value = map[string]int32{
+	"PRICING_RULE_FIRST_PRICE":  0,
+	"PRICING_RULE_SECOND_PRICE": 1,
+}
+
+func (x PricingRule) String() string {
+	return proto.EnumName(PricingRule_name, int32(x))
+}
+
+func (PricingRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{0}
+}
+
 // GenesisState defines the genesis state of the x/builder module.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// auction_results defines the historical auction results that are currently
+	// retained in state.
+	AuctionResults []AuctionResult `protobuf:"bytes,2,rep,name=auction_results,json=auctionResults,proto3" json:"auction_results"`
+	// bid_commitments defines the sealed bid commitments that have not been
+	// revealed yet.
+	BidCommitments []BidCommitment `protobuf:"bytes,3,rep,name=bid_commitments,json=bidCommitments,proto3" json:"bid_commitments"`
+	// searchers defines the searchers that are registered with the builder
+	// module.
+	Searchers []Searcher `protobuf:"bytes,4,rep,name=searchers,proto3" json:"searchers"`
+	// pending_rewards defines the auction proceeds that have been accrued but
+	// not paid out yet.
+	PendingRewards []PendingReward `protobuf:"bytes,5,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards"`
+	// validator_rewards defines the proposer rewards that have been accrued by
+	// validators but not withdrawn yet.
+	ValidatorRewards []ValidatorRewards `protobuf:"bytes,6,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+	// withdraw_addresses defines the addresses that validators withdraw their
+	// proposer rewards to.
+	WithdrawAddresses []RewardsWithdrawAddress `protobuf:"bytes,7,rep,name=withdraw_addresses,json=withdrawAddresses,proto3" json:"withdraw_addresses"`
+	// censorship_reports defines the verified reports of censored bids at the
+	// heights for which auction results are retained.
+	CensorshipReports []CensorshipReport `protobuf:"bytes,8,rep,name=censorship_reports,json=censorshipReports,proto3" json:"censorship_reports"`
+	// censorship_scores defines the number of blocks in which each validator
+	// was reported to have censored a bid.
+	CensorshipScores []CensorshipScore `protobuf:"bytes,9,rep,name=censorship_scores,json=censorshipScores,proto3" json:"censorship_scores"`
+	// auction_evidence defines the vote extension auctions of the blocks for
+	// which censored bids can be reported.
+	AuctionEvidence []AuctionEvidence `protobuf:"bytes,10,rep,name=auction_evidence,json=auctionEvidence,proto3" json:"auction_evidence"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,6 +132,69 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAuctionResults() []AuctionResult {
+	if m != nil {
+		return m.AuctionResults
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBidCommitments() []BidCommitment {
+	if m != nil {
+		return m.BidCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSearchers() []Searcher {
+	if m != nil {
+		return m.Searchers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRewards() []PendingReward {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorRewards() []ValidatorRewards {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawAddresses() []RewardsWithdrawAddress {
+	if m != nil {
+		return m.WithdrawAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCensorshipReports() []CensorshipReport {
+	if m != nil {
+		return m.CensorshipReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCensorshipScores() []CensorshipScore {
+	if m != nil {
+		return m.CensorshipScores
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionEvidence() []AuctionEvidence {
+	if m != nil {
+		return m.AuctionEvidence
+	}
+	return nil
+}
+
 // Params defines the parameters of the x/builder module.
 type Params struct {
 	// max_bundle_size is the maximum number of transactions that can be bundled
@@ -91,6 +214,57 @@ type Params struct {
 	// proposer_fee defines the portion of the winning bid that goes to the block
 	// proposer that proposed the block.
 	ProposerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=proposer_fee,json=proposerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proposer_fee"`
+	// auction_result_retention specifies the number of most recent blocks for
+	// which auction results, censorship reports and auction evidence are
+	// retained in state. A value of zero disables pruning, so the state grows
+	// without bound.
+	AuctionResultRetention uint64 `protobuf:"varint,7,opt,name=auction_result_retention,json=auctionResultRetention,proto3" json:"auction_result_retention,omitempty"`
+	// bid_denoms defines the additional denominations, besides the denomination
+	// of the reserve fee, that may be used to bid in the auction. Each
+	// denomination defines its own reserve fee and minimum bid increment.
+	BidDenoms []BidDenom `protobuf:"bytes,8,rep,name=bid_denoms,json=bidDenoms,proto3" json:"bid_denoms"`
+	// max_bundles_per_block is the maximum number of winning bundles that can be
+	// included at the top of a single block.
+	MaxBundlesPerBlock uint32 `protobuf:"varint,9,opt,name=max_bundles_per_block,json=maxBundlesPerBlock,proto3" json:"max_bundles_per_block,omitempty"`
+	// reveal_window is the number of blocks after a sealed bid commitment is
+	// included in a block within which the bid must be revealed. A value of zero
+	// disables sealed bids. When sealed bids are enabled, bids can only be
+	// submitted to the auction by revealing a commitment.
+	RevealWindow uint64 `protobuf:"varint,10,opt,name=reveal_window,json=revealWindow,proto3" json:"reveal_window,omitempty"`
+	// min_commitment_collateral is the minimum collateral that must be locked
+	// with a sealed bid commitment. The collateral is returned when the bid is
+	// revealed and is sent to the escrow account otherwise.
+	MinCommitmentCollateral types.Coin `protobuf:"bytes,11,opt,name=min_commitment_collateral,json=minCommitmentCollateral,proto3" json:"min_commitment_collateral"`
+	// pricing_rule determines the amount that the winners of the auction pay.
+	PricingRule PricingRule `protobuf:"varint,12,opt,name=pricing_rule,json=pricingRule,proto3,enum=pob.builder.v1.PricingRule" json:"pricing_rule,omitempty"`
+	// revert_protection_refund is the portion of the price paid for a winning
+	// bundle that is refunded to the bidder from the escrow account if any of the
+	// bundled transactions fail. A value of zero disables revert protection.
+	RevertProtectionRefund cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=revert_protection_refund,json=revertProtectionRefund,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"revert_protection_refund"`
+	// revenue_split defines how the price paid by the winners of the auction is
+	// distributed. The weights of the recipients must sum to one. If it is empty,
+	// the proposer_fee portion of the price is sent to the proposer and the rest
+	// is sent to the escrow account.
+	RevenueSplit []RevenueShare `protobuf:"bytes,14,rep,name=revenue_split,json=revenueSplit,proto3" json:"revenue_split"`
+	// require_searcher_registration specifies whether only registered searchers
+	// with at least min_searcher_collateral bonded can bid in the auction.
+	RequireSearcherRegistration bool `protobuf:"varint,15,opt,name=require_searcher_registration,json=requireSearcherRegistration,proto3" json:"require_searcher_registration,omitempty"`
+	// min_searcher_collateral is the minimum collateral that a searcher must
+	// have bonded to bid in the auction when registration is required.
+	MinSearcherCollateral types.Coin `protobuf:"bytes,16,opt,name=min_searcher_collateral,json=minSearcherCollateral,proto3" json:"min_searcher_collateral"`
+	// reward_payout_interval is the number of blocks between payouts of the
+	// auction proceeds. The proceeds are accrued in the builder module account
+	// and paid out in the end blocker of every block whose height is a multiple
+	// of the interval. A value of zero pays out the proceeds immediately.
+	RewardPayoutInterval uint64 `protobuf:"varint,18,opt,name=reward_payout_interval,json=rewardPayoutInterval,proto3" json:"reward_payout_interval,omitempty"`
+	// claimable_proposer_rewards specifies whether the proposer's portion of the
+	// auction proceeds is accrued for the validator that proposed the block
+	// instead of being sent to its rewards address. Validators withdraw the
+	// accrued rewards with MsgWithdrawBuilderRewards.
+	ClaimableProposerRewards bool `protobuf:"varint,19,opt,name=claimable_proposer_rewards,json=claimableProposerRewards,proto3" json:"claimable_proposer_rewards,omitempty"`
+	// searcher_unbonding_period is the number of blocks after which collateral
+	// that a registered searcher unbonds can be withdrawn.
+	SearcherUnbondingPeriod uint64 `protobuf:"varint,20,opt,name=searcher_unbonding_period,json=searcherUnbondingPeriod,proto3" json:"searcher_unbonding_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -161,211 +335,5604 @@ func (m *Params) GetFrontRunningProtection() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "pob.builder.v1.GenesisState")
-	proto.RegisterType((*Params)(nil), "pob.builder.v1.Params")
+func (m *Params) GetAuctionResultRetention() uint64 {
+	if m != nil {
+		return m.AuctionResultRetention
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("pob/builder/v1/genesis.proto", fileDescriptor_287f1bdff5ccfc33) }
+func (m *Params) GetBidDenoms() []BidDenom {
+	if m != nil {
+		return m.BidDenoms
+	}
+	return nil
+}
 
-var fileDescriptor_287f1bdff5ccfc33 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x5a, 0x22, 0xba, 0x49, 0xa9, 0x6a, 0x55, 0x91, 0x5b, 0x90, 0x1b, 0xf5, 0x00,
-	0x51, 0xa5, 0xec, 0x2a, 0x80, 0x10, 0xe2, 0x96, 0x10, 0x8a, 0x90, 0x38, 0x44, 0xee, 0x09, 0x2e,
-	0xd6, 0x7a, 0x3d, 0x75, 0x57, 0xe9, 0xee, 0x5a, 0xbb, 0x1b, 0x93, 0xf6, 0x11, 0x38, 0xf1, 0x18,
-	0x1c, 0x7b, 0x40, 0x3c, 0x43, 0x8f, 0x15, 0x27, 0xc4, 0xa1, 0x42, 0xc9, 0xa1, 0xaf, 0x81, 0xec,
-	0x75, 0x8b, 0x38, 0xf6, 0x62, 0xd9, 0xf3, 0xcd, 0xfc, 0xf3, 0xcf, 0x78, 0xd0, 0xe3, 0x5c, 0x25,
-	0x24, 0x99, 0xf1, 0x93, 0x14, 0x34, 0x29, 0x06, 0x24, 0x03, 0x09, 0x86, 0x1b, 0x9c, 0x6b, 0x65,
-	0x95, 0xff, 0x30, 0x57, 0x09, 0xae, 0x29, 0x2e, 0x06, 0x3b, 0x5b, 0x99, 0xca, 0x54, 0x85, 0x48,
-	0xf9, 0xe6, 0xb2, 0x76, 0x42, 0xa6, 0x8c, 0x50, 0x86, 0x24, 0xd4, 0x00, 0x29, 0x06, 0x09, 0x58,
-	0x3a, 0x20, 0x4c, 0x71, 0x59, 0xf3, 0x4d, 0x2a, 0xb8, 0x54, 0xa4, 0x7a, 0xd6, 0xa1, 0x6d, 0x57,
-	0x12, 0x3b, 0x2d, 0xf7, 0xe1, 0xd0, 0xde, 0x18, 0xb5, 0xdf, 0x39, 0x13, 0x87, 0x96, 0x5a, 0xf0,
-	0x5f, 0xa0, 0x66, 0x4e, 0x35, 0x15, 0x26, 0xf0, 0xba, 0x5e, 0xaf, 0xf5, 0xac, 0x83, 0xff, 0x37,
-	0x85, 0x27, 0x15, 0x1d, 0xad, 0x5e, 0x5c, 0xed, 0x36, 0xa2, 0x3a, 0x77, 0xef, 0xc7, 0x0a, 0x6a,
-	0x3a, 0xe0, 0x3f, 0x41, 0x1b, 0x82, 0xce, 0xe3, 0x64, 0x26, 0xd3, 0x13, 0x88, 0x0d, 0x3f, 0x83,
-	0x4a, 0x69, 0x3d, 0x5a, 0x17, 0x74, 0x3e, 0xaa, 0xa2, 0x87, 0xfc, 0xac, 0x6c, 0xd4, 0x01, 0xc3,
-	0xb4, 0xfa, 0x1c, 0x53, 0xc6, 0xd4, 0x4c, 0xda, 0x98, 0xa6, 0xa9, 0x06, 0x63, 0x82, 0x7b, 0x5d,
-	0xaf, 0xd7, 0x8e, 0xb6, 0x1c, 0x1d, 0x3a, 0x38, 0x74, 0xcc, 0x7f, 0x8b, 0x5a, 0x1a, 0x0c, 0xe8,
-	0x02, 0xe2, 0x23, 0x80, 0x60, 0xa5, 0xf2, 0xb8, 0x8d, 0xeb, 0x91, 0xca, 0x95, 0xe0, 0x7a, 0x25,
-	0xf8, 0x8d, 0xe2, 0x72, 0xb4, 0x56, 0xda, 0xfc, 0x76, 0x7d, 0xbe, 0xef, 0x45, 0xa8, 0x2e, 0x3c,
-	0x00, 0xf0, 0x27, 0x68, 0x53, 0x70, 0x19, 0x27, 0x3c, 0x8d, 0xb9, 0x64, 0x1a, 0x04, 0x48, 0x1b,
-	0xac, 0xde, 0x41, 0x6c, 0x43, 0x70, 0x39, 0xe2, 0xe9, 0xfb, 0x9b, 0x62, 0xff, 0x15, 0x0a, 0x8e,
-	0xb4, 0x92, 0x36, 0xd6, 0x33, 0x29, 0xb9, 0xcc, 0xaa, 0x5d, 0x03, 0xb3, 0x5c, 0xc9, 0xe0, 0x7e,
-	0xd7, 0xeb, 0x3d, 0x88, 0x3a, 0x15, 0x8f, 0x1c, 0x9e, 0xdc, 0x52, 0xff, 0x23, 0x6a, 0xe7, 0x5a,
-	0xe5, 0xca, 0x80, 0xae, 0x66, 0x6a, 0x76, 0xbd, 0xde, 0xda, 0xe8, 0x65, 0xd9, 0xeb, 0xf7, 0xd5,
-	0xee, 0x23, 0xe7, 0xc6, 0xa4, 0x53, 0xcc, 0x15, 0x11, 0xd4, 0x1e, 0xe3, 0x0f, 0x90, 0x51, 0x76,
-	0x3a, 0x06, 0xf6, 0xf3, 0x7b, 0x1f, 0xd5, 0x66, 0xc7, 0xc0, 0x9c, 0xb1, 0xd6, 0x8d, 0xd6, 0x01,
-	0xc0, 0xeb, 0xee, 0x97, 0xeb, 0xf3, 0xfd, 0x5a, 0xa1, 0x6f, 0xd2, 0x29, 0x99, 0xdf, 0x1e, 0x5f,
-	0xfd, 0x1b, 0x87, 0x17, 0x8b, 0xd0, 0xbb, 0x5c, 0x84, 0xde, 0x9f, 0x45, 0xe8, 0x7d, 0x5d, 0x86,
-	0x8d, 0xcb, 0x65, 0xd8, 0xf8, 0xb5, 0x0c, 0x1b, 0x9f, 0x9e, 0x66, 0xdc, 0x1e, 0xcf, 0x12, 0xcc,
-	0x94, 0x20, 0x66, 0xca, 0xf3, 0xbe, 0x80, 0x82, 0x94, 0xe7, 0xfb, 0x4f, 0xc3, 0x9e, 0xe6, 0x60,
-	0x92, 0x66, 0x75, 0x48, 0xcf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xb6, 0x7e, 0x29, 0xdc,
-	0x02, 0x00, 0x00,
+func (m *Params) GetMaxBundlesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxBundlesPerBlock
+	}
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetRevealWindow() uint64 {
+	if m != nil {
+		return m.RevealWindow
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMinCommitmentCollateral() types.Coin {
+	if m != nil {
+		return m.MinCommitmentCollateral
+	}
+	return types.Coin{}
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *Params) GetPricingRule() PricingRule {
+	if m != nil {
+		return m.PricingRule
+	}
+	return PricingRule_PRICING_RULE_FIRST_PRICE
+}
+
+func (m *Params) GetRevenueSplit() []RevenueShare {
+	if m != nil {
+		return m.RevenueSplit
+	}
+	return nil
+}
+
+func (m *Params) GetRequireSearcherRegistration() bool {
+	if m != nil {
+		return m.RequireSearcherRegistration
+	}
+	return false
+}
+
+func (m *Params) GetMinSearcherCollateral() types.Coin {
+	if m != nil {
+		return m.MinSearcherCollateral
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetRewardPayoutInterval() uint64 {
+	if m != nil {
+		return m.RewardPayoutInterval
+	}
+	return 0
+}
+
+func (m *Params) GetClaimableProposerRewards() bool {
+	if m != nil {
+		return m.ClaimableProposerRewards
+	}
+	return false
+}
+
+func (m *Params) GetSearcherUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.SearcherUnbondingPeriod
+	}
+	return 0
+}
+
+// RevenueShare defines the portion of the auction proceeds that is sent to a
+// recipient.
+type RevenueShare struct {
+	// recipient is either the address of an account or one of the special
+	// recipients: "proposer", "escrow", "community_pool", "burn" or
+	// "fee_collector".
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// weight is the portion of the auction proceeds sent to the recipient.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *RevenueShare) Reset()         { *m = RevenueShare{} }
+func (m *RevenueShare) String() string { return proto.CompactTextString(m) }
+func (*RevenueShare) ProtoMessage()    {}
+func (*RevenueShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{2}
+}
+func (m *RevenueShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevenueShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevenueShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *RevenueShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevenueShare.Merge(m, src)
+}
+func (m *RevenueShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevenueShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevenueShare.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RevenueShare proto.InternalMessageInfo
+
+func (m *RevenueShare) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BidDenom defines the auction fees for a denomination that may be used to bid
+// in the auction.
+type BidDenom struct {
+	// reserve_fee specifies the bid floor for bids in this denomination.
+	ReserveFee types.Coin `protobuf:"bytes,1,opt,name=reserve_fee,json=reserveFee,proto3" json:"reserve_fee"`
+	// min_bid_increment specifies the minimum amount that a bid in this
+	// denomination must be greater than the previous bid.
+	MinBidIncrement types.Coin `protobuf:"bytes,2,opt,name=min_bid_increment,json=minBidIncrement,proto3" json:"min_bid_increment"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ProposerFee.Size()
-		i -= size
-		if _, err := m.ProposerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *BidDenom) Reset()         { *m = BidDenom{} }
+func (m *BidDenom) String() string { return proto.CompactTextString(m) }
+func (*BidDenom) ProtoMessage()    {}
+func (*BidDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{3}
+}
+func (m *BidDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.FrontRunningProtection {
-		i--
-		if m.FrontRunningProtection {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+}
+func (m *BidDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidDenom.Merge(m, src)
+}
+func (m *BidDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidDenom proto.InternalMessageInfo
+
+func (m *BidDenom) GetReserveFee() types.Coin {
+	if m != nil {
+		return m.ReserveFee
 	}
-	{
-		size, err := m.MinBidIncrement.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+func (m *BidDenom) GetMinBidIncrement() types.Coin {
+	if m != nil {
+		return m.MinBidIncrement
+	}
+	return types.Coin{}
+}
+
+// AuctionResult defines the outcome of the top-of-block auction at a given
+// block height.
+type AuctionResult struct {
+	// height is the block height at which the winning bid was executed.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// bidder is the address of the account that won the auction.
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid is the amount of the winning bid.
+	Bid types.Coin `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid"`
+	// proposer_reward is the portion of the bid that was paid to the proposer.
+	ProposerReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=proposer_reward,json=proposerReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proposer_reward"`
+	// escrow_reward is the portion of the bid that was paid to the escrow
+	// account.
+	EscrowReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=escrow_reward,json=escrowReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow_reward"`
+	// bundled_tx_hashes are the hex-encoded hashes of the bundled transactions
+	// in the order they were executed.
+	BundledTxHashes []string `protobuf:"bytes,6,rep,name=bundled_tx_hashes,json=bundledTxHashes,proto3" json:"bundled_tx_hashes,omitempty"`
+	// bundle_index is the position of the winning bundle amongst all of the
+	// winning bundles included in the block.
+	BundleIndex uint32 `protobuf:"varint,7,opt,name=bundle_index,json=bundleIndex,proto3" json:"bundle_index,omitempty"`
+	// price is the amount that the bidder paid for the winning bundle. The price
+	// is equal to the bid unless the second price pricing rule is used. It is not
+	// set for results that were recorded before the price was tracked.
+	Price *types.Coin `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	// refund is the amount that was refunded to the bidder because some of the
+	// bundled transactions failed while revert protection was enabled.
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
+	// proposer is the consensus address of the validator that proposed the
+	// block. It is not set for results that were recorded before the proposer
+	// was tracked.
+	Proposer string `protobuf:"bytes,10,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *AuctionResult) Reset()         { *m = AuctionResult{} }
+func (m *AuctionResult) String() string { return proto.CompactTextString(m) }
+func (*AuctionResult) ProtoMessage()    {}
+func (*AuctionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{4}
+}
+func (m *AuctionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.ReserveFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+}
+func (m *AuctionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionResult.Merge(m, src)
+}
+func (m *AuctionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionResult proto.InternalMessageInfo
+
+func (m *AuctionResult) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuctionResult) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *AuctionResult) GetBid() types.Coin {
+	if m != nil {
+		return m.Bid
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionResult) GetProposerReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetEscrowReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EscrowReward
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetBundledTxHashes() []string {
+	if m != nil {
+		return m.BundledTxHashes
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetBundleIndex() uint32 {
+	if m != nil {
+		return m.BundleIndex
+	}
+	return 0
+}
+
+func (m *AuctionResult) GetPrice() *types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *AuctionResult) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// BidCommitment defines a sealed bid that has been committed to but not yet
+// revealed.
+type BidCommitment struct {
+	// bidder is the address of the account that committed to the bid.
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment is the hash of the sealed bid.
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// collateral is the amount of coins locked until the bid is revealed.
+	Collateral types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+	// height is the block height at which the commitment was included.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// expiration_height is the last block height at which the bid can be
+	// revealed.
+	ExpirationHeight uint64 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// revealed is true if the bid was revealed in the auction info of a block
+	// within the reveal window without winning the auction. The collateral of
+	// revealed commitments is returned rather than slashed once they expire.
+	Revealed bool `protobuf:"varint,6,opt,name=revealed,proto3" json:"revealed,omitempty"`
+}
+
+func (m *BidCommitment) Reset()         { *m = BidCommitment{} }
+func (m *BidCommitment) String() string { return proto.CompactTextString(m) }
+func (*BidCommitment) ProtoMessage()    {}
+func (*BidCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{5}
+}
+func (m *BidCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidCommitment.Merge(m, src)
+}
+func (m *BidCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidCommitment proto.InternalMessageInfo
+
+func (m *BidCommitment) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *BidCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *BidCommitment) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *BidCommitment) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BidCommitment) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *BidCommitment) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+// AuctionSettlement defines the outcome of the top-of-block auction that is
+// included by the proposer when the second price pricing rule is used. It is
+// placed directly after the winning bundles in the block proposal and is not a
+// transaction.
+type AuctionSettlement struct {
+	// runner_up_bid is the highest valid bid that did not win the auction. It is
+	// empty if there is no such bid.
+	RunnerUpBid types.Coin `protobuf:"bytes,1,opt,name=runner_up_bid,json=runnerUpBid,proto3" json:"runner_up_bid"`
+	// runner_up_bid_tx_hash is the SHA-256 hash of the bid transaction of the
+	// runner-up bid, which is one of the bids of the auction info. It is empty if
+	// there is no runner-up bid.
+	RunnerUpBidTxHash []byte `protobuf:"bytes,2,opt,name=runner_up_bid_tx_hash,json=runnerUpBidTxHash,proto3" json:"runner_up_bid_tx_hash,omitempty"`
+}
+
+func (m *AuctionSettlement) Reset()         { *m = AuctionSettlement{} }
+func (m *AuctionSettlement) String() string { return proto.CompactTextString(m) }
+func (*AuctionSettlement) ProtoMessage()    {}
+func (*AuctionSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{6}
+}
+func (m *AuctionSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionSettlement.Merge(m, src)
+}
+func (m *AuctionSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionSettlement proto.InternalMessageInfo
+
+func (m *AuctionSettlement) GetRunnerUpBid() types.Coin {
+	if m != nil {
+		return m.RunnerUpBid
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionSettlement) GetRunnerUpBidTxHash() []byte {
+	if m != nil {
+		return m.RunnerUpBidTxHash
+	}
+	return nil
+}
+
+// AuctionInfo contains the bids of the top-of-block auction that is run over the
+// vote extensions of the previous block. It is injected as the first entry of a
+// block proposal so that validators can verify that the proposer ran the auction
+// correctly. It is not a transaction.
+type AuctionInfo struct {
+	// bid_txs are the deduplicated bid transactions of the vote extensions that
+	// were used to run the auction, sorted by their hash.
+	BidTxs [][]byte `protobuf:"bytes,1,rep,name=bid_txs,json=bidTxs,proto3" json:"bid_txs,omitempty"`
+	// max_tx_bytes is the maximum number of bytes that were allowed for the
+	// proposal.
+	MaxTxBytes int64 `protobuf:"varint,2,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty"`
+	// num_txs is the number of transactions that were included in the proposal.
+	NumTxs uint64 `protobuf:"varint,3,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+	// failed_bid_tx_hashes are the hashes of the bid transactions of the auction
+	// info that failed verification when the auction was run, in the order in
+	// which they were considered.
+	FailedBidTxHashes [][]byte `protobuf:"bytes,4,rep,name=failed_bid_tx_hashes,json=failedBidTxHashes,proto3" json:"failed_bid_tx_hashes,omitempty"`
+	// extended_commit_info is the extended commit info of the previous block
+	// whose vote extensions include the bids of the auction. Validators verify
+	// the vote extensions and rebuild the bids from them.
+	ExtendedCommitInfo []byte `protobuf:"bytes,5,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info,omitempty"`
+}
+
+func (m *AuctionInfo) Reset()         { *m = AuctionInfo{} }
+func (m *AuctionInfo) String() string { return proto.CompactTextString(m) }
+func (*AuctionInfo) ProtoMessage()    {}
+func (*AuctionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{7}
+}
+func (m *AuctionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionInfo.Merge(m, src)
+}
+func (m *AuctionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionInfo proto.InternalMessageInfo
+
+func (m *AuctionInfo) GetBidTxs() [][]byte {
+	if m != nil {
+		return m.BidTxs
+	}
+	return nil
+}
+
+func (m *AuctionInfo) GetMaxTxBytes() int64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+func (m *AuctionInfo) GetNumTxs() uint64 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (m *AuctionInfo) GetFailedBidTxHashes() [][]byte {
+	if m != nil {
+		return m.FailedBidTxHashes
+	}
+	return nil
+}
+
+func (m *AuctionInfo) GetExtendedCommitInfo() []byte {
+	if m != nil {
+		return m.ExtendedCommitInfo
+	}
+	return nil
+}
+
+// Searcher defines a searcher that is registered with the builder module.
+type Searcher struct {
+	// address is the address of the searcher.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// collateral is the amount of coins bonded by the searcher. It must be at
+	// least the minimum searcher collateral to bid when registration is
+	// required.
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
+	// bids_won is the number of winning bids of the searcher.
+	BidsWon uint64 `protobuf:"varint,3,opt,name=bids_won,json=bidsWon,proto3" json:"bids_won,omitempty"`
+	// bundles_failed is the number of bids of the searcher that failed
+	// verification in the auction that was run over vote extensions.
+	BundlesFailed uint64 `protobuf:"varint,4,opt,name=bundles_failed,json=bundlesFailed,proto3" json:"bundles_failed,omitempty"`
+	// unbonding is the collateral that the searcher is unbonding. It can be
+	// withdrawn once the searcher unbonding period has passed.
+	Unbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=unbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unbonding"`
+	// unbonding_completion_height is the block height from which the unbonding
+	// collateral can be withdrawn.
+	UnbondingCompletionHeight uint64 `protobuf:"varint,6,opt,name=unbonding_completion_height,json=unbondingCompletionHeight,proto3" json:"unbonding_completion_height,omitempty"`
+}
+
+func (m *Searcher) Reset()         { *m = Searcher{} }
+func (m *Searcher) String() string { return proto.CompactTextString(m) }
+func (*Searcher) ProtoMessage()    {}
+func (*Searcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{8}
+}
+func (m *Searcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Searcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Searcher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Searcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Searcher.Merge(m, src)
+}
+func (m *Searcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *Searcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_Searcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Searcher proto.InternalMessageInfo
+
+func (m *Searcher) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Searcher) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *Searcher) GetBidsWon() uint64 {
+	if m != nil {
+		return m.BidsWon
+	}
+	return 0
+}
+
+func (m *Searcher) GetBundlesFailed() uint64 {
+	if m != nil {
+		return m.BundlesFailed
+	}
+	return 0
+}
+
+func (m *Searcher) GetUnbonding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unbonding
+	}
+	return nil
+}
+
+func (m *Searcher) GetUnbondingCompletionHeight() uint64 {
+	if m != nil {
+		return m.UnbondingCompletionHeight
+	}
+	return 0
+}
+
+// PendingReward defines the auction proceeds that have been accrued for a
+// recipient but not paid out yet.
+type PendingReward struct {
+	// address is the address of the recipient.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount of coins that will be paid out to the recipient.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *PendingReward) Reset()         { *m = PendingReward{} }
+func (m *PendingReward) String() string { return proto.CompactTextString(m) }
+func (*PendingReward) ProtoMessage()    {}
+func (*PendingReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{9}
+}
+func (m *PendingReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingReward.Merge(m, src)
+}
+func (m *PendingReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingReward proto.InternalMessageInfo
+
+func (m *PendingReward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ValidatorRewards defines the proposer rewards that have been accrued by a
+// validator but not withdrawn yet.
+type ValidatorRewards struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rewards is the amount of coins accrued by the validator.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{10}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// RewardsWithdrawAddress defines the address that a validator withdraws its
+// proposer rewards to.
+type RewardsWithdrawAddress struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdraw_address is the address that receives the withdrawn rewards.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *RewardsWithdrawAddress) Reset()         { *m = RewardsWithdrawAddress{} }
+func (m *RewardsWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*RewardsWithdrawAddress) ProtoMessage()    {}
+func (*RewardsWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{11}
+}
+func (m *RewardsWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsWithdrawAddress.Merge(m, src)
+}
+func (m *RewardsWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsWithdrawAddress proto.InternalMessageInfo
+
+func (m *RewardsWithdrawAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RewardsWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// CensorshipReport defines a verified report that the proposer of a block
+// omitted a bid from the auction info of the block although the bid was
+// included in the vote extension of a validator in the commit of the previous
+// block.
+type CensorshipReport struct {
+	// height is the block height at which the bid was censored.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// proposer is the consensus address of the validator that proposed the
+	// block.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// bidder is the address of the account whose bid was censored.
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid is the amount of the censored bid.
+	Bid types.Coin `protobuf:"bytes,4,opt,name=bid,proto3" json:"bid"`
+	// bid_tx_hash is the hex-encoded hash of the censored bid transaction.
+	BidTxHash string `protobuf:"bytes,5,opt,name=bid_tx_hash,json=bidTxHash,proto3" json:"bid_tx_hash,omitempty"`
+	// validator_address is the consensus address of the validator whose vote
+	// extension included the censored bid.
+	ValidatorAddress string `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *CensorshipReport) Reset()         { *m = CensorshipReport{} }
+func (m *CensorshipReport) String() string { return proto.CompactTextString(m) }
+func (*CensorshipReport) ProtoMessage()    {}
+func (*CensorshipReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{12}
+}
+func (m *CensorshipReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CensorshipReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CensorshipReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CensorshipReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CensorshipReport.Merge(m, src)
+}
+func (m *CensorshipReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *CensorshipReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CensorshipReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CensorshipReport proto.InternalMessageInfo
+
+func (m *CensorshipReport) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CensorshipReport) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *CensorshipReport) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *CensorshipReport) GetBid() types.Coin {
+	if m != nil {
+		return m.Bid
+	}
+	return types.Coin{}
+}
+
+func (m *CensorshipReport) GetBidTxHash() string {
+	if m != nil {
+		return m.BidTxHash
+	}
+	return ""
+}
+
+func (m *CensorshipReport) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// CensorshipScore defines the number of blocks in which a validator was
+// reported to have censored a bid.
+type CensorshipScore struct {
+	// validator_address is the consensus address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// score is the number of blocks proposed by the validator for which at least
+	// one censored bid was reported.
+	Score uint64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// last_report_height is the height of the most recent block in which the
+	// validator was reported to have censored a bid.
+	LastReportHeight uint64 `protobuf:"varint,3,opt,name=last_report_height,json=lastReportHeight,proto3" json:"last_report_height,omitempty"`
+}
+
+func (m *CensorshipScore) Reset()         { *m = CensorshipScore{} }
+func (m *CensorshipScore) String() string { return proto.CompactTextString(m) }
+func (*CensorshipScore) ProtoMessage()    {}
+func (*CensorshipScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{13}
+}
+func (m *CensorshipScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CensorshipScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CensorshipScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CensorshipScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CensorshipScore.Merge(m, src)
+}
+func (m *CensorshipScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *CensorshipScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_CensorshipScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CensorshipScore proto.InternalMessageInfo
+
+func (m *CensorshipScore) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *CensorshipScore) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *CensorshipScore) GetLastReportHeight() uint64 {
+	if m != nil {
+		return m.LastReportHeight
+	}
+	return 0
+}
+
+// AuctionEvidence defines the vote extension auction of a block that is
+// retained so that bids that were omitted from the auction can be reported.
+type AuctionEvidence struct {
+	// height is the height of the block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// proposer is the consensus address of the validator that proposed the
+	// block.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// round is the round of the commit of the previous block in which the vote
+	// extensions were signed.
+	Round int32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// bid_tx_hashes are the hashes of the bid transactions of the auction info
+	// of the block.
+	BidTxHashes [][]byte `protobuf:"bytes,4,rep,name=bid_tx_hashes,json=bidTxHashes,proto3" json:"bid_tx_hashes,omitempty"`
+	// validators are the consensus addresses of the validators whose votes, and
+	// therefore vote extensions, were included in the commit of the previous
+	// block.
+	Validators []string `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *AuctionEvidence) Reset()         { *m = AuctionEvidence{} }
+func (m *AuctionEvidence) String() string { return proto.CompactTextString(m) }
+func (*AuctionEvidence) ProtoMessage()    {}
+func (*AuctionEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_287f1bdff5ccfc33, []int{14}
+}
+func (m *AuctionEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionEvidence.Merge(m, src)
+}
+func (m *AuctionEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionEvidence proto.InternalMessageInfo
+
+func (m *AuctionEvidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuctionEvidence) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *AuctionEvidence) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AuctionEvidence) GetBidTxHashes() [][]byte {
+	if m != nil {
+		return m.BidTxHashes
+	}
+	return nil
+}
+
+func (m *AuctionEvidence) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pob.builder.v1.PricingRule", PricingRule_name, PricingRule_value)
+	proto.RegisterType((*GenesisState)(nil), "pob.builder.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "pob.builder.v1.Params")
+	proto.RegisterType((*RevenueShare)(nil), "pob.builder.v1.RevenueShare")
+	proto.RegisterType((*BidDenom)(nil), "pob.builder.v1.BidDenom")
+	proto.RegisterType((*AuctionResult)(nil), "pob.builder.v1.AuctionResult")
+	proto.RegisterType((*BidCommitment)(nil), "pob.builder.v1.BidCommitment")
+	proto.RegisterType((*AuctionSettlement)(nil), "pob.builder.v1.AuctionSettlement")
+	proto.RegisterType((*AuctionInfo)(nil), "pob.builder.v1.AuctionInfo")
+	proto.RegisterType((*Searcher)(nil), "pob.builder.v1.Searcher")
+	proto.RegisterType((*PendingReward)(nil), "pob.builder.v1.PendingReward")
+	proto.RegisterType((*ValidatorRewards)(nil), "pob.builder.v1.ValidatorRewards")
+	proto.RegisterType((*RewardsWithdrawAddress)(nil), "pob.builder.v1.RewardsWithdrawAddress")
+	proto.RegisterType((*CensorshipReport)(nil), "pob.builder.v1.CensorshipReport")
+	proto.RegisterType((*CensorshipScore)(nil), "pob.builder.v1.CensorshipScore")
+	proto.RegisterType((*AuctionEvidence)(nil), "pob.builder.v1.AuctionEvidence")
+}
+
+func init() { proto.RegisterFile("pob/builder/v1/genesis.proto", fileDescriptor_287f1bdff5ccfc33) }
+
+var fileDescriptor_287f1bdff5ccfc33 = []byte{
+	// 1969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x4a, 0x22, 0x4d, 0x3e, 0x92, 0x22, 0x39, 0x95, 0xe5, 0x95, 0x3f, 0x64, 0x86, 0x45,
+	0x53, 0xc1, 0xad, 0x48, 0xcb, 0x4d, 0x83, 0x20, 0x48, 0x03, 0x88, 0x92, 0x1c, 0x2b, 0x30, 0x5c,
+	0x62, 0x69, 0xd7, 0xe8, 0x07, 0xb0, 0xdd, 0x8f, 0x91, 0x38, 0xf0, 0xee, 0xec, 0x76, 0x66, 0x49,
+	0xd1, 0x41, 0xff, 0x80, 0xa2, 0x97, 0xf6, 0x5e, 0xf4, 0x54, 0x14, 0x08, 0x0a, 0x14, 0xc8, 0xc1,
+	0xff, 0x41, 0x7b, 0x48, 0x6f, 0x81, 0x4f, 0x45, 0x0f, 0x49, 0x61, 0xa3, 0xc8, 0x7f, 0xd0, 0x73,
+	0x31, 0x1f, 0xbb, 0x5c, 0xd2, 0x4a, 0x25, 0x3a, 0xce, 0x85, 0xe0, 0xbe, 0xaf, 0x79, 0xf3, 0xde,
+	0x9b, 0xdf, 0x7b, 0x33, 0x70, 0x2d, 0x8e, 0xdc, 0xae, 0x3b, 0x22, 0x81, 0x8f, 0x59, 0x77, 0xbc,
+	0xd3, 0x3d, 0xc6, 0x14, 0x73, 0xc2, 0x3b, 0x31, 0x8b, 0x92, 0x08, 0xad, 0xc6, 0x91, 0xdb, 0xd1,
+	0xdc, 0xce, 0x78, 0xe7, 0xca, 0xda, 0x71, 0x74, 0x1c, 0x49, 0x56, 0x57, 0xfc, 0x53, 0x52, 0x57,
+	0x36, 0xbd, 0x88, 0x87, 0x11, 0xef, 0xba, 0x0e, 0xc7, 0xdd, 0xf1, 0x8e, 0x8b, 0x13, 0x67, 0xa7,
+	0xeb, 0x45, 0x84, 0x6a, 0x7e, 0xd3, 0x09, 0x09, 0x8d, 0xba, 0xf2, 0x57, 0x93, 0x36, 0x94, 0x8a,
+	0xad, 0x6c, 0xa9, 0x0f, 0xc5, 0x6a, 0xff, 0xa1, 0x08, 0xd5, 0x0f, 0x94, 0x17, 0x83, 0xc4, 0x49,
+	0x30, 0x7a, 0x0b, 0x8a, 0xb1, 0xc3, 0x9c, 0x90, 0x9b, 0x46, 0xcb, 0xd8, 0xaa, 0xdc, 0x5e, 0xef,
+	0xcc, 0x7a, 0xd5, 0xe9, 0x4b, 0x6e, 0x6f, 0xe5, 0xd3, 0xcf, 0x6f, 0x5c, 0xb0, 0xb4, 0x2c, 0xba,
+	0x07, 0x75, 0x67, 0xe4, 0x25, 0x24, 0xa2, 0x36, 0xc3, 0x7c, 0x14, 0x24, 0xdc, 0x5c, 0x6a, 0x2d,
+	0x6f, 0x55, 0x6e, 0x5f, 0x9f, 0x57, 0xdf, 0x55, 0x62, 0x96, 0x94, 0xd2, 0x56, 0x56, 0x9d, 0x3c,
+	0x51, 0x5a, 0x73, 0x89, 0x6f, 0x7b, 0x51, 0x18, 0x92, 0x24, 0xc4, 0x34, 0xe1, 0xe6, 0xf2, 0xe9,
+	0xd6, 0x7a, 0xc4, 0xdf, 0xcb, 0xa4, 0x52, 0x6b, 0x6e, 0x9e, 0xc8, 0xd1, 0x7b, 0x50, 0xe6, 0xd8,
+	0x61, 0xde, 0x10, 0x33, 0x6e, 0xae, 0x48, 0x3b, 0xe6, 0xbc, 0x9d, 0x81, 0x16, 0xd0, 0x26, 0xa6,
+	0x0a, 0xc2, 0x97, 0x18, 0x53, 0x9f, 0xd0, 0x63, 0x9b, 0xe1, 0x13, 0x87, 0xf9, 0xdc, 0x2c, 0x9c,
+	0xee, 0x4b, 0x5f, 0x89, 0x59, 0x52, 0x2a, 0xf5, 0x25, 0xce, 0x13, 0x39, 0x1a, 0x40, 0x73, 0xec,
+	0x04, 0xc4, 0x77, 0x92, 0x88, 0x65, 0xf6, 0x8a, 0xd2, 0x5e, 0x6b, 0xde, 0xde, 0x4f, 0x52, 0x41,
+	0xad, 0xac, 0x4d, 0x36, 0xc6, 0x73, 0x74, 0xf4, 0x73, 0x40, 0x27, 0x24, 0x19, 0xfa, 0xcc, 0x39,
+	0xb1, 0x1d, 0xdf, 0x67, 0x98, 0x73, 0xcc, 0xcd, 0x8b, 0xd2, 0xea, 0x9b, 0xf3, 0x56, 0xb5, 0xd2,
+	0x23, 0xad, 0xb0, 0xab, 0xe4, 0xb5, 0xed, 0xe6, 0xc9, 0x2c, 0x19, 0x73, 0xf4, 0x10, 0x90, 0x87,
+	0x29, 0x8f, 0x18, 0x1f, 0x92, 0xd8, 0x66, 0x38, 0x8e, 0x58, 0xc2, 0xcd, 0xd2, 0xe9, 0x2e, 0xef,
+	0x65, 0x92, 0x96, 0x14, 0x4c, 0xcd, 0x7a, 0x73, 0x74, 0x8e, 0x2c, 0xc8, 0x11, 0x6d, 0xee, 0x45,
+	0x0c, 0x73, 0xb3, 0x2c, 0xad, 0xde, 0xf8, 0x6a, 0xab, 0x03, 0x21, 0x97, 0xc6, 0xc1, 0x9b, 0x25,
+	0x73, 0xd4, 0x87, 0x46, 0x5a, 0x84, 0x78, 0x4c, 0x7c, 0x4c, 0x3d, 0x6c, 0xc2, 0xe9, 0x26, 0x75,
+	0x15, 0x1e, 0x68, 0x31, 0x6d, 0xb2, 0xee, 0xcc, 0x92, 0xdb, 0x5f, 0x00, 0x14, 0x55, 0xbd, 0xa3,
+	0x37, 0xa1, 0x1e, 0x3a, 0x13, 0xdb, 0x1d, 0x51, 0x3f, 0xc0, 0x36, 0x27, 0x1f, 0x61, 0x79, 0x40,
+	0x6a, 0x56, 0x2d, 0x74, 0x26, 0x3d, 0x49, 0x1d, 0x90, 0x8f, 0xc4, 0xf9, 0x59, 0xc7, 0xdc, 0x63,
+	0xd1, 0x89, 0xed, 0x78, 0x5e, 0x34, 0xa2, 0x49, 0x9a, 0x12, 0x73, 0xa9, 0x65, 0x6c, 0x55, 0xad,
+	0x35, 0xc5, 0xdd, 0x55, 0x4c, 0x1d, 0x67, 0x74, 0x00, 0x15, 0x86, 0x39, 0x66, 0x63, 0x6c, 0x1f,
+	0x61, 0x6c, 0x2e, 0xcb, 0xa3, 0xb7, 0xd1, 0xd1, 0x47, 0x55, 0x1c, 0xf5, 0x8e, 0x3e, 0xea, 0x9d,
+	0xbd, 0x88, 0xd0, 0x5e, 0x59, 0xf8, 0xfb, 0xf1, 0x97, 0x9f, 0xdc, 0x34, 0x2c, 0xd0, 0x8a, 0x77,
+	0x30, 0x46, 0x7d, 0x68, 0x86, 0x84, 0xda, 0xe2, 0xf0, 0x10, 0xea, 0x31, 0x2c, 0x0e, 0x80, 0xb9,
+	0xb2, 0x80, 0xb1, 0x7a, 0x48, 0x68, 0x8f, 0xf8, 0x87, 0xa9, 0x32, 0x7a, 0x07, 0xcc, 0x23, 0x16,
+	0xd1, 0xc4, 0x66, 0x23, 0x4a, 0xc5, 0x21, 0x10, 0xb0, 0x81, 0x65, 0x94, 0xcc, 0x42, 0xcb, 0xd8,
+	0x2a, 0x59, 0xeb, 0x92, 0x6f, 0x29, 0x76, 0x3f, 0xe3, 0xa2, 0x9f, 0x42, 0x35, 0x66, 0x51, 0x1c,
+	0x71, 0xcc, 0xe4, 0x9e, 0x8a, 0x2d, 0x63, 0xab, 0xdc, 0x7b, 0x5b, 0xac, 0xf5, 0xaf, 0xcf, 0x6f,
+	0x5c, 0x55, 0xde, 0x70, 0xff, 0x71, 0x87, 0x44, 0xdd, 0xd0, 0x49, 0x86, 0x9d, 0x7b, 0xf8, 0xd8,
+	0xf1, 0x9e, 0xec, 0x63, 0xef, 0xd9, 0xd3, 0x6d, 0xd0, 0xce, 0xee, 0x63, 0x4f, 0x39, 0x56, 0x49,
+	0x6d, 0x89, 0x6d, 0xbe, 0x03, 0xe6, 0x2c, 0xda, 0xd8, 0x0c, 0x27, 0x98, 0x4a, 0xa7, 0x2e, 0xb6,
+	0x8c, 0xad, 0x15, 0x6b, 0x7d, 0x06, 0x51, 0xac, 0x94, 0x8b, 0x7a, 0x00, 0x22, 0x38, 0x3e, 0xa6,
+	0x51, 0x98, 0x56, 0xb1, 0x79, 0x0a, 0xa8, 0xec, 0x0b, 0x81, 0x7c, 0x60, 0xca, 0xae, 0x26, 0x72,
+	0xb4, 0x03, 0x97, 0xa6, 0x95, 0xc0, 0xed, 0x18, 0x33, 0xdb, 0x0d, 0x22, 0xef, 0xb1, 0x59, 0x96,
+	0xf5, 0x80, 0xb2, 0x7a, 0xe0, 0x7d, 0xcc, 0x7a, 0x82, 0x83, 0xbe, 0x0d, 0x35, 0x86, 0xc7, 0xd8,
+	0x09, 0xec, 0x13, 0x42, 0xfd, 0xe8, 0xc4, 0x04, 0xe9, 0x65, 0x55, 0x11, 0x1f, 0x49, 0x1a, 0xfa,
+	0x25, 0x6c, 0x88, 0xe4, 0x4d, 0x51, 0xcf, 0xf6, 0xa2, 0x20, 0x70, 0x12, 0xcc, 0x9c, 0xc0, 0xac,
+	0x2c, 0x90, 0xc4, 0xcb, 0x21, 0xa1, 0x53, 0x00, 0xdc, 0xcb, 0x8c, 0xa0, 0xf7, 0x45, 0x4a, 0x88,
+	0x27, 0xb1, 0x6c, 0x14, 0x60, 0xb3, 0xda, 0x32, 0xb6, 0x56, 0x6f, 0x5f, 0x7d, 0x09, 0xc8, 0x94,
+	0x8c, 0x35, 0x0a, 0xb0, 0x88, 0x7b, 0xf6, 0x81, 0x62, 0x30, 0x85, 0xc7, 0x2c, 0xc9, 0x55, 0x81,
+	0xcd, 0xf0, 0xd1, 0x88, 0xfa, 0x66, 0xed, 0x6b, 0xa5, 0x77, 0x5d, 0xd9, 0x9d, 0x96, 0x8f, 0x25,
+	0xad, 0xa2, 0x7b, 0x2a, 0x70, 0x74, 0x84, 0x6d, 0x1e, 0x07, 0x24, 0x31, 0x57, 0x65, 0xca, 0xae,
+	0xbd, 0x8c, 0x6a, 0x52, 0x68, 0x30, 0x74, 0x18, 0xce, 0x87, 0xa2, 0xaa, 0xb5, 0x07, 0x42, 0x19,
+	0xf5, 0xe0, 0x3a, 0xc3, 0xbf, 0x1a, 0x11, 0x86, 0xed, 0x14, 0xe0, 0x6d, 0x86, 0x8f, 0x09, 0x4f,
+	0x98, 0x23, 0x8b, 0xa7, 0x2e, 0x2b, 0xfa, 0xaa, 0x16, 0x4a, 0x9b, 0x82, 0x95, 0x13, 0x41, 0xbf,
+	0x00, 0x11, 0xde, 0xa9, 0x7e, 0x2e, 0x47, 0x8d, 0x05, 0x72, 0x74, 0x29, 0x24, 0x34, 0xb5, 0x9f,
+	0xcb, 0xd0, 0x5b, 0xb0, 0xae, 0xba, 0x82, 0x1d, 0x3b, 0x4f, 0xa2, 0x51, 0x62, 0x13, 0x9a, 0x60,
+	0x36, 0x76, 0x02, 0x13, 0xc9, 0x8a, 0x59, 0x53, 0xdc, 0xbe, 0x64, 0x1e, 0x6a, 0x1e, 0x7a, 0x0f,
+	0xae, 0x78, 0x81, 0x43, 0x42, 0xc7, 0x0d, 0xb0, 0x9d, 0x1d, 0xba, 0xb4, 0xbd, 0x7c, 0x4b, 0x6e,
+	0xca, 0xcc, 0x24, 0xfa, 0x5a, 0x20, 0x6d, 0x1f, 0xef, 0xc2, 0x46, 0xb6, 0x9b, 0x11, 0x75, 0x23,
+	0xd5, 0xec, 0x62, 0xcc, 0x48, 0xe4, 0x9b, 0x6b, 0x72, 0xd9, 0xcb, 0xa9, 0xc0, 0xc3, 0x94, 0xdf,
+	0x97, 0xec, 0x77, 0x5b, 0xbf, 0xfd, 0xf2, 0x93, 0x9b, 0x3a, 0xd9, 0xdb, 0xdc, 0x7f, 0xdc, 0x9d,
+	0x64, 0xe3, 0x8d, 0xc2, 0xcd, 0x0f, 0x57, 0x4a, 0xcd, 0x06, 0x9a, 0x1a, 0xb0, 0x79, 0xe0, 0xf0,
+	0xa1, 0x7d, 0xc4, 0x1c, 0x99, 0xe6, 0xf6, 0xaf, 0xa1, 0x9a, 0xcf, 0x1d, 0xba, 0x06, 0x65, 0x86,
+	0x3d, 0x12, 0x13, 0x81, 0x5c, 0x02, 0x60, 0xcb, 0xd6, 0x94, 0x80, 0xee, 0x43, 0xf1, 0x04, 0x93,
+	0xe3, 0x61, 0x22, 0xc1, 0xf4, 0xd5, 0xcb, 0x4d, 0x5b, 0x69, 0xff, 0xc9, 0x80, 0x52, 0x7a, 0xda,
+	0xe7, 0x31, 0xd8, 0x78, 0x9d, 0x18, 0xbc, 0xf4, 0x35, 0x30, 0xb8, 0xfd, 0xc7, 0x02, 0xd4, 0x66,
+	0xc6, 0x26, 0xb4, 0x0e, 0xc5, 0xa1, 0x8a, 0x83, 0x21, 0xf3, 0xa3, 0xbf, 0xd0, 0x2d, 0x28, 0xba,
+	0xc4, 0xf7, 0x31, 0xd3, 0xf1, 0x31, 0x9f, 0x3d, 0xdd, 0x5e, 0xd3, 0x6b, 0xea, 0x56, 0x33, 0x48,
+	0x98, 0x38, 0xce, 0x5a, 0x0e, 0xbd, 0x0d, 0xcb, 0x2e, 0xf1, 0x17, 0x6a, 0x38, 0x42, 0x01, 0x25,
+	0x50, 0x9f, 0x2b, 0x34, 0x3d, 0x5a, 0xfd, 0x1f, 0x1b, 0xb7, 0x84, 0x8d, 0xbf, 0x7c, 0x71, 0x63,
+	0xeb, 0x98, 0x24, 0xc3, 0x91, 0xdb, 0xf1, 0xa2, 0x50, 0x0f, 0xa3, 0xdd, 0x5c, 0x05, 0x25, 0x4f,
+	0x62, 0xcc, 0xa5, 0x02, 0xb7, 0x56, 0xe3, 0x99, 0x5a, 0x45, 0x31, 0xd4, 0x74, 0x73, 0xd5, 0x6b,
+	0x16, 0x5e, 0xff, 0x9a, 0x55, 0xb5, 0x82, 0x5e, 0xf1, 0x26, 0x34, 0x15, 0xd0, 0xfb, 0x76, 0x32,
+	0xb1, 0x87, 0x0e, 0x1f, 0x62, 0x35, 0xb0, 0x95, 0xad, 0xba, 0x66, 0x3c, 0x98, 0xdc, 0x95, 0x64,
+	0xf4, 0x06, 0x54, 0x15, 0xc9, 0x26, 0xd4, 0xc7, 0x13, 0xd9, 0x8a, 0x6a, 0x56, 0x45, 0xd1, 0x0e,
+	0x05, 0x09, 0x75, 0xa1, 0x20, 0x00, 0x15, 0x9b, 0xa5, 0x33, 0x02, 0x6e, 0x29, 0x39, 0xe4, 0x41,
+	0x51, 0x03, 0x6c, 0xf9, 0xf5, 0x6f, 0x55, 0x9b, 0x46, 0x3f, 0x82, 0x52, 0x1a, 0x68, 0xd9, 0x99,
+	0xca, 0xbd, 0x37, 0x9e, 0x3d, 0xdd, 0xbe, 0xae, 0x57, 0xda, 0x8b, 0x28, 0xc7, 0x94, 0x8f, 0xf8,
+	0x6c, 0x05, 0x65, 0x2a, 0xed, 0xdf, 0x2c, 0x41, 0x6d, 0x66, 0x10, 0xcf, 0xd5, 0xa1, 0x71, 0xce,
+	0x3a, 0xdc, 0x04, 0x98, 0x36, 0x3e, 0x3d, 0x2a, 0xe5, 0x28, 0x68, 0x5f, 0xf0, 0x33, 0xa4, 0x5d,
+	0x68, 0x3e, 0x9a, 0xea, 0xe5, 0xce, 0xcd, 0xca, 0xcc, 0xb9, 0xf9, 0x1e, 0x34, 0xf1, 0x24, 0x26,
+	0x0a, 0xe2, 0x6d, 0x2d, 0x52, 0x90, 0x22, 0x8d, 0x29, 0xe3, 0xae, 0x12, 0xbe, 0x02, 0x25, 0xd5,
+	0xb7, 0xb1, 0x2f, 0x87, 0x9a, 0x92, 0x95, 0x7d, 0xb7, 0x7f, 0x67, 0x40, 0x53, 0x1f, 0xd5, 0x01,
+	0x4e, 0x92, 0x40, 0x0d, 0x51, 0x77, 0xa1, 0x26, 0xc6, 0x27, 0x81, 0xaf, 0xb1, 0x00, 0x86, 0x85,
+	0xb0, 0xa5, 0xa2, 0x54, 0x1f, 0xc6, 0x3d, 0xe2, 0xa3, 0x5b, 0x70, 0x69, 0xc6, 0x52, 0x5a, 0x94,
+	0x3a, 0x62, 0xcd, 0x9c, 0xac, 0x2a, 0xcb, 0xf6, 0xdf, 0x0d, 0xa8, 0x68, 0x8f, 0x0e, 0xe9, 0x51,
+	0x84, 0x2e, 0xc3, 0x45, 0xa5, 0x27, 0x2e, 0x78, 0xcb, 0x5b, 0x55, 0x99, 0x81, 0x07, 0x13, 0x8e,
+	0x5a, 0x50, 0x15, 0x63, 0x4d, 0x32, 0xb1, 0xdd, 0x27, 0x09, 0x56, 0xe3, 0xea, 0xb2, 0x05, 0xa1,
+	0x33, 0x79, 0x30, 0xe9, 0x09, 0x8a, 0x50, 0xa5, 0xa3, 0x50, 0xaa, 0x2e, 0xab, 0xf0, 0xd1, 0x51,
+	0x28, 0x54, 0xbb, 0xb0, 0x76, 0xe4, 0x10, 0x71, 0x46, 0x72, 0x2e, 0x61, 0x75, 0xd9, 0xaa, 0x5a,
+	0x4d, 0xc5, 0xcb, 0x5c, 0xc2, 0x1c, 0xdd, 0x82, 0x35, 0x3c, 0x49, 0x30, 0xf5, 0x71, 0x7a, 0xcb,
+	0xb3, 0x09, 0x3d, 0x8a, 0x64, 0xc8, 0xab, 0x16, 0x4a, 0x79, 0xaa, 0xa2, 0x84, 0xdb, 0xed, 0xff,
+	0x2e, 0x41, 0x29, 0xed, 0x97, 0xe8, 0x36, 0x5c, 0x4c, 0x87, 0xea, 0xb3, 0xea, 0x2b, 0x15, 0x9c,
+	0x2b, 0xa0, 0xa5, 0x57, 0x2c, 0xa0, 0x0d, 0x28, 0xb9, 0xc4, 0xe7, 0xf6, 0x49, 0x44, 0x75, 0x0c,
+	0x44, 0x34, 0xf9, 0xa3, 0x88, 0xa2, 0xef, 0xc0, 0x6a, 0x3a, 0x12, 0xaa, 0x0d, 0xeb, 0x1a, 0xab,
+	0x69, 0xea, 0x1d, 0x49, 0x44, 0x14, 0xca, 0x59, 0x93, 0x3d, 0x1b, 0xbe, 0x7e, 0xb8, 0xe8, 0x99,
+	0xd6, 0xd3, 0x6a, 0xb6, 0x04, 0x7a, 0x1f, 0xae, 0x66, 0x1f, 0x22, 0xd6, 0x71, 0x80, 0xf3, 0x45,
+	0x5e, 0x94, 0x3e, 0x6e, 0x64, 0x22, 0x7b, 0x99, 0x84, 0xaa, 0xf6, 0xf6, 0xc7, 0x06, 0xd4, 0x66,
+	0x6e, 0xb6, 0xaf, 0x14, 0x7d, 0x0f, 0x8a, 0x4e, 0x28, 0x2e, 0x3c, 0xe6, 0xd2, 0x59, 0x5b, 0x7e,
+	0x05, 0x18, 0x53, 0xa6, 0xdb, 0xff, 0x30, 0xa0, 0x31, 0x7f, 0x69, 0x46, 0xf7, 0xf3, 0x37, 0xee,
+	0x59, 0xbf, 0xf3, 0x20, 0x97, 0xe9, 0xcd, 0x6e, 0xa0, 0x31, 0x9e, 0xa3, 0x23, 0x0c, 0x17, 0xd3,
+	0xc1, 0xea, 0x1b, 0xd8, 0x4a, 0x6a, 0xbb, 0xfd, 0x57, 0x03, 0xd6, 0x4f, 0xbf, 0xaa, 0xbf, 0xf6,
+	0x1d, 0xed, 0x41, 0x63, 0xfe, 0xf9, 0xe0, 0xcc, 0xf1, 0xa1, 0x3e, 0xf7, 0x50, 0xd0, 0xfe, 0xdb,
+	0x12, 0x34, 0xe6, 0x6f, 0xff, 0x5f, 0x39, 0xa6, 0xe4, 0xfb, 0xcd, 0xd2, 0xc2, 0xfd, 0x26, 0xd7,
+	0x5d, 0x96, 0x17, 0x9b, 0x72, 0x56, 0x16, 0x9d, 0x72, 0x36, 0xa1, 0x92, 0x07, 0xd9, 0x82, 0x9a,
+	0x47, 0xdd, 0x14, 0xc9, 0x4e, 0x4f, 0x45, 0xf1, 0xbc, 0x3b, 0x7a, 0x29, 0x15, 0xed, 0x3f, 0x1b,
+	0x50, 0x9f, 0x7b, 0xed, 0x38, 0x6f, 0xba, 0xcf, 0xbb, 0x06, 0x5a, 0x83, 0x82, 0x7c, 0x6e, 0x91,
+	0x91, 0x5f, 0xb1, 0xd4, 0x07, 0xfa, 0x3e, 0xa0, 0xc0, 0xe1, 0x89, 0x7e, 0xe0, 0x49, 0xd1, 0x41,
+	0x41, 0x5c, 0x43, 0x70, 0x54, 0x4a, 0x35, 0x28, 0xfc, 0xc7, 0x80, 0xfa, 0xdc, 0x13, 0xca, 0x37,
+	0x95, 0xec, 0x35, 0x28, 0xb0, 0x48, 0xcc, 0x3f, 0xc2, 0x97, 0x82, 0xa5, 0x3e, 0x50, 0x1b, 0x6a,
+	0xa7, 0xb5, 0x9a, 0x8a, 0x9b, 0x6b, 0x32, 0xbb, 0x00, 0xd9, 0xe6, 0xd5, 0xa3, 0xdd, 0xb9, 0x96,
+	0xce, 0x29, 0xdd, 0xfc, 0x10, 0x2a, 0xb9, 0xcb, 0x30, 0xba, 0x06, 0x66, 0xdf, 0x3a, 0xdc, 0x3b,
+	0xbc, 0xff, 0x81, 0x6d, 0x3d, 0xbc, 0x77, 0x60, 0xdf, 0x39, 0xb4, 0x06, 0x0f, 0x6c, 0x41, 0x3a,
+	0x68, 0x5c, 0x40, 0xd7, 0x61, 0x63, 0x86, 0x3b, 0x38, 0xd8, 0xfb, 0xf1, 0xfd, 0x7d, 0xcd, 0x36,
+	0x7a, 0xbb, 0x9f, 0x3e, 0xdf, 0x34, 0x3e, 0x7b, 0xbe, 0x69, 0xfc, 0xfb, 0xf9, 0xa6, 0xf1, 0xfb,
+	0x17, 0x9b, 0x17, 0x3e, 0x7b, 0xb1, 0x79, 0xe1, 0x9f, 0x2f, 0x36, 0x2f, 0xfc, 0xec, 0xbb, 0x39,
+	0x78, 0xe0, 0x8f, 0x49, 0xbc, 0x1d, 0xe2, 0x71, 0x57, 0xbc, 0x14, 0x4f, 0x2f, 0x53, 0x12, 0x23,
+	0xdc, 0xa2, 0x7c, 0xb3, 0xfd, 0xc1, 0xff, 0x06, 0x00, 0xb7, 0x1e, 0x28, 0x51, 0x47, 0x16, 0x00,
+	0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionEvidence) > 0 {
+		for iNdEx := len(m.AuctionEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CensorshipScores) > 0 {
+		for iNdEx := len(m.CensorshipScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CensorshipScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CensorshipReports) > 0 {
+		for iNdEx := len(m.CensorshipReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CensorshipReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for iNdEx := len(m.WithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Searchers) > 0 {
+		for iNdEx := len(m.Searchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Searchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BidCommitments) > 0 {
+		for iNdEx := len(m.BidCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AuctionResults) > 0 {
+		for iNdEx := len(m.AuctionResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SearcherUnbondingPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SearcherUnbondingPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ClaimableProposerRewards {
+		i--
+		if m.ClaimableProposerRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.RewardPayoutInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RewardPayoutInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.MinSearcherCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.RequireSearcherRegistration {
+		i--
+		if m.RequireSearcherRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RevenueSplit) > 0 {
+		for iNdEx := len(m.RevenueSplit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevenueSplit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.RevertProtectionRefund.Size()
+		i -= size
+		if _, err := m.RevertProtectionRefund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.PricingRule != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PricingRule))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.MinCommitmentCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.RevealWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RevealWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxBundlesPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBundlesPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.BidDenoms) > 0 {
+		for iNdEx := len(m.BidDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.AuctionResultRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionResultRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.ProposerFee.Size()
+		i -= size
+		if _, err := m.ProposerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.FrontRunningProtection {
+		i--
+		if m.FrontRunningProtection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.MinBidIncrement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ReserveFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EscrowAccountAddress) > 0 {
+		i -= len(m.EscrowAccountAddress)
+		copy(dAtA[i:], m.EscrowAccountAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EscrowAccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevenueShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevenueShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevenueShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinBidIncrement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ReserveFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BundleIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BundleIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BundledTxHashes) > 0 {
+		for iNdEx := len(m.BundledTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BundledTxHashes[iNdEx])
+			copy(dAtA[i:], m.BundledTxHashes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BundledTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EscrowReward) > 0 {
+		for iNdEx := len(m.EscrowReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProposerReward) > 0 {
+		for iNdEx := len(m.ProposerReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunnerUpBidTxHash) > 0 {
+		i -= len(m.RunnerUpBidTxHash)
+		copy(dAtA[i:], m.RunnerUpBidTxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RunnerUpBidTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.RunnerUpBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtendedCommitInfo) > 0 {
+		i -= len(m.ExtendedCommitInfo)
+		copy(dAtA[i:], m.ExtendedCommitInfo)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExtendedCommitInfo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FailedBidTxHashes) > 0 {
+		for iNdEx := len(m.FailedBidTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedBidTxHashes[iNdEx])
+			copy(dAtA[i:], m.FailedBidTxHashes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FailedBidTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NumTxs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BidTxs) > 0 {
+		for iNdEx := len(m.BidTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BidTxs[iNdEx])
+			copy(dAtA[i:], m.BidTxs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BidTxs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Searcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Searcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Searcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingCompletionHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondingCompletionHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Unbonding) > 0 {
+		for iNdEx := len(m.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbonding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BundlesFailed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BundlesFailed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BidsWon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BidsWon))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CensorshipReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CensorshipReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CensorshipReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BidTxHash) > 0 {
+		i -= len(m.BidTxHash)
+		copy(dAtA[i:], m.BidTxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BidTxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CensorshipScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CensorshipScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CensorshipScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastReportHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastReportHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Score != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BidTxHashes) > 0 {
+		for iNdEx := len(m.BidTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BidTxHashes[iNdEx])
+			copy(dAtA[i:], m.BidTxHashes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BidTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Round != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AuctionResults) > 0 {
+		for _, e := range m.AuctionResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidCommitments) > 0 {
+		for _, e := range m.BidCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Searchers) > 0 {
+		for _, e := range m.Searchers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for _, e := range m.WithdrawAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CensorshipReports) > 0 {
+		for _, e := range m.CensorshipReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CensorshipScores) > 0 {
+		for _, e := range m.CensorshipScores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionEvidence) > 0 {
+		for _, e := range m.AuctionEvidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBundleSize))
+	}
+	l = len(m.EscrowAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ReserveFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinBidIncrement.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.FrontRunningProtection {
+		n += 2
+	}
+	l = m.ProposerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.AuctionResultRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionResultRetention))
+	}
+	if len(m.BidDenoms) > 0 {
+		for _, e := range m.BidDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxBundlesPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBundlesPerBlock))
+	}
+	if m.RevealWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.RevealWindow))
+	}
+	l = m.MinCommitmentCollateral.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.PricingRule != 0 {
+		n += 1 + sovGenesis(uint64(m.PricingRule))
+	}
+	l = m.RevertProtectionRefund.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RevenueSplit) > 0 {
+		for _, e := range m.RevenueSplit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RequireSearcherRegistration {
+		n += 2
+	}
+	l = m.MinSearcherCollateral.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.RewardPayoutInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.RewardPayoutInterval))
+	}
+	if m.ClaimableProposerRewards {
+		n += 3
+	}
+	if m.SearcherUnbondingPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.SearcherUnbondingPeriod))
+	}
+	return n
+}
+
+func (m *RevenueShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BidDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReserveFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinBidIncrement.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AuctionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProposerReward) > 0 {
+		for _, e := range m.ProposerReward {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowReward) > 0 {
+		for _, e := range m.EscrowReward {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BundledTxHashes) > 0 {
+		for _, s := range m.BundledTxHashes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BundleIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.BundleIndex))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BidCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExpirationHeight))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *AuctionSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RunnerUpBid.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.RunnerUpBidTxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *AuctionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BidTxs) > 0 {
+		for _, b := range m.BidTxs {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTxBytes))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovGenesis(uint64(m.NumTxs))
+	}
+	if len(m.FailedBidTxHashes) > 0 {
+		for _, b := range m.FailedBidTxHashes {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ExtendedCommitInfo)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Searcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BidsWon != 0 {
+		n += 1 + sovGenesis(uint64(m.BidsWon))
+	}
+	if m.BundlesFailed != 0 {
+		n += 1 + sovGenesis(uint64(m.BundlesFailed))
+	}
+	if len(m.Unbonding) > 0 {
+		for _, e := range m.Unbonding {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.UnbondingCompletionHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.UnbondingCompletionHeight))
+	}
+	return n
+}
+
+func (m *PendingReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardsWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *CensorshipReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.BidTxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *CensorshipScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovGenesis(uint64(m.Score))
+	}
+	if m.LastReportHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastReportHeight))
+	}
+	return n
+}
+
+func (m *AuctionEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovGenesis(uint64(m.Round))
+	}
+	if len(m.BidTxHashes) > 0 {
+		for _, b := range m.BidTxHashes {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionResults = append(m.AuctionResults, AuctionResult{})
+			if err := m.AuctionResults[len(m.AuctionResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidCommitments = append(m.BidCommitments, BidCommitment{})
+			if err := m.BidCommitments[len(m.BidCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Searchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Searchers = append(m.Searchers, Searcher{})
+			if err := m.Searchers[len(m.Searchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, PendingReward{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorRewards{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddresses = append(m.WithdrawAddresses, RewardsWithdrawAddress{})
+			if err := m.WithdrawAddresses[len(m.WithdrawAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CensorshipReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CensorshipReports = append(m.CensorshipReports, CensorshipReport{})
+			if err := m.CensorshipReports[len(m.CensorshipReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CensorshipScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CensorshipScores = append(m.CensorshipScores, CensorshipScore{})
+			if err := m.CensorshipScores[len(m.CensorshipScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionEvidence = append(m.AuctionEvidence, AuctionEvidence{})
+			if err := m.AuctionEvidence[len(m.AuctionEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAccountAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAccountAddress = append(m.EscrowAccountAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowAccountAddress == nil {
+				m.EscrowAccountAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidIncrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrontRunningProtection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FrontRunningProtection = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionResultRetention", wireType)
+			}
+			m.AuctionResultRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionResultRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidDenoms = append(m.BidDenoms, BidDenom{})
+			if err := m.BidDenoms[len(m.BidDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundlesPerBlock", wireType)
+			}
+			m.MaxBundlesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundlesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealWindow", wireType)
+			}
+			m.RevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommitmentCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommitmentCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingRule", wireType)
+			}
+			m.PricingRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingRule |= PricingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertProtectionRefund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevertProtectionRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevenueSplit = append(m.RevenueSplit, RevenueShare{})
+			if err := m.RevenueSplit[len(m.RevenueSplit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireSearcherRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireSearcherRegistration = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSearcherCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSearcherCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPayoutInterval", wireType)
+			}
+			m.RewardPayoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardPayoutInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableProposerRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimableProposerRewards = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearcherUnbondingPeriod", wireType)
+			}
+			m.SearcherUnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SearcherUnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevenueShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevenueShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevenueShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidIncrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerReward = append(m.ProposerReward, types.Coin{})
+			if err := m.ProposerReward[len(m.ProposerReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowReward = append(m.EscrowReward, types.Coin{})
+			if err := m.EscrowReward[len(m.EscrowReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundledTxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundledTxHashes = append(m.BundledTxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleIndex", wireType)
+			}
+			m.BundleIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &types.Coin{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunnerUpBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RunnerUpBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunnerUpBidTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunnerUpBidTxHash = append(m.RunnerUpBidTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RunnerUpBidTxHash == nil {
+				m.RunnerUpBidTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTxs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidTxs = append(m.BidTxs, make([]byte, postIndex-iNdEx))
+			copy(m.BidTxs[len(m.BidTxs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
+			}
+			m.MaxTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedBidTxHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedBidTxHashes = append(m.FailedBidTxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.FailedBidTxHashes[len(m.FailedBidTxHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedCommitInfo = append(m.ExtendedCommitInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtendedCommitInfo == nil {
+				m.ExtendedCommitInfo = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Searcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Searcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Searcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidsWon", wireType)
+			}
+			m.BidsWon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidsWon |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlesFailed", wireType)
+			}
+			m.BundlesFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundlesFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbonding = append(m.Unbonding, types.Coin{})
+			if err := m.Unbonding[len(m.Unbonding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCompletionHeight", wireType)
+			}
+			m.UnbondingCompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingCompletionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CensorshipReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CensorshipReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CensorshipReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.EscrowAccountAddress) > 0 {
-		i -= len(m.EscrowAccountAddress)
-		copy(dAtA[i:], m.EscrowAccountAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EscrowAccountAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxBundleSize != 0 {
-		n += 1 + sovGenesis(uint64(m.MaxBundleSize))
-	}
-	l = len(m.EscrowAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = m.ReserveFee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.MinBidIncrement.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.FrontRunningProtection {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.ProposerFee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *CensorshipScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -388,17 +5955,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CensorshipScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CensorshipScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -408,25 +5975,62 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReportHeight", wireType)
+			}
+			m.LastReportHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReportHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -448,7 +6052,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AuctionEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -471,17 +6075,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxBundleSize = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -491,16 +6095,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBundleSize |= uint32(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -510,31 +6114,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAccountAddress = append(m.EscrowAccountAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.EscrowAccountAddress == nil {
-				m.EscrowAccountAddress = []byte{}
-			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			var msglen int
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -544,30 +6146,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Round |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReserveFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBidIncrement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTxHashes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -577,48 +6165,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBidIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BidTxHashes = append(m.BidTxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.BidTxHashes[len(m.BidTxHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrontRunningProtection", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FrontRunningProtection = bool(v != 0)
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -646,9 +6213,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProposerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
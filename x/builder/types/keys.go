@@ -1,5 +1,12 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the name of the builder module
 	ModuleName = "builder"
@@ -16,7 +23,151 @@ const (
 
 const (
 	prefixParams = iota + 1
+	prefixAuctionResult
+	prefixBidCommitment
+	prefixBidCommitmentExpiration
+	prefixAuctionSettlement
+	prefixPendingBundledTx
+	prefixSearcher
+	prefixPendingReward
+	prefixValidatorRewards
+	prefixRewardsWithdrawAddress
+	prefixCensorshipReport
+	prefixCensorshipScore
+	prefixAuctionEvidence
+	prefixPendingRewardPayoutCursor
 )
 
-// KeyParams is the store key for the builder module's parameters.
-var KeyParams = []byte{prefixParams}
+var (
+	// KeyParams is the store key for the builder module's parameters.
+	KeyParams = []byte{prefixParams}
+
+	// KeyPrefixAuctionResult is the store key prefix for historical auction results.
+	KeyPrefixAuctionResult = []byte{prefixAuctionResult}
+
+	// KeyPrefixBidCommitment is the store key prefix for sealed bid commitments.
+	KeyPrefixBidCommitment = []byte{prefixBidCommitment}
+
+	// KeyPrefixBidCommitmentExpiration is the store key prefix for the index of sealed bid
+	// commitments by expiration height.
+	KeyPrefixBidCommitmentExpiration = []byte{prefixBidCommitmentExpiration}
+
+	// KeyAuctionSettlement is the store key for the auction settlement of the current block.
+	KeyAuctionSettlement = []byte{prefixAuctionSettlement}
+
+	// KeyPrefixPendingBundledTx is the store key prefix for the bundled transactions of the
+	// winning bundles of the current block that have not been executed successfully yet.
+	KeyPrefixPendingBundledTx = []byte{prefixPendingBundledTx}
+
+	// KeyPrefixSearcher is the store key prefix for registered searchers.
+	KeyPrefixSearcher = []byte{prefixSearcher}
+
+	// KeyPrefixPendingReward is the store key prefix for the auction proceeds that have been
+	// accrued but not paid out yet.
+	KeyPrefixPendingReward = []byte{prefixPendingReward}
+
+	// KeyPrefixValidatorRewards is the store key prefix for the proposer rewards accrued by
+	// validators.
+	KeyPrefixValidatorRewards = []byte{prefixValidatorRewards}
+
+	// KeyPrefixRewardsWithdrawAddress is the store key prefix for the addresses that
+	// validators withdraw their proposer rewards to.
+	KeyPrefixRewardsWithdrawAddress = []byte{prefixRewardsWithdrawAddress}
+
+	// KeyPrefixCensorshipReport is the store key prefix for verified censorship reports.
+	KeyPrefixCensorshipReport = []byte{prefixCensorshipReport}
+
+	// KeyPrefixCensorshipScore is the store key prefix for the censorship scores of validators.
+	KeyPrefixCensorshipScore = []byte{prefixCensorshipScore}
+
+	// KeyPrefixAuctionEvidence is the store key prefix for the vote extension auctions of
+	// previous blocks.
+	KeyPrefixAuctionEvidence = []byte{prefixAuctionEvidence}
+
+	// KeyPendingRewardPayoutCursor is the store key for the key of the next pending reward to
+	// pay out when a payout does not fit into a single block.
+	KeyPendingRewardPayoutCursor = []byte{prefixPendingRewardPayoutCursor}
+)
+
+// GetAuctionResultHeightPrefix returns the store key prefix for all of the auction results at the
+// given height. Heights are big-endian encoded so that results are iterated in ascending height order.
+func GetAuctionResultHeightPrefix(height uint64) []byte {
+	return append(append([]byte{}, KeyPrefixAuctionResult...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetAuctionResultKey returns the store key for the auction result of the winning bundle at the
+// given height and bundle index.
+func GetAuctionResultKey(height uint64, bundleIndex uint32) []byte {
+	return binary.BigEndian.AppendUint32(GetAuctionResultHeightPrefix(height), bundleIndex)
+}
+
+// GetBidCommitmentKey returns the store key for the sealed bid commitment of the given bidder.
+func GetBidCommitmentKey(bidder sdk.AccAddress, commitment []byte) []byte {
+	return append(append(append([]byte{}, KeyPrefixBidCommitment...), address.MustLengthPrefix(bidder)...), commitment...)
+}
+
+// GetBidCommitmentExpirationPrefix returns the store key prefix for all of the sealed bid
+// commitments that expire at the given height.
+func GetBidCommitmentExpirationPrefix(height uint64) []byte {
+	return append(append([]byte{}, KeyPrefixBidCommitmentExpiration...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetBidCommitmentExpirationKey returns the store key for the expiration index entry of the
+// sealed bid commitment of the given bidder.
+func GetBidCommitmentExpirationKey(height uint64, bidder sdk.AccAddress, commitment []byte) []byte {
+	return append(append(GetBidCommitmentExpirationPrefix(height), address.MustLengthPrefix(bidder)...), commitment...)
+}
+
+// GetPendingBundledTxKey returns the store key for the pending bundled transaction with the
+// given hash.
+func GetPendingBundledTxKey(txHash []byte) []byte {
+	return append(append([]byte{}, KeyPrefixPendingBundledTx...), txHash...)
+}
+
+// GetSearcherKey returns the store key for the registered searcher with the given address.
+func GetSearcherKey(searcher sdk.AccAddress) []byte {
+	return append(append([]byte{}, KeyPrefixSearcher...), address.MustLengthPrefix(searcher)...)
+}
+
+// GetPendingRewardKey returns the store key for the pending reward of the given recipient.
+func GetPendingRewardKey(recipient sdk.AccAddress) []byte {
+	return append(append([]byte{}, KeyPrefixPendingReward...), address.MustLengthPrefix(recipient)...)
+}
+
+// GetValidatorRewardsKey returns the store key for the proposer rewards accrued by the given
+// validator.
+func GetValidatorRewardsKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, KeyPrefixValidatorRewards...), address.MustLengthPrefix(validator)...)
+}
+
+// GetRewardsWithdrawAddressKey returns the store key for the address that the given validator
+// withdraws its proposer rewards to.
+func GetRewardsWithdrawAddressKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, KeyPrefixRewardsWithdrawAddress...), address.MustLengthPrefix(validator)...)
+}
+
+// GetCensorshipReportHeightPrefix returns the store key prefix for all of the censorship reports
+// at the given height. Heights are big-endian encoded so that reports are iterated in ascending
+// height order.
+func GetCensorshipReportHeightPrefix(height uint64) []byte {
+	return append(append([]byte{}, KeyPrefixCensorshipReport...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetCensorshipReportKey returns the store key for the censorship report of the bid transaction
+// with the given hash at the given height.
+func GetCensorshipReportKey(height uint64, bidTxHash []byte) []byte {
+	return append(GetCensorshipReportHeightPrefix(height), bidTxHash...)
+}
+
+// GetAuctionEvidenceKey returns the store key for the vote extension auction of the block at the
+// given height. Heights are big-endian encoded so that evidence is iterated in ascending height
+// order.
+func GetAuctionEvidenceKey(height uint64) []byte {
+	return append(append([]byte{}, KeyPrefixAuctionEvidence...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetCensorshipScoreKey returns the store key for the censorship score of the validator with
+// the given consensus address.
+func GetCensorshipScoreKey(validator sdk.ConsAddress) []byte {
+	return append(append([]byte{}, KeyPrefixCensorshipScore...), address.MustLengthPrefix(validator)...)
+}
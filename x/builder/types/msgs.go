@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	fmt "fmt"
 
 	"cosmossdk.io/errors"
@@ -11,6 +12,16 @@ import (
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgAuctionBid{}
+	_ sdk.Msg = &MsgCommitBid{}
+	_ sdk.Msg = &MsgRevealBid{}
+	_ sdk.Msg = &MsgRevealBidCommitment{}
+	_ sdk.Msg = &MsgSubmitBundle{}
+	_ sdk.Msg = &MsgRegisterSearcher{}
+	_ sdk.Msg = &MsgUnbondSearcherCollateral{}
+	_ sdk.Msg = &MsgWithdrawSearcherCollateral{}
+	_ sdk.Msg = &MsgWithdrawBuilderRewards{}
+	_ sdk.Msg = &MsgSetBuilderRewardsWithdrawAddress{}
+	_ sdk.Msg = &MsgReportCensoredBid{}
 )
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -80,3 +91,390 @@ func (m MsgAuctionBid) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgCommitBid(bidder sdk.AccAddress, commitment []byte, collateral sdk.Coin) *MsgCommitBid {
+	return &MsgCommitBid{
+		Bidder:     bidder.String(),
+		Commitment: commitment,
+		Collateral: collateral,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCommitBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCommitBid message.
+func (m MsgCommitBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCommitBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return errors.Wrap(err, "invalid bidder address")
+	}
+
+	if len(m.Commitment) != sha256.Size {
+		return fmt.Errorf("invalid commitment length; expected %d, got %d", sha256.Size, len(m.Commitment))
+	}
+
+	if m.Collateral.IsNil() {
+		return fmt.Errorf("no collateral included")
+	}
+
+	if err := m.Collateral.Validate(); err != nil {
+		return errors.Wrap(err, "invalid collateral")
+	}
+
+	return nil
+}
+
+func NewMsgRevealBid(bidder sdk.AccAddress, bid sdk.Coin, transactions [][]byte, salt []byte) *MsgRevealBid {
+	return &MsgRevealBid{
+		Bidder:       bidder.String(),
+		Bid:          bid,
+		Transactions: transactions,
+		Salt:         salt,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRevealBid message.
+func (m MsgRevealBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgRevealBid) ValidateBasic() error {
+	auctionBid := MsgAuctionBid{
+		Bidder:       m.Bidder,
+		Bid:          m.Bid,
+		Transactions: m.Transactions,
+	}
+	if err := auctionBid.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(m.Salt) < MinSaltLength {
+		return fmt.Errorf("salt must be at least %d bytes; got %d", MinSaltLength, len(m.Salt))
+	}
+
+	return nil
+}
+
+// GetCommitment returns the commitment of the revealed bid.
+func (m MsgRevealBid) GetCommitment() ([]byte, error) {
+	bidder, err := sdk.AccAddressFromBech32(m.Bidder)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid bidder address")
+	}
+
+	return ComputeBidCommitment(bidder, m.Bid, m.Transactions, m.Salt), nil
+}
+
+func NewMsgRevealBidCommitment(bidder sdk.AccAddress, bid sdk.Coin, transactions [][]byte, salt []byte) *MsgRevealBidCommitment {
+	return &MsgRevealBidCommitment{
+		Bidder:       bidder.String(),
+		Bid:          bid,
+		Transactions: transactions,
+		Salt:         salt,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevealBidCommitment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRevealBidCommitment message.
+func (m MsgRevealBidCommitment) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgRevealBidCommitment) ValidateBasic() error {
+	revealBid := MsgRevealBid{
+		Bidder:       m.Bidder,
+		Bid:          m.Bid,
+		Transactions: m.Transactions,
+		Salt:         m.Salt,
+	}
+
+	return revealBid.ValidateBasic()
+}
+
+func NewMsgSubmitBundle(sender sdk.AccAddress, transactions [][]byte) *MsgSubmitBundle {
+	return &MsgSubmitBundle{
+		Sender:       sender.String(),
+		Transactions: transactions,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSubmitBundle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitBundle message.
+func (m MsgSubmitBundle) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data. Encrypted transactions are only
+// decrypted for winning bids, so they cannot be submitted without a bid.
+func (m MsgSubmitBundle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+
+	if len(m.Transactions) == 0 {
+		return fmt.Errorf("no transactions included")
+	}
+
+	for _, tx := range m.Transactions {
+		if len(tx) == 0 {
+			return fmt.Errorf("empty transaction included")
+		}
+
+		if IsEncryptedBundleTx(tx) {
+			return fmt.Errorf("encrypted transactions cannot be submitted without a bid")
+		}
+	}
+
+	return nil
+}
+
+func NewMsgRegisterSearcher(searcher sdk.AccAddress, collateral sdk.Coin) *MsgRegisterSearcher {
+	return &MsgRegisterSearcher{
+		Searcher:   searcher.String(),
+		Collateral: collateral,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterSearcher) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterSearcher message.
+func (m MsgRegisterSearcher) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Searcher)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgRegisterSearcher) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Searcher); err != nil {
+		return errors.Wrap(err, "invalid searcher address")
+	}
+
+	if m.Collateral.IsNil() {
+		return fmt.Errorf("no collateral included")
+	}
+
+	if err := m.Collateral.Validate(); err != nil {
+		return errors.Wrap(err, "invalid collateral")
+	}
+
+	if !m.Collateral.IsPositive() {
+		return fmt.Errorf("collateral must be positive")
+	}
+
+	return nil
+}
+
+func NewMsgUnbondSearcherCollateral(searcher sdk.AccAddress, amount sdk.Coin) *MsgUnbondSearcherCollateral {
+	return &MsgUnbondSearcherCollateral{
+		Searcher: searcher.String(),
+		Amount:   amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnbondSearcherCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUnbondSearcherCollateral message.
+func (m MsgUnbondSearcherCollateral) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Searcher)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUnbondSearcherCollateral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Searcher); err != nil {
+		return errors.Wrap(err, "invalid searcher address")
+	}
+
+	if m.Amount.IsNil() {
+		return fmt.Errorf("no amount included")
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return errors.Wrap(err, "invalid amount")
+	}
+
+	if !m.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+
+	return nil
+}
+
+func NewMsgWithdrawSearcherCollateral(searcher sdk.AccAddress) *MsgWithdrawSearcherCollateral {
+	return &MsgWithdrawSearcherCollateral{
+		Searcher: searcher.String(),
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWithdrawSearcherCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawSearcherCollateral message.
+func (m MsgWithdrawSearcherCollateral) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Searcher)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgWithdrawSearcherCollateral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Searcher); err != nil {
+		return errors.Wrap(err, "invalid searcher address")
+	}
+
+	return nil
+}
+
+func NewMsgWithdrawBuilderRewards(sender sdk.AccAddress, validator sdk.ValAddress) *MsgWithdrawBuilderRewards {
+	return &MsgWithdrawBuilderRewards{
+		ValidatorAddress: validator.String(),
+		Sender:           sender.String(),
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgWithdrawBuilderRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawBuilderRewards message.
+func (m MsgWithdrawBuilderRewards) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgWithdrawBuilderRewards) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+
+	return nil
+}
+
+func NewMsgSetBuilderRewardsWithdrawAddress(validator sdk.ValAddress, withdrawAddress sdk.AccAddress) *MsgSetBuilderRewardsWithdrawAddress {
+	return &MsgSetBuilderRewardsWithdrawAddress{
+		ValidatorAddress: validator.String(),
+		WithdrawAddress:  withdrawAddress.String(),
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetBuilderRewardsWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetBuilderRewardsWithdrawAddress message.
+func (m MsgSetBuilderRewardsWithdrawAddress) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(addr)}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgSetBuilderRewardsWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawAddress); err != nil {
+		return errors.Wrap(err, "invalid withdraw address")
+	}
+
+	return nil
+}
+
+func NewMsgReportCensoredBid(
+	reporter sdk.AccAddress,
+	height uint64,
+	bidTx []byte,
+	validator sdk.ConsAddress,
+	voteExtension []byte,
+	extensionSignature []byte,
+) *MsgReportCensoredBid {
+	return &MsgReportCensoredBid{
+		Reporter:           reporter.String(),
+		Height:             height,
+		BidTx:              bidTx,
+		ValidatorAddress:   validator.String(),
+		VoteExtension:      voteExtension,
+		ExtensionSignature: extensionSignature,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgReportCensoredBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgReportCensoredBid message.
+func (m MsgReportCensoredBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Reporter)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgReportCensoredBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Reporter); err != nil {
+		return errors.Wrap(err, "invalid reporter address")
+	}
+
+	// The vote extensions of a block are signed in the commit of the previous block.
+	if m.Height <= 1 {
+		return fmt.Errorf("height must be greater than one")
+	}
+
+	if len(m.BidTx) == 0 {
+		return fmt.Errorf("bid transaction cannot be empty")
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(m.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if len(m.VoteExtension) == 0 {
+		return fmt.Errorf("vote extension cannot be empty")
+	}
+
+	if len(m.ExtensionSignature) == 0 {
+		return fmt.Errorf("vote extension signature cannot be empty")
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -87,6 +88,489 @@ func TestMsgAuctionBid(t *testing.T) {
 	}
 }
 
+// TestMsgCommitBid tests the ValidateBasic method of MsgCommitBid
+func TestMsgCommitBid(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("test"))
+	commitment := types.ComputeBidCommitment(bidder, sdk.NewCoin("test", math.NewInt(100)), [][]byte{[]byte("test")}, []byte("0123456789abcdef"))
+
+	cases := []struct {
+		description string
+		msg         types.MsgCommitBid
+		expectPass  bool
+	}{
+		{
+			description: "invalid message with empty bidder",
+			msg: types.MsgCommitBid{
+				Commitment: commitment,
+				Collateral: sdk.NewCoin("test", math.NewInt(100)),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with invalid commitment",
+			msg: types.MsgCommitBid{
+				Bidder:     bidder.String(),
+				Commitment: []byte("test"),
+				Collateral: sdk.NewCoin("test", math.NewInt(100)),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with empty collateral",
+			msg: types.MsgCommitBid{
+				Bidder:     bidder.String(),
+				Commitment: commitment,
+			},
+			expectPass: false,
+		},
+		{
+			description: "valid message",
+			msg: types.MsgCommitBid{
+				Bidder:     bidder.String(),
+				Commitment: commitment,
+				Collateral: sdk.NewCoin("test", math.NewInt(100)),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				if err != nil {
+					t.Errorf("expected no error on %s, got %s", tc.description, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error on %s, got none", tc.description)
+				}
+			}
+		})
+	}
+}
+
+// TestMsgRevealBid tests the ValidateBasic and GetCommitment methods of MsgRevealBid
+func TestMsgRevealBid(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("test"))
+	bid := sdk.NewCoin("test", math.NewInt(100))
+	transactions := [][]byte{[]byte("test")}
+	salt := []byte("0123456789abcdef")
+
+	t.Run("invalid message with short salt", func(t *testing.T) {
+		msg := types.NewMsgRevealBid(bidder, bid, transactions, salt[:types.MinSaltLength-1])
+		if err := msg.ValidateBasic(); err == nil {
+			t.Errorf("expected error, got none")
+		}
+	})
+
+	t.Run("invalid message with empty transactions", func(t *testing.T) {
+		msg := types.NewMsgRevealBid(bidder, bid, nil, salt)
+		if err := msg.ValidateBasic(); err == nil {
+			t.Errorf("expected error, got none")
+		}
+	})
+
+	t.Run("valid message reveals the commitment", func(t *testing.T) {
+		msg := types.NewMsgRevealBid(bidder, bid, transactions, salt)
+		if err := msg.ValidateBasic(); err != nil {
+			t.Errorf("expected no error, got %s", err)
+		}
+
+		commitment, err := msg.GetCommitment()
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !bytes.Equal(commitment, types.ComputeBidCommitment(bidder, bid, transactions, salt)) {
+			t.Errorf("commitment does not match")
+		}
+
+		// Changing any part of the bid changes the commitment.
+		other := types.ComputeBidCommitment(bidder, bid.AddAmount(math.NewInt(1)), transactions, salt)
+		if bytes.Equal(commitment, other) {
+			t.Errorf("expected commitments of different bids to differ")
+		}
+	})
+}
+
+// TestMsgRevealBidCommitment tests the ValidateBasic method of MsgRevealBidCommitment
+func TestMsgRevealBidCommitment(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("test"))
+	bid := sdk.NewCoin("test", math.NewInt(100))
+	transactions := [][]byte{[]byte("test")}
+	salt := []byte("0123456789abcdef")
+
+	t.Run("invalid message with short salt", func(t *testing.T) {
+		msg := types.NewMsgRevealBidCommitment(bidder, bid, transactions, salt[:types.MinSaltLength-1])
+		if err := msg.ValidateBasic(); err == nil {
+			t.Errorf("expected error, got none")
+		}
+	})
+
+	t.Run("valid message", func(t *testing.T) {
+		msg := types.NewMsgRevealBidCommitment(bidder, bid, transactions, salt)
+		if err := msg.ValidateBasic(); err != nil {
+			t.Errorf("expected no error, got %s", err)
+		}
+	})
+}
+
+// TestMsgSubmitBundle tests the ValidateBasic method of MsgSubmitBundle
+func TestMsgSubmitBundle(t *testing.T) {
+	cases := []struct {
+		description string
+		msg         types.MsgSubmitBundle
+		expectPass  bool
+	}{
+		{
+			description: "invalid message with empty sender",
+			msg: types.MsgSubmitBundle{
+				Sender:       "",
+				Transactions: [][]byte{[]byte("test")},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with empty transactions",
+			msg: types.MsgSubmitBundle{
+				Sender:       sdk.AccAddress([]byte("test")).String(),
+				Transactions: [][]byte{},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with empty transaction in transactions",
+			msg: types.MsgSubmitBundle{
+				Sender:       sdk.AccAddress([]byte("test")).String(),
+				Transactions: [][]byte{[]byte("test"), []byte("")},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with encrypted transaction",
+			msg: types.MsgSubmitBundle{
+				Sender:       sdk.AccAddress([]byte("test")).String(),
+				Transactions: [][]byte{types.EncodeEncryptedBundleTx(1, []byte("test"))},
+			},
+			expectPass: false,
+		},
+		{
+			description: "valid message with multiple transactions",
+			msg: types.MsgSubmitBundle{
+				Sender:       sdk.AccAddress([]byte("test")).String(),
+				Transactions: [][]byte{[]byte("test"), []byte("test2")},
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				if err != nil {
+					t.Errorf("expected no error on %s, got %s", tc.description, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error on %s, got none", tc.description)
+				}
+			}
+		})
+	}
+}
+
+// TestMsgRegisterSearcher tests the ValidateBasic method of MsgRegisterSearcher
+func TestMsgRegisterSearcher(t *testing.T) {
+	cases := []struct {
+		description string
+		msg         types.MsgRegisterSearcher
+		expectPass  bool
+	}{
+		{
+			description: "invalid message with empty searcher",
+			msg: types.MsgRegisterSearcher{
+				Searcher:   "",
+				Collateral: sdk.NewCoin("test", math.NewInt(100)),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with no collateral",
+			msg: types.MsgRegisterSearcher{
+				Searcher: sdk.AccAddress([]byte("test")).String(),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with zero collateral",
+			msg: types.MsgRegisterSearcher{
+				Searcher:   sdk.AccAddress([]byte("test")).String(),
+				Collateral: sdk.NewCoin("test", math.NewInt(0)),
+			},
+			expectPass: false,
+		},
+		{
+			description: "valid message",
+			msg: types.MsgRegisterSearcher{
+				Searcher:   sdk.AccAddress([]byte("test")).String(),
+				Collateral: sdk.NewCoin("test", math.NewInt(100)),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				if err != nil {
+					t.Errorf("expected no error on %s, got %s", tc.description, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error on %s, got none", tc.description)
+				}
+			}
+		})
+	}
+}
+
+// TestMsgUnbondSearcherCollateral tests the ValidateBasic method of MsgUnbondSearcherCollateral
+func TestMsgUnbondSearcherCollateral(t *testing.T) {
+	cases := []struct {
+		description string
+		msg         types.MsgUnbondSearcherCollateral
+		expectPass  bool
+	}{
+		{
+			description: "invalid message with empty searcher",
+			msg: types.MsgUnbondSearcherCollateral{
+				Searcher: "",
+				Amount:   sdk.NewCoin("test", math.NewInt(100)),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with no amount",
+			msg: types.MsgUnbondSearcherCollateral{
+				Searcher: sdk.AccAddress([]byte("test")).String(),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with zero amount",
+			msg: types.MsgUnbondSearcherCollateral{
+				Searcher: sdk.AccAddress([]byte("test")).String(),
+				Amount:   sdk.NewCoin("test", math.NewInt(0)),
+			},
+			expectPass: false,
+		},
+		{
+			description: "valid message",
+			msg: types.MsgUnbondSearcherCollateral{
+				Searcher: sdk.AccAddress([]byte("test")).String(),
+				Amount:   sdk.NewCoin("test", math.NewInt(100)),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				if err != nil {
+					t.Errorf("expected no error on %s, got %s", tc.description, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error on %s, got none", tc.description)
+				}
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawBuilderRewards(t *testing.T) {
+	cases := []struct {
+		description string
+		msg         types.MsgWithdrawBuilderRewards
+		expectPass  bool
+	}{
+		{
+			description: "invalid message with empty validator",
+			msg: types.MsgWithdrawBuilderRewards{
+				Sender: sdk.AccAddress([]byte("test")).String(),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with empty sender",
+			msg: types.MsgWithdrawBuilderRewards{
+				ValidatorAddress: sdk.ValAddress([]byte("test")).String(),
+			},
+			expectPass: false,
+		},
+		{
+			description: "valid message signed by another account",
+			msg: types.MsgWithdrawBuilderRewards{
+				ValidatorAddress: sdk.ValAddress([]byte("test")).String(),
+				Sender:           sdk.AccAddress([]byte("other")).String(),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				if err != nil {
+					t.Errorf("expected no error on %s, got %s", tc.description, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error on %s, got none", tc.description)
+				}
+			}
+		})
+	}
+}
+
+func TestMsgSetBuilderRewardsWithdrawAddress(t *testing.T) {
+	cases := []struct {
+		description string
+		msg         types.MsgSetBuilderRewardsWithdrawAddress
+		expectPass  bool
+	}{
+		{
+			description: "invalid message with empty validator",
+			msg: types.MsgSetBuilderRewardsWithdrawAddress{
+				WithdrawAddress: sdk.AccAddress([]byte("test")).String(),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with account address as validator",
+			msg: types.MsgSetBuilderRewardsWithdrawAddress{
+				ValidatorAddress: sdk.AccAddress([]byte("test")).String(),
+				WithdrawAddress:  sdk.AccAddress([]byte("test")).String(),
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with empty withdraw address",
+			msg: types.MsgSetBuilderRewardsWithdrawAddress{
+				ValidatorAddress: sdk.ValAddress([]byte("test")).String(),
+			},
+			expectPass: false,
+		},
+		{
+			description: "valid message",
+			msg: types.MsgSetBuilderRewardsWithdrawAddress{
+				ValidatorAddress: sdk.ValAddress([]byte("test")).String(),
+				WithdrawAddress:  sdk.AccAddress([]byte("test")).String(),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				if err != nil {
+					t.Errorf("expected no error on %s, got %s", tc.description, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error on %s, got none", tc.description)
+				}
+			}
+		})
+	}
+}
+
+func TestMsgReportCensoredBid(t *testing.T) {
+	validMsg := func() types.MsgReportCensoredBid {
+		return types.MsgReportCensoredBid{
+			Reporter:           sdk.AccAddress([]byte("test")).String(),
+			Height:             2,
+			BidTx:              []byte("test"),
+			ValidatorAddress:   sdk.ConsAddress([]byte("validator")).String(),
+			VoteExtension:      []byte("vote extension"),
+			ExtensionSignature: []byte("signature"),
+		}
+	}
+
+	cases := []struct {
+		description string
+		malleate    func(msg *types.MsgReportCensoredBid)
+		expectPass  bool
+	}{
+		{
+			description: "invalid message with empty reporter",
+			malleate:    func(msg *types.MsgReportCensoredBid) { msg.Reporter = "" },
+			expectPass:  false,
+		},
+		{
+			description: "invalid message with zero height",
+			malleate:    func(msg *types.MsgReportCensoredBid) { msg.Height = 0 },
+			expectPass:  false,
+		},
+		{
+			description: "invalid message with the first height",
+			malleate:    func(msg *types.MsgReportCensoredBid) { msg.Height = 1 },
+			expectPass:  false,
+		},
+		{
+			description: "invalid message with empty bid tx",
+			malleate:    func(msg *types.MsgReportCensoredBid) { msg.BidTx = nil },
+			expectPass:  false,
+		},
+		{
+			description: "invalid message with invalid validator address",
+			malleate:    func(msg *types.MsgReportCensoredBid) { msg.ValidatorAddress = "invalid" },
+			expectPass:  false,
+		},
+		{
+			description: "invalid message with empty vote extension",
+			malleate:    func(msg *types.MsgReportCensoredBid) { msg.VoteExtension = nil },
+			expectPass:  false,
+		},
+		{
+			description: "invalid message with empty vote extension signature",
+			malleate:    func(msg *types.MsgReportCensoredBid) { msg.ExtensionSignature = nil },
+			expectPass:  false,
+		},
+		{
+			description: "valid message",
+			malleate:    func(msg *types.MsgReportCensoredBid) {},
+			expectPass:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			msg := validMsg()
+			tc.malleate(&msg)
+
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				if err != nil {
+					t.Errorf("expected no error on %s, got %s", tc.description, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error on %s, got none", tc.description)
+				}
+			}
+		})
+	}
+}
+
 // TestMsgUpdateParams tests the ValidateBasic method of MsgUpdateParams
 func TestMsgUpdateParams(t *testing.T) {
 	cases := []struct {
@@ -124,6 +608,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
 					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
 					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:   1,
 				},
 			},
 			expectPass: true,
@@ -152,6 +637,231 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			description: "valid message with additional bid denoms",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyNewDecFromInt(math.NewInt(1)),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:   1,
+					BidDenoms: []types.BidDenom{
+						{
+							ReserveFee:      sdk.NewCoin("test2", math.NewInt(50)),
+							MinBidIncrement: sdk.NewCoin("test2", math.NewInt(10)),
+						},
+					},
+				},
+			},
+			expectPass: true,
+		},
+		{
+			description: "invalid message with duplicate bid denoms",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyNewDecFromInt(math.NewInt(1)),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					BidDenoms: []types.BidDenom{
+						{
+							ReserveFee:      sdk.NewCoin("test", math.NewInt(50)),
+							MinBidIncrement: sdk.NewCoin("test", math.NewInt(10)),
+						},
+					},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with mismatched bid denom fees",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyNewDecFromInt(math.NewInt(1)),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					BidDenoms: []types.BidDenom{
+						{
+							ReserveFee:      sdk.NewCoin("test2", math.NewInt(50)),
+							MinBidIncrement: sdk.NewCoin("test3", math.NewInt(10)),
+						},
+					},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "valid message with a revenue split",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyZeroDec(),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:   1,
+					RevenueSplit: []types.RevenueShare{
+						types.NewRevenueShare(types.RecipientBurn, math.LegacyMustNewDecFromStr("0.5")),
+						types.NewRevenueShare(types.RecipientCommunityPool, math.LegacyMustNewDecFromStr("0.3")),
+						types.NewRevenueShare(sdk.AccAddress([]byte("test")).String(), math.LegacyMustNewDecFromStr("0.2")),
+					},
+				},
+			},
+			expectPass: true,
+		},
+		{
+			description: "invalid message with a proposer fee and a revenue split",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyMustNewDecFromStr("0.1"),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:   1,
+					RevenueSplit: []types.RevenueShare{
+						types.NewRevenueShare(types.RecipientProposer, math.LegacyOneDec()),
+					},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with revenue split weights that do not sum to one",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyZeroDec(),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:   1,
+					RevenueSplit: []types.RevenueShare{
+						types.NewRevenueShare(types.RecipientBurn, math.LegacyMustNewDecFromStr("0.5")),
+						types.NewRevenueShare(types.RecipientProposer, math.LegacyMustNewDecFromStr("0.4")),
+					},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with duplicate revenue split recipients",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyZeroDec(),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:   1,
+					RevenueSplit: []types.RevenueShare{
+						types.NewRevenueShare(types.RecipientBurn, math.LegacyMustNewDecFromStr("0.5")),
+						types.NewRevenueShare(types.RecipientBurn, math.LegacyMustNewDecFromStr("0.5")),
+					},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with an unknown revenue split recipient",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyZeroDec(),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:   1,
+					RevenueSplit: []types.RevenueShare{
+						types.NewRevenueShare("stakers", math.LegacyOneDec()),
+					},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with sealed bids and no commitment collateral",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:             math.LegacyZeroDec(),
+					EscrowAccountAddress:    sdk.AccAddress([]byte("test")),
+					ReserveFee:              sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:         sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:      1,
+					RevealWindow:            2,
+					MinCommitmentCollateral: sdk.NewCoin("test", math.NewInt(0)),
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with a refund larger than the escrow share",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:            math.LegacyMustNewDecFromStr("0.6"),
+					EscrowAccountAddress:   sdk.AccAddress([]byte("test")),
+					ReserveFee:             sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:        sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:     1,
+					RevertProtectionRefund: math.LegacyMustNewDecFromStr("0.5"),
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with a refund and no escrow share in the revenue split",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:            math.LegacyZeroDec(),
+					EscrowAccountAddress:   sdk.AccAddress([]byte("test")),
+					ReserveFee:             sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:        sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:     1,
+					RevertProtectionRefund: math.LegacyMustNewDecFromStr("0.5"),
+					RevenueSplit: []types.RevenueShare{
+						types.NewRevenueShare(types.RecipientProposer, math.LegacyOneDec()),
+					},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with zero max bundles per block",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:          math.LegacyNewDecFromInt(math.NewInt(1)),
+					EscrowAccountAddress: sdk.AccAddress([]byte("test")),
+					ReserveFee:           sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:      sdk.NewCoin("test", math.NewInt(100)),
+				},
+			},
+			expectPass: false,
+		},
+		{
+			description: "invalid message with required searchers and no unbonding period",
+			msg: types.MsgUpdateParams{
+				Authority: sdk.AccAddress([]byte("test")).String(),
+				Params: types.Params{
+					ProposerFee:                 math.LegacyNewDecFromInt(math.NewInt(1)),
+					EscrowAccountAddress:        sdk.AccAddress([]byte("test")),
+					ReserveFee:                  sdk.NewCoin("test", math.NewInt(100)),
+					MinBidIncrement:             sdk.NewCoin("test", math.NewInt(100)),
+					MaxBundlesPerBlock:          1,
+					RequireSearcherRegistration: true,
+					MinSearcherCollateral:       sdk.NewCoin("test", math.NewInt(100)),
+				},
+			},
+			expectPass: false,
+		},
 		{
 			description: "invalid message with min bid increment equal to 0",
 			msg: types.MsgUpdateParams{
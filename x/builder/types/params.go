@@ -9,12 +9,25 @@ import (
 )
 
 var (
-	DefaultMaxBundleSize          uint32 = 2
-	DefaultEscrowAccountAddress          = authtypes.NewModuleAddress(ModuleName)
-	DefaultReserveFee                    = sdk.NewCoin("stake", math.NewInt(1))
-	DefaultMinBidIncrement               = sdk.NewCoin("stake", math.NewInt(1))
-	DefaultFrontRunningProtection        = true
-	DefaultProposerFee                   = math.LegacyNewDec(0)
+	DefaultMaxBundleSize               uint32 = 2
+	DefaultEscrowAccountAddress               = authtypes.NewModuleAddress(ModuleName)
+	DefaultReserveFee                         = sdk.NewCoin("stake", math.NewInt(1))
+	DefaultMinBidIncrement                    = sdk.NewCoin("stake", math.NewInt(1))
+	DefaultFrontRunningProtection             = true
+	DefaultProposerFee                        = math.LegacyNewDec(0)
+	DefaultAuctionResultRetention      uint64 = 100800
+	DefaultBidDenoms                          = []BidDenom{}
+	DefaultMaxBundlesPerBlock          uint32 = 1
+	DefaultRevealWindow                uint64 = 0
+	DefaultMinCommitmentCollateral            = sdk.NewCoin("stake", math.NewInt(0))
+	DefaultPricingRule                        = PricingRule_PRICING_RULE_FIRST_PRICE
+	DefaultRevertProtectionRefund             = math.LegacyNewDec(0)
+	DefaultRevenueSplit                       = []RevenueShare{}
+	DefaultRequireSearcherRegistration        = false
+	DefaultMinSearcherCollateral              = sdk.NewCoin("stake", math.NewInt(0))
+	DefaultRewardPayoutInterval        uint64 = 0
+	DefaultClaimableProposerRewards           = false
+	DefaultSearcherUnbondingPeriod     uint64 = 100800
 )
 
 // NewParams returns a new Params instance with the provided values.
@@ -24,14 +37,40 @@ func NewParams(
 	reserveFee, minBidIncrement sdk.Coin,
 	frontRunningProtection bool,
 	proposerFee math.LegacyDec,
+	auctionResultRetention uint64,
+	bidDenoms []BidDenom,
+	maxBundlesPerBlock uint32,
+	revealWindow uint64,
+	minCommitmentCollateral sdk.Coin,
+	pricingRule PricingRule,
+	revertProtectionRefund math.LegacyDec,
+	revenueSplit []RevenueShare,
+	requireSearcherRegistration bool,
+	minSearcherCollateral sdk.Coin,
+	rewardPayoutInterval uint64,
+	claimableProposerRewards bool,
+	searcherUnbondingPeriod uint64,
 ) Params {
 	return Params{
-		MaxBundleSize:          maxBundleSize,
-		EscrowAccountAddress:   escrowAccountAddress,
-		ReserveFee:             reserveFee,
-		MinBidIncrement:        minBidIncrement,
-		FrontRunningProtection: frontRunningProtection,
-		ProposerFee:            proposerFee,
+		MaxBundleSize:               maxBundleSize,
+		EscrowAccountAddress:        escrowAccountAddress,
+		ReserveFee:                  reserveFee,
+		MinBidIncrement:             minBidIncrement,
+		FrontRunningProtection:      frontRunningProtection,
+		ProposerFee:                 proposerFee,
+		AuctionResultRetention:      auctionResultRetention,
+		BidDenoms:                   bidDenoms,
+		MaxBundlesPerBlock:          maxBundlesPerBlock,
+		RevealWindow:                revealWindow,
+		MinCommitmentCollateral:     minCommitmentCollateral,
+		PricingRule:                 pricingRule,
+		RevertProtectionRefund:      revertProtectionRefund,
+		RevenueSplit:                revenueSplit,
+		RequireSearcherRegistration: requireSearcherRegistration,
+		MinSearcherCollateral:       minSearcherCollateral,
+		RewardPayoutInterval:        rewardPayoutInterval,
+		ClaimableProposerRewards:    claimableProposerRewards,
+		SearcherUnbondingPeriod:     searcherUnbondingPeriod,
 	}
 }
 
@@ -44,6 +83,19 @@ func DefaultParams() Params {
 		DefaultMinBidIncrement,
 		DefaultFrontRunningProtection,
 		DefaultProposerFee,
+		DefaultAuctionResultRetention,
+		DefaultBidDenoms,
+		DefaultMaxBundlesPerBlock,
+		DefaultRevealWindow,
+		DefaultMinCommitmentCollateral,
+		DefaultPricingRule,
+		DefaultRevertProtectionRefund,
+		DefaultRevenueSplit,
+		DefaultRequireSearcherRegistration,
+		DefaultMinSearcherCollateral,
+		DefaultRewardPayoutInterval,
+		DefaultClaimableProposerRewards,
+		DefaultSearcherUnbondingPeriod,
 	)
 }
 
@@ -53,29 +105,173 @@ func (p Params) Validate() error {
 		return fmt.Errorf("escrow account address cannot be nil")
 	}
 
-	if err := validateFee(p.ReserveFee); err != nil {
+	if p.MaxBundlesPerBlock == 0 {
+		return fmt.Errorf("max bundles per block must be greater than zero")
+	}
+
+	if err := validateAuctionFees(p.ReserveFee, p.MinBidIncrement); err != nil {
+		return err
+	}
+
+	// Each additional bid denomination must be unique and define its own auction fees.
+	seen := map[string]struct{}{
+		p.ReserveFee.Denom: {},
+	}
+
+	for _, bidDenom := range p.BidDenoms {
+		if err := validateAuctionFees(bidDenom.ReserveFee, bidDenom.MinBidIncrement); err != nil {
+			return fmt.Errorf("invalid bid denom (%s)", err)
+		}
+
+		if _, ok := seen[bidDenom.ReserveFee.Denom]; ok {
+			return fmt.Errorf("duplicate bid denom: %s", bidDenom.ReserveFee.Denom)
+		}
+
+		seen[bidDenom.ReserveFee.Denom] = struct{}{}
+	}
+
+	if p.RevealWindow > 0 {
+		if err := validateFee(p.MinCommitmentCollateral); err != nil {
+			return fmt.Errorf("invalid minimum commitment collateral (%s)", err)
+		}
+
+		// Commitments without collateral could be abandoned at no cost, so sealed bids would
+		// not prevent bidders from reacting to the revealed bids of others.
+		if !p.MinCommitmentCollateral.IsPositive() {
+			return fmt.Errorf("minimum commitment collateral must be positive when sealed bids are enabled")
+		}
+	}
+
+	if _, ok := PricingRule_name[int32(p.PricingRule)]; !ok {
+		return fmt.Errorf("unknown pricing rule: %d", p.PricingRule)
+	}
+
+	if err := validateRevertProtectionRefund(p.RevertProtectionRefund); err != nil {
+		return err
+	}
+
+	if err := ValidateRevenueSplit(p.RevenueSplit); err != nil {
+		return err
+	}
+
+	// The proposer fee only applies when no revenue split is configured, in which case the
+	// proposer's share is defined by the revenue split.
+	if len(p.RevenueSplit) > 0 && !p.ProposerFee.IsNil() && !p.ProposerFee.IsZero() {
+		return fmt.Errorf("proposer fee must be zero when a revenue split is configured")
+	}
+
+	if p.RequireSearcherRegistration {
+		if err := validateFee(p.MinSearcherCollateral); err != nil {
+			return fmt.Errorf("invalid minimum searcher collateral (%s)", err)
+		}
+	}
+
+	// Searchers could otherwise withdraw their collateral as soon as they have bid.
+	if p.RequireSearcherRegistration && p.SearcherUnbondingPeriod == 0 {
+		return fmt.Errorf("searcher unbonding period must be positive when searchers are required")
+	}
+
+	if err := validateProposerFee(p.ProposerFee); err != nil {
+		return err
+	}
+
+	// Refunds are paid out of the escrow account's share of the price of a winning bid.
+	if p.RevertProtectionEnabled() && p.RevertProtectionRefund.GT(p.EscrowShare()) {
+		return fmt.Errorf(
+			"revert protection refund (%s) exceeds the escrow account's share of the price (%s)",
+			p.RevertProtectionRefund,
+			p.EscrowShare(),
+		)
+	}
+
+	return nil
+}
+
+// EscrowShare returns the portion of the price of a winning bid that is paid to the escrow
+// account.
+func (p Params) EscrowShare() math.LegacyDec {
+	if len(p.RevenueSplit) == 0 {
+		return math.LegacyOneDec().Sub(p.ProposerFee)
+	}
+
+	for _, share := range p.RevenueSplit {
+		if share.Recipient == RecipientEscrow {
+			return share.Weight
+		}
+	}
+
+	return math.LegacyZeroDec()
+}
+
+// SealedBidsEnabled returns true if bids must be committed to before they are revealed
+// to the auction.
+func (p Params) SealedBidsEnabled() bool {
+	return p.RevealWindow > 0
+}
+
+// SecondPriceEnabled returns true if the winners of the auction pay the clearing price
+// determined by the runner-up bid rather than their own bid.
+func (p Params) SecondPriceEnabled() bool {
+	return p.PricingRule == PricingRule_PRICING_RULE_SECOND_PRICE
+}
+
+// RevertProtectionEnabled returns true if bidders are refunded a portion of the price paid
+// for a winning bundle when any of its bundled transactions fail.
+func (p Params) RevertProtectionEnabled() bool {
+	return !p.RevertProtectionRefund.IsNil() && p.RevertProtectionRefund.IsPositive()
+}
+
+// DeferredPayoutEnabled returns true if the auction proceeds are accrued and paid out
+// periodically rather than immediately.
+func (p Params) DeferredPayoutEnabled() bool {
+	return p.RewardPayoutInterval > 0
+}
+
+// GetAuctionFees returns the reserve fee and minimum bid increment for the given bid
+// denomination. The returned boolean is false if the denomination cannot be used to bid.
+func (p Params) GetAuctionFees(denom string) (reserveFee, minBidIncrement sdk.Coin, found bool) {
+	if p.ReserveFee.Denom == denom {
+		return p.ReserveFee, p.MinBidIncrement, true
+	}
+
+	for _, bidDenom := range p.BidDenoms {
+		if bidDenom.ReserveFee.Denom == denom {
+			return bidDenom.ReserveFee, bidDenom.MinBidIncrement, true
+		}
+	}
+
+	return sdk.Coin{}, sdk.Coin{}, false
+}
+
+// GetAllowedBidDenoms returns all of the denominations that can be used to bid in the auction.
+func (p Params) GetAllowedBidDenoms() []string {
+	denoms := []string{p.ReserveFee.Denom}
+	for _, bidDenom := range p.BidDenoms {
+		denoms = append(denoms, bidDenom.ReserveFee.Denom)
+	}
+
+	return denoms
+}
+
+func validateAuctionFees(reserveFee, minBidIncrement sdk.Coin) error {
+	if err := validateFee(reserveFee); err != nil {
 		return fmt.Errorf("invalid reserve fee (%s)", err)
 	}
 
-	if err := validateFee(p.MinBidIncrement); err != nil {
+	if err := validateFee(minBidIncrement); err != nil {
 		return fmt.Errorf("invalid minimum bid increment (%s)", err)
 	}
 
 	// Minimum bid increment must always be greater than 0.
-	if p.MinBidIncrement.IsLTE(sdk.NewCoin(p.MinBidIncrement.Denom, math.ZeroInt())) {
+	if minBidIncrement.IsLTE(sdk.NewCoin(minBidIncrement.Denom, math.ZeroInt())) {
 		return fmt.Errorf("minimum bid increment cannot be zero")
 	}
 
-	denoms := map[string]struct{}{
-		p.ReserveFee.Denom:      {},
-		p.MinBidIncrement.Denom: {},
+	if reserveFee.Denom != minBidIncrement.Denom {
+		return fmt.Errorf("mismatched auction fee denoms: minimum bid increment (%s), reserve fee (%s)", minBidIncrement, reserveFee)
 	}
 
-	if len(denoms) != 1 {
-		return fmt.Errorf("mismatched auction fee denoms: minimum bid increment (%s), reserve fee (%s)", p.MinBidIncrement, p.ReserveFee)
-	}
-
-	return validateProposerFee(p.ProposerFee)
+	return nil
 }
 
 func validateFee(fee sdk.Coin) error {
@@ -99,3 +295,18 @@ func validateProposerFee(v math.LegacyDec) error {
 
 	return nil
 }
+
+func validateRevertProtectionRefund(v math.LegacyDec) error {
+	// Parameters that were set before revert protection was introduced leave it disabled.
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("revert protection refund cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("revert protection refund too large: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPendingRewardPayoutsPerBlock is the maximum number of pending rewards that are paid out in
+// a single block. A payout that does not fit into a block is continued in the next block.
+const MaxPendingRewardPayoutsPerBlock = 100
+
+// NewPendingReward returns a new PendingReward instance.
+func NewPendingReward(address sdk.AccAddress, amount sdk.Coins) PendingReward {
+	return PendingReward{
+		Address: address.String(),
+		Amount:  amount,
+	}
+}
+
+// Validate performs basic validation on the pending reward.
+func (r PendingReward) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return errors.Wrap(err, "invalid recipient address")
+	}
+
+	if err := r.Amount.Validate(); err != nil {
+		return errors.Wrap(err, "invalid pending reward")
+	}
+
+	if r.Amount.IsZero() {
+		return fmt.Errorf("pending reward of %s cannot be zero", r.Address)
+	}
+
+	return nil
+}
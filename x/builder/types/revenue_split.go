@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Special recipients of the auction proceeds that can be used in the revenue split.
+const (
+	// RecipientProposer is the address returned by the RewardsAddressProvider.
+	RecipientProposer = "proposer"
+
+	// RecipientEscrow is the escrow account address.
+	RecipientEscrow = "escrow"
+
+	// RecipientCommunityPool is the community pool of the x/distribution module.
+	RecipientCommunityPool = "community_pool"
+
+	// RecipientBurn burns the proceeds.
+	RecipientBurn = "burn"
+
+	// RecipientFeeCollector is the fee collector module account, whose balance is
+	// distributed to stakers.
+	RecipientFeeCollector = "fee_collector"
+)
+
+// IsSpecialRecipient returns true if the recipient is one of the special recipients.
+func IsSpecialRecipient(recipient string) bool {
+	switch recipient {
+	case RecipientProposer, RecipientEscrow, RecipientCommunityPool, RecipientBurn, RecipientFeeCollector:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewRevenueShare returns a new RevenueShare instance.
+func NewRevenueShare(recipient string, weight math.LegacyDec) RevenueShare {
+	return RevenueShare{
+		Recipient: recipient,
+		Weight:    weight,
+	}
+}
+
+// ValidateRevenueSplit validates that each recipient of the revenue split is unique and is
+// either a special recipient or a valid address, and that the weights sum to one. An empty
+// revenue split is valid.
+func ValidateRevenueSplit(split []RevenueShare) error {
+	if len(split) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(split))
+	total := math.LegacyZeroDec()
+
+	for _, share := range split {
+		if !IsSpecialRecipient(share.Recipient) {
+			if _, err := sdk.AccAddressFromBech32(share.Recipient); err != nil {
+				return fmt.Errorf("invalid revenue split recipient %s: %w", share.Recipient, err)
+			}
+		}
+
+		if _, ok := seen[share.Recipient]; ok {
+			return fmt.Errorf("duplicate revenue split recipient: %s", share.Recipient)
+		}
+		seen[share.Recipient] = struct{}{}
+
+		if share.Weight.IsNil() || !share.Weight.IsPositive() {
+			return fmt.Errorf("revenue split weight of %s must be positive: %s", share.Recipient, share.Weight)
+		}
+
+		total = total.Add(share.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("revenue split weights must sum to one: %s", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSearcher returns a new Searcher instance with no recorded bids.
+func NewSearcher(address sdk.AccAddress, collateral sdk.Coin) Searcher {
+	return Searcher{
+		Address:    address.String(),
+		Collateral: collateral,
+	}
+}
+
+// Validate performs basic validation on the searcher.
+func (s Searcher) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return errors.Wrap(err, "invalid searcher address")
+	}
+
+	if s.Collateral.IsNil() {
+		return fmt.Errorf("searcher has no collateral")
+	}
+
+	if err := s.Collateral.Validate(); err != nil {
+		return errors.Wrap(err, "invalid collateral")
+	}
+
+	if err := s.Unbonding.Validate(); err != nil {
+		return errors.Wrap(err, "invalid unbonding collateral")
+	}
+
+	return nil
+}
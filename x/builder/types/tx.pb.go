@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -138,28 +139,30 @@ func (m *MsgAuctionBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAuctionBidResponse proto.InternalMessageInfo
 
-// MsgUpdateParams defines a request type for updating the x/builder module
-// parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the account that is authorized to update the
-	// x/builder module parameters.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params is the new parameters for the x/builder module.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgCommitBid defines a request type for committing to a sealed bid.
+type MsgCommitBid struct {
+	// bidder is the address of the account that is committing to a bid.
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment is the hash of the sealed bid. See MsgRevealBid for the
+	// contents of the hash.
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// collateral is the amount of coins that are locked until the bid is
+	// revealed.
+	Collateral types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgCommitBid) Reset()         { *m = MsgCommitBid{} }
+func (m *MsgCommitBid) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBid) ProtoMessage()    {}
+func (*MsgCommitBid) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5cab4e3a4b082d0a, []int{2}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCommitBid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCommitBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCommitBid.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -169,48 +172,55 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCommitBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBid.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCommitBid) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCommitBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBid.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCommitBid proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCommitBid) GetBidder() string {
 	if m != nil {
-		return m.Authority
+		return m.Bidder
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCommitBid) GetCommitment() []byte {
 	if m != nil {
-		return m.Params
+		return m.Commitment
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
-type MsgUpdateParamsResponse struct {
+func (m *MsgCommitBid) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+// MsgCommitBidResponse defines the Msg/CommitBid response type.
+type MsgCommitBidResponse struct {
+}
+
+func (m *MsgCommitBidResponse) Reset()         { *m = MsgCommitBidResponse{} }
+func (m *MsgCommitBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBidResponse) ProtoMessage()    {}
+func (*MsgCommitBidResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5cab4e3a4b082d0a, []int{3}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCommitBidResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCommitBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCommitBidResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -220,393 +230,4952 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCommitBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBidResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCommitBidResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCommitBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBidResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCommitBidResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAuctionBid)(nil), "pob.builder.v1.MsgAuctionBid")
-	proto.RegisterType((*MsgAuctionBidResponse)(nil), "pob.builder.v1.MsgAuctionBidResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "pob.builder.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pob.builder.v1.MsgUpdateParamsResponse")
+// MsgRevealBid defines a request type for revealing a sealed bid. The
+// commitment of the bid is the SHA-256 hash of the bidder, the bid, the hashes
+// of the bundled transactions and the salt.
+type MsgRevealBid struct {
+	// bidder is the address of the account that committed to the bid.
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid is the amount of coins that the bidder is bidding to participate in the
+	// auction.
+	Bid types.Coin `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid"`
+	// transactions are the bytes of the transactions that the bidder wants to
+	// bundle together.
+	Transactions [][]byte `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// salt is the random value that was used to compute the commitment.
+	Salt []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func init() { proto.RegisterFile("pob/builder/v1/tx.proto", fileDescriptor_5cab4e3a4b082d0a) }
-
-var fileDescriptor_5cab4e3a4b082d0a = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x1a, 0xc8, 0x18, 0x15, 0xd7, 0xd6, 0xfc, 0xa8, 0xdd, 0x84, 0x05, 0x69, 0x08,
-	0x74, 0xc7, 0xd4, 0xd2, 0x43, 0x6f, 0x59, 0xcf, 0x01, 0x59, 0x11, 0xc4, 0x8b, 0xcc, 0x66, 0x87,
-	0xe9, 0x60, 0x77, 0x66, 0xd9, 0x99, 0x84, 0xf6, 0x26, 0x3d, 0x7a, 0x12, 0xfc, 0x07, 0x3c, 0x7a,
-	0xcc, 0x41, 0xff, 0x87, 0x9e, 0xa4, 0xe8, 0x41, 0x4f, 0x22, 0x89, 0x10, 0xff, 0x0c, 0x99, 0xdd,
-	0xd9, 0x36, 0x1b, 0xc5, 0x5e, 0x42, 0xf6, 0x7d, 0xdf, 0xfb, 0xde, 0xfb, 0xbe, 0x79, 0xb0, 0x1e,
-	0x8b, 0x00, 0x05, 0x63, 0x76, 0x14, 0x92, 0x04, 0x4d, 0xfa, 0x48, 0x1d, 0xbb, 0x71, 0x22, 0x94,
-	0xb0, 0x6e, 0xc5, 0x22, 0x70, 0x0d, 0xe0, 0x4e, 0xfa, 0xad, 0x75, 0x2a, 0xa8, 0x48, 0x21, 0xa4,
-	0xff, 0x65, 0xac, 0xd6, 0x7d, 0x2a, 0x04, 0x3d, 0x22, 0x08, 0xc7, 0x0c, 0x61, 0xce, 0x85, 0xc2,
-	0x8a, 0x09, 0x2e, 0x0d, 0x6a, 0x8f, 0x84, 0x8c, 0x84, 0x44, 0x01, 0x96, 0x04, 0x4d, 0xfa, 0x01,
-	0x51, 0xb8, 0x8f, 0x46, 0x82, 0xf1, 0xbc, 0x7b, 0x65, 0x38, 0x25, 0x9c, 0x48, 0x96, 0x77, 0x37,
-	0xb3, 0xee, 0x97, 0xd9, 0xd0, 0xec, 0xc3, 0x40, 0x75, 0x23, 0x1c, 0x49, 0xaa, 0xfb, 0x22, 0x49,
-	0x0d, 0x70, 0x07, 0x47, 0x8c, 0x0b, 0x94, 0xfe, 0x66, 0x25, 0xe7, 0x33, 0x80, 0x37, 0x87, 0x92,
-	0x0e, 0xc6, 0x23, 0xbd, 0x9a, 0xc7, 0x42, 0xeb, 0x21, 0xac, 0x04, 0x2c, 0x0c, 0x49, 0xd2, 0x00,
-	0x1d, 0xd0, 0xad, 0x7a, 0x8d, 0x2f, 0x1f, 0x77, 0xd6, 0x8d, 0xfe, 0x20, 0x0c, 0x13, 0x22, 0xe5,
-	0x53, 0x95, 0x30, 0x4e, 0x7d, 0xc3, 0xb3, 0xf6, 0x61, 0x39, 0x60, 0x61, 0x63, 0xad, 0x03, 0xba,
-	0x37, 0x76, 0x9b, 0xae, 0xe1, 0x6a, 0x5b, 0xae, 0xb1, 0xe5, 0x3e, 0x16, 0x8c, 0x7b, 0xd5, 0xb3,
-	0x1f, 0xed, 0xd2, 0x87, 0xc5, 0xb4, 0x07, 0x7c, 0xdd, 0x60, 0x39, 0xb0, 0xa6, 0x12, 0xcc, 0x25,
-	0x4e, 0x67, 0xcb, 0x46, 0xb9, 0x53, 0xee, 0xd6, 0xfc, 0x42, 0xed, 0x00, 0xfd, 0x7e, 0xdf, 0x2e,
-	0x9d, 0x2e, 0xa6, 0x3d, 0x33, 0xec, 0xcd, 0x62, 0xda, 0xdb, 0xd4, 0xc1, 0x1c, 0x5f, 0x44, 0x53,
-	0x58, 0xdf, 0xa9, 0xc3, 0x8d, 0x42, 0xc1, 0x27, 0x32, 0x16, 0x5c, 0x12, 0xe7, 0x13, 0x80, 0xb7,
-	0x87, 0x92, 0x3e, 0x8b, 0x43, 0xac, 0xc8, 0x13, 0x9c, 0xe0, 0x48, 0x5a, 0xfb, 0xb0, 0x8a, 0xc7,
-	0xea, 0x50, 0x24, 0x4c, 0x9d, 0x5c, 0x69, 0xf7, 0x92, 0x6a, 0xed, 0xc1, 0x4a, 0x9c, 0x2a, 0x18,
-	0xd3, 0xf7, 0xdc, 0xe2, 0x3d, 0xb8, 0x99, 0xbe, 0x77, 0x4d, 0x3b, 0xf6, 0x0d, 0xf7, 0x60, 0x2f,
-	0xf7, 0x72, 0xa9, 0xa4, 0xed, 0x6c, 0xfd, 0x65, 0x67, 0x79, 0x47, 0xa7, 0x09, 0xeb, 0x2b, 0xa5,
-	0xdc, 0xd2, 0xee, 0x37, 0x00, 0xcb, 0x43, 0x49, 0x2d, 0x01, 0xe1, 0xd2, 0x03, 0x6e, 0xad, 0x2e,
-	0x53, 0xc8, 0xa3, 0xf5, 0xe0, 0xbf, 0xf0, 0x45, 0x5c, 0x9b, 0xa7, 0x5f, 0x7f, 0xbd, 0x5b, 0xdb,
-	0x70, 0xee, 0xa2, 0x95, 0x33, 0xd4, 0x2f, 0xf7, 0x1c, 0xd6, 0x0a, 0x39, 0xb6, 0xff, 0xa1, 0xb9,
-	0x4c, 0x68, 0x6d, 0x5f, 0x41, 0xc8, 0xc7, 0xb6, 0xae, 0xbf, 0xd6, 0xf7, 0xe1, 0x0d, 0xce, 0x66,
-	0x36, 0x38, 0x9f, 0xd9, 0xe0, 0xe7, 0xcc, 0x06, 0x6f, 0xe7, 0x76, 0xe9, 0x7c, 0x6e, 0x97, 0xbe,
-	0xcf, 0xed, 0xd2, 0x8b, 0x6d, 0xca, 0xd4, 0xe1, 0x38, 0x70, 0x47, 0x22, 0x42, 0xf2, 0x15, 0x8b,
-	0x77, 0x22, 0x32, 0x41, 0xc5, 0x04, 0xd5, 0x49, 0x4c, 0x64, 0x50, 0x49, 0x0f, 0xfc, 0xd1, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xeb, 0xfe, 0x5c, 0xc4, 0x03, 0x00, 0x00,
+func (m *MsgRevealBid) Reset()         { *m = MsgRevealBid{} }
+func (m *MsgRevealBid) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBid) ProtoMessage()    {}
+func (*MsgRevealBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AuctionBid defines a method for sending bids to the x/builder module.
-	AuctionBid(ctx context.Context, in *MsgAuctionBid, opts ...grpc.CallOption) (*MsgAuctionBidResponse, error)
-	// UpdateParams defines a governance operation for updating the x/builder
-	// module parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRevealBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRevealBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBid.Merge(m, src)
+}
+func (m *MsgRevealBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBid.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgRevealBid proto.InternalMessageInfo
+
+func (m *MsgRevealBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
 }
 
-func (c *msgClient) AuctionBid(ctx context.Context, in *MsgAuctionBid, opts ...grpc.CallOption) (*MsgAuctionBidResponse, error) {
-	out := new(MsgAuctionBidResponse)
-	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/AuctionBid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBid) GetBid() types.Coin {
+	if m != nil {
+		return m.Bid
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBid) GetTransactions() [][]byte {
+	if m != nil {
+		return m.Transactions
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AuctionBid defines a method for sending bids to the x/builder module.
-	AuctionBid(context.Context, *MsgAuctionBid) (*MsgAuctionBidResponse, error)
-	// UpdateParams defines a governance operation for updating the x/builder
-	// module parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgRevealBid) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgRevealBidResponse defines the Msg/RevealBid response type.
+type MsgRevealBidResponse struct {
 }
 
-func (*UnimplementedMsgServer) AuctionBid(ctx context.Context, req *MsgAuctionBid) (*MsgAuctionBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuctionBid not implemented")
+func (m *MsgRevealBidResponse) Reset()         { *m = MsgRevealBidResponse{} }
+func (m *MsgRevealBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidResponse) ProtoMessage()    {}
+func (*MsgRevealBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{5}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRevealBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidResponse.Merge(m, src)
+}
+func (m *MsgRevealBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRevealBidResponse proto.InternalMessageInfo
+
+// MsgRevealBidCommitment defines a request type for revealing a sealed bid
+// without bidding. It proves that the bidder revealed the bid within the
+// reveal window, so the collateral of the commitment is not slashed.
+type MsgRevealBidCommitment struct {
+	// bidder is the address of the account that committed to the bid.
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid is the amount of coins of the sealed bid.
+	Bid types.Coin `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid"`
+	// transactions are the bytes of the bundled transactions of the sealed bid.
+	Transactions [][]byte `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// salt is the random value that was used to compute the commitment.
+	Salt []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func _Msg_AuctionBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAuctionBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AuctionBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pob.builder.v1.Msg/AuctionBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AuctionBid(ctx, req.(*MsgAuctionBid))
+func (m *MsgRevealBidCommitment) Reset()         { *m = MsgRevealBidCommitment{} }
+func (m *MsgRevealBidCommitment) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidCommitment) ProtoMessage()    {}
+func (*MsgRevealBidCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{6}
+}
+func (m *MsgRevealBidCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevealBidCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidCommitment.Merge(m, src)
+}
+func (m *MsgRevealBidCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidCommitment.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pob.builder.v1.Msg/UpdateParams",
+var xxx_messageInfo_MsgRevealBidCommitment proto.InternalMessageInfo
+
+func (m *MsgRevealBidCommitment) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgRevealBidCommitment) GetBid() types.Coin {
+	if m != nil {
+		return m.Bid
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pob.builder.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AuctionBid",
-			Handler:    _Msg_AuctionBid_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pob/builder/v1/tx.proto",
+func (m *MsgRevealBidCommitment) GetTransactions() [][]byte {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
 }
 
-func (m *MsgAuctionBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBidCommitment) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAuctionBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevealBidCommitmentResponse defines the Msg/RevealBidCommitment response
+// type.
+type MsgRevealBidCommitmentResponse struct {
 }
 
-func (m *MsgAuctionBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Transactions) > 0 {
-		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Transactions[iNdEx])
-			copy(dAtA[i:], m.Transactions[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Transactions[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgRevealBidCommitmentResponse) Reset()         { *m = MsgRevealBidCommitmentResponse{} }
+func (m *MsgRevealBidCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidCommitmentResponse) ProtoMessage()    {}
+func (*MsgRevealBidCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{7}
+}
+func (m *MsgRevealBidCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	{
-		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgRevealBidCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidCommitmentResponse.Merge(m, src)
+}
+func (m *MsgRevealBidCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidCommitmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBidCommitmentResponse proto.InternalMessageInfo
+
+// MsgSubmitBundle defines a request type for submitting a bundle of
+// transactions without a bid. The bundled transactions are executed in order
+// when the message is executed, and either all of them succeed or none of
+// their state changes are applied.
+type MsgSubmitBundle struct {
+	// sender is the address of the account that is submitting the bundle.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// transactions are the bytes of the transactions that the sender wants to
+	// bundle together.
+	Transactions [][]byte `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (m *MsgSubmitBundle) Reset()         { *m = MsgSubmitBundle{} }
+func (m *MsgSubmitBundle) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBundle) ProtoMessage()    {}
+func (*MsgSubmitBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{8}
+}
+func (m *MsgSubmitBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSubmitBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBundle.Merge(m, src)
+}
+func (m *MsgSubmitBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBundle proto.InternalMessageInfo
+
+func (m *MsgSubmitBundle) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAuctionBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitBundle) GetTransactions() [][]byte {
+	if m != nil {
+		return m.Transactions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAuctionBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSubmitBundleResponse defines the Msg/SubmitBundle response type.
+type MsgSubmitBundleResponse struct {
 }
 
-func (m *MsgAuctionBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitBundleResponse) Reset()         { *m = MsgSubmitBundleResponse{} }
+func (m *MsgSubmitBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBundleResponse) ProtoMessage()    {}
+func (*MsgSubmitBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{9}
+}
+func (m *MsgSubmitBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBundleResponse.Merge(m, src)
+}
+func (m *MsgSubmitBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBundleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitBundleResponse proto.InternalMessageInfo
+
+// ExtensionOptionBundledTx defines the non-critical extension option that
+// every bundled transaction of a MsgSubmitBundle must carry. Transactions with
+// the option can only be executed as part of a bundle, so the bundled
+// transactions cannot be included in a block on their own.
+type ExtensionOptionBundledTx struct {
+}
+
+func (m *ExtensionOptionBundledTx) Reset()         { *m = ExtensionOptionBundledTx{} }
+func (m *ExtensionOptionBundledTx) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionBundledTx) ProtoMessage()    {}
+func (*ExtensionOptionBundledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{10}
+}
+func (m *ExtensionOptionBundledTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionBundledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionBundledTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ExtensionOptionBundledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionBundledTx.Merge(m, src)
+}
+func (m *ExtensionOptionBundledTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionBundledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionBundledTx.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ExtensionOptionBundledTx proto.InternalMessageInfo
+
+// MsgRegisterSearcher defines a request type for registering a searcher.
+type MsgRegisterSearcher struct {
+	// searcher is the address of the account that is registering.
+	Searcher string `protobuf:"bytes,1,opt,name=searcher,proto3" json:"searcher,omitempty"`
+	// collateral is the amount of coins that are bonded by the searcher.
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterSearcher) Reset()         { *m = MsgRegisterSearcher{} }
+func (m *MsgRegisterSearcher) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSearcher) ProtoMessage()    {}
+func (*MsgRegisterSearcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{11}
+}
+func (m *MsgRegisterSearcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSearcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSearcher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSearcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSearcher.Merge(m, src)
+}
+func (m *MsgRegisterSearcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSearcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSearcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSearcher proto.InternalMessageInfo
+
+func (m *MsgRegisterSearcher) GetSearcher() string {
+	if m != nil {
+		return m.Searcher
+	}
+	return ""
+}
+
+func (m *MsgRegisterSearcher) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+// MsgRegisterSearcherResponse defines the Msg/RegisterSearcher response type.
+type MsgRegisterSearcherResponse struct {
+}
+
+func (m *MsgRegisterSearcherResponse) Reset()         { *m = MsgRegisterSearcherResponse{} }
+func (m *MsgRegisterSearcherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSearcherResponse) ProtoMessage()    {}
+func (*MsgRegisterSearcherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{12}
+}
+func (m *MsgRegisterSearcherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSearcherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSearcherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSearcherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSearcherResponse.Merge(m, src)
+}
+func (m *MsgRegisterSearcherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSearcherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSearcherResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSearcherResponse proto.InternalMessageInfo
+
+// MsgUnbondSearcherCollateral defines a request type for unbonding collateral
+// of a registered searcher.
+type MsgUnbondSearcherCollateral struct {
+	// searcher is the address of the registered searcher.
+	Searcher string `protobuf:"bytes,1,opt,name=searcher,proto3" json:"searcher,omitempty"`
+	// amount is the amount of bonded collateral to unbond.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgUnbondSearcherCollateral) Reset()         { *m = MsgUnbondSearcherCollateral{} }
+func (m *MsgUnbondSearcherCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondSearcherCollateral) ProtoMessage()    {}
+func (*MsgUnbondSearcherCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{13}
+}
+func (m *MsgUnbondSearcherCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondSearcherCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondSearcherCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondSearcherCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondSearcherCollateral.Merge(m, src)
+}
+func (m *MsgUnbondSearcherCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondSearcherCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondSearcherCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondSearcherCollateral proto.InternalMessageInfo
+
+func (m *MsgUnbondSearcherCollateral) GetSearcher() string {
+	if m != nil {
+		return m.Searcher
+	}
+	return ""
+}
+
+func (m *MsgUnbondSearcherCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgUnbondSearcherCollateralResponse defines the Msg/UnbondSearcherCollateral
+// response type.
+type MsgUnbondSearcherCollateralResponse struct {
+	// completion_height is the block height from which the unbonding collateral
+	// can be withdrawn.
+	CompletionHeight uint64 `protobuf:"varint,1,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *MsgUnbondSearcherCollateralResponse) Reset()         { *m = MsgUnbondSearcherCollateralResponse{} }
+func (m *MsgUnbondSearcherCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondSearcherCollateralResponse) ProtoMessage()    {}
+func (*MsgUnbondSearcherCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{14}
+}
+func (m *MsgUnbondSearcherCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondSearcherCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondSearcherCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondSearcherCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondSearcherCollateralResponse.Merge(m, src)
+}
+func (m *MsgUnbondSearcherCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondSearcherCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondSearcherCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondSearcherCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondSearcherCollateralResponse) GetCompletionHeight() uint64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
+}
+
+// MsgWithdrawSearcherCollateral defines a request type for withdrawing the
+// unbonded collateral of a registered searcher.
+type MsgWithdrawSearcherCollateral struct {
+	// searcher is the address of the registered searcher.
+	Searcher string `protobuf:"bytes,1,opt,name=searcher,proto3" json:"searcher,omitempty"`
+}
+
+func (m *MsgWithdrawSearcherCollateral) Reset()         { *m = MsgWithdrawSearcherCollateral{} }
+func (m *MsgWithdrawSearcherCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSearcherCollateral) ProtoMessage()    {}
+func (*MsgWithdrawSearcherCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{15}
+}
+func (m *MsgWithdrawSearcherCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSearcherCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSearcherCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSearcherCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSearcherCollateral.Merge(m, src)
+}
+func (m *MsgWithdrawSearcherCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSearcherCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSearcherCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSearcherCollateral proto.InternalMessageInfo
+
+func (m *MsgWithdrawSearcherCollateral) GetSearcher() string {
+	if m != nil {
+		return m.Searcher
+	}
+	return ""
+}
+
+// MsgWithdrawSearcherCollateralResponse defines the
+// Msg/WithdrawSearcherCollateral response type.
+type MsgWithdrawSearcherCollateralResponse struct {
+	// amount is the amount of collateral that was withdrawn.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawSearcherCollateralResponse) Reset()         { *m = MsgWithdrawSearcherCollateralResponse{} }
+func (m *MsgWithdrawSearcherCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSearcherCollateralResponse) ProtoMessage()    {}
+func (*MsgWithdrawSearcherCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{16}
+}
+func (m *MsgWithdrawSearcherCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSearcherCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSearcherCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSearcherCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSearcherCollateralResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSearcherCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSearcherCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSearcherCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSearcherCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawSearcherCollateralResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawBuilderRewards defines a request type for withdrawing the
+// proposer rewards accrued by a validator. The rewards are always sent to the
+// validator's withdraw address, so any account can submit the request.
+type MsgWithdrawBuilderRewards struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// sender is the address of the account that submits the request.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgWithdrawBuilderRewards) Reset()         { *m = MsgWithdrawBuilderRewards{} }
+func (m *MsgWithdrawBuilderRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawBuilderRewards) ProtoMessage()    {}
+func (*MsgWithdrawBuilderRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{17}
+}
+func (m *MsgWithdrawBuilderRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawBuilderRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawBuilderRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawBuilderRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawBuilderRewards.Merge(m, src)
+}
+func (m *MsgWithdrawBuilderRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawBuilderRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawBuilderRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawBuilderRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawBuilderRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawBuilderRewards) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgWithdrawBuilderRewardsResponse defines the Msg/WithdrawBuilderRewards
+// response type.
+type MsgWithdrawBuilderRewardsResponse struct {
+	// amount is the amount of coins that were withdrawn.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawBuilderRewardsResponse) Reset()         { *m = MsgWithdrawBuilderRewardsResponse{} }
+func (m *MsgWithdrawBuilderRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawBuilderRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawBuilderRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{18}
+}
+func (m *MsgWithdrawBuilderRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawBuilderRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawBuilderRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawBuilderRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawBuilderRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawBuilderRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawBuilderRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawBuilderRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawBuilderRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawBuilderRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetBuilderRewardsWithdrawAddress defines a request type for setting the
+// address that a validator withdraws its proposer rewards to.
+type MsgSetBuilderRewardsWithdrawAddress struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdraw_address is the address that receives the withdrawn rewards.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddress) Reset()         { *m = MsgSetBuilderRewardsWithdrawAddress{} }
+func (m *MsgSetBuilderRewardsWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBuilderRewardsWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetBuilderRewardsWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{19}
+}
+func (m *MsgSetBuilderRewardsWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBuilderRewardsWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBuilderRewardsWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetBuilderRewardsWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBuilderRewardsWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddress proto.InternalMessageInfo
+
+func (m *MsgSetBuilderRewardsWithdrawAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// MsgSetBuilderRewardsWithdrawAddressResponse defines the
+// Msg/SetBuilderRewardsWithdrawAddress response type.
+type MsgSetBuilderRewardsWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) Reset() {
+	*m = MsgSetBuilderRewardsWithdrawAddressResponse{}
+}
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetBuilderRewardsWithdrawAddressResponse) ProtoMessage() {}
+func (*MsgSetBuilderRewardsWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{20}
+}
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBuilderRewardsWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgReportCensoredBid defines a request type for reporting a bid that was
+// censored by the proposer of a block.
+type MsgReportCensoredBid struct {
+	// reporter is the address of the bidder whose bid was censored.
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// height is the block height at which the bid was censored.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// bid_tx is the bid transaction that was censored.
+	BidTx []byte `protobuf:"bytes,3,opt,name=bid_tx,json=bidTx,proto3" json:"bid_tx,omitempty"`
+	// validator_address is the consensus address of the validator whose vote
+	// extension included the bid transaction.
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// vote_extension is the vote extension of the validator in the commit of
+	// the block before the given height.
+	VoteExtension []byte `protobuf:"bytes,5,opt,name=vote_extension,json=voteExtension,proto3" json:"vote_extension,omitempty"`
+	// extension_signature is the signature of the validator over the vote
+	// extension.
+	ExtensionSignature []byte `protobuf:"bytes,6,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+}
+
+func (m *MsgReportCensoredBid) Reset()         { *m = MsgReportCensoredBid{} }
+func (m *MsgReportCensoredBid) String() string { return proto.CompactTextString(m) }
+func (*MsgReportCensoredBid) ProtoMessage()    {}
+func (*MsgReportCensoredBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{21}
+}
+func (m *MsgReportCensoredBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportCensoredBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportCensoredBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportCensoredBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportCensoredBid.Merge(m, src)
+}
+func (m *MsgReportCensoredBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportCensoredBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportCensoredBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportCensoredBid proto.InternalMessageInfo
+
+func (m *MsgReportCensoredBid) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MsgReportCensoredBid) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgReportCensoredBid) GetBidTx() []byte {
+	if m != nil {
+		return m.BidTx
+	}
+	return nil
+}
+
+func (m *MsgReportCensoredBid) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgReportCensoredBid) GetVoteExtension() []byte {
+	if m != nil {
+		return m.VoteExtension
+	}
+	return nil
+}
+
+func (m *MsgReportCensoredBid) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
+// MsgReportCensoredBidResponse defines the Msg/ReportCensoredBid response
+// type.
+type MsgReportCensoredBidResponse struct {
+}
+
+func (m *MsgReportCensoredBidResponse) Reset()         { *m = MsgReportCensoredBidResponse{} }
+func (m *MsgReportCensoredBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportCensoredBidResponse) ProtoMessage()    {}
+func (*MsgReportCensoredBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{22}
+}
+func (m *MsgReportCensoredBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportCensoredBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportCensoredBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportCensoredBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportCensoredBidResponse.Merge(m, src)
+}
+func (m *MsgReportCensoredBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportCensoredBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportCensoredBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportCensoredBidResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a request type for updating the x/builder module
+// parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the account that is authorized to update the
+	// x/builder module parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params is the new parameters for the x/builder module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{23}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cab4e3a4b082d0a, []int{24}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAuctionBid)(nil), "pob.builder.v1.MsgAuctionBid")
+	proto.RegisterType((*MsgAuctionBidResponse)(nil), "pob.builder.v1.MsgAuctionBidResponse")
+	proto.RegisterType((*MsgCommitBid)(nil), "pob.builder.v1.MsgCommitBid")
+	proto.RegisterType((*MsgCommitBidResponse)(nil), "pob.builder.v1.MsgCommitBidResponse")
+	proto.RegisterType((*MsgRevealBid)(nil), "pob.builder.v1.MsgRevealBid")
+	proto.RegisterType((*MsgRevealBidResponse)(nil), "pob.builder.v1.MsgRevealBidResponse")
+	proto.RegisterType((*MsgRevealBidCommitment)(nil), "pob.builder.v1.MsgRevealBidCommitment")
+	proto.RegisterType((*MsgRevealBidCommitmentResponse)(nil), "pob.builder.v1.MsgRevealBidCommitmentResponse")
+	proto.RegisterType((*MsgSubmitBundle)(nil), "pob.builder.v1.MsgSubmitBundle")
+	proto.RegisterType((*MsgSubmitBundleResponse)(nil), "pob.builder.v1.MsgSubmitBundleResponse")
+	proto.RegisterType((*ExtensionOptionBundledTx)(nil), "pob.builder.v1.ExtensionOptionBundledTx")
+	proto.RegisterType((*MsgRegisterSearcher)(nil), "pob.builder.v1.MsgRegisterSearcher")
+	proto.RegisterType((*MsgRegisterSearcherResponse)(nil), "pob.builder.v1.MsgRegisterSearcherResponse")
+	proto.RegisterType((*MsgUnbondSearcherCollateral)(nil), "pob.builder.v1.MsgUnbondSearcherCollateral")
+	proto.RegisterType((*MsgUnbondSearcherCollateralResponse)(nil), "pob.builder.v1.MsgUnbondSearcherCollateralResponse")
+	proto.RegisterType((*MsgWithdrawSearcherCollateral)(nil), "pob.builder.v1.MsgWithdrawSearcherCollateral")
+	proto.RegisterType((*MsgWithdrawSearcherCollateralResponse)(nil), "pob.builder.v1.MsgWithdrawSearcherCollateralResponse")
+	proto.RegisterType((*MsgWithdrawBuilderRewards)(nil), "pob.builder.v1.MsgWithdrawBuilderRewards")
+	proto.RegisterType((*MsgWithdrawBuilderRewardsResponse)(nil), "pob.builder.v1.MsgWithdrawBuilderRewardsResponse")
+	proto.RegisterType((*MsgSetBuilderRewardsWithdrawAddress)(nil), "pob.builder.v1.MsgSetBuilderRewardsWithdrawAddress")
+	proto.RegisterType((*MsgSetBuilderRewardsWithdrawAddressResponse)(nil), "pob.builder.v1.MsgSetBuilderRewardsWithdrawAddressResponse")
+	proto.RegisterType((*MsgReportCensoredBid)(nil), "pob.builder.v1.MsgReportCensoredBid")
+	proto.RegisterType((*MsgReportCensoredBidResponse)(nil), "pob.builder.v1.MsgReportCensoredBidResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "pob.builder.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pob.builder.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("pob/builder/v1/tx.proto", fileDescriptor_5cab4e3a4b082d0a) }
+
+var fileDescriptor_5cab4e3a4b082d0a = []byte{
+	// 1460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0x69, 0xd4, 0xcc, 0xd7, 0x6d, 0x93, 0x4d, 0x9b, 0x38, 0xdb, 0xc4, 0x71, 0x26,
+	0x49, 0x93, 0x26, 0xb1, 0xf7, 0x9b, 0x36, 0x2d, 0x90, 0x72, 0x69, 0x0c, 0x88, 0x4b, 0x00, 0x39,
+	0x2d, 0x20, 0x2e, 0xd6, 0xae, 0x77, 0xb4, 0x5e, 0xd5, 0xde, 0xb1, 0x76, 0xc6, 0x4e, 0x7a, 0x02,
+	0xf5, 0x88, 0x84, 0x28, 0x82, 0x13, 0xe2, 0xd0, 0x23, 0xe2, 0x94, 0x43, 0x91, 0x38, 0x73, 0xaa,
+	0x7a, 0x40, 0x55, 0x91, 0x10, 0x42, 0x02, 0xaa, 0x16, 0xa9, 0xfc, 0x01, 0xfc, 0x01, 0x68, 0x67,
+	0x67, 0xc7, 0xbb, 0xeb, 0xf1, 0x8f, 0x44, 0x95, 0xe8, 0xa5, 0xb5, 0xdf, 0xfb, 0xbc, 0x79, 0x9f,
+	0xcf, 0x7b, 0x9e, 0x99, 0x37, 0x01, 0xd3, 0x0d, 0x6c, 0xea, 0x66, 0xd3, 0xa9, 0x59, 0xc8, 0xd3,
+	0x5b, 0x9b, 0x3a, 0x3d, 0x28, 0x34, 0x3c, 0x4c, 0xb1, 0x7a, 0xba, 0x81, 0xcd, 0x02, 0x77, 0x14,
+	0x5a, 0x9b, 0xda, 0x59, 0x1b, 0xdb, 0x98, 0xb9, 0x74, 0xff, 0x53, 0x80, 0xd2, 0x66, 0x6d, 0x8c,
+	0xed, 0x1a, 0xd2, 0x8d, 0x86, 0xa3, 0x1b, 0xae, 0x8b, 0xa9, 0x41, 0x1d, 0xec, 0x12, 0xee, 0xcd,
+	0x56, 0x30, 0xa9, 0x63, 0xa2, 0x9b, 0x06, 0x41, 0x7a, 0x6b, 0xd3, 0x44, 0xd4, 0xd8, 0xd4, 0x2b,
+	0xd8, 0x71, 0xc3, 0xe8, 0x44, 0x72, 0x1b, 0xb9, 0x88, 0x38, 0x61, 0xf4, 0x4c, 0x10, 0x5d, 0x0e,
+	0x92, 0x06, 0x5f, 0xb8, 0x6b, 0x9a, 0x2f, 0x5c, 0x27, 0xb6, 0x1f, 0x57, 0x27, 0x36, 0x77, 0x4c,
+	0x18, 0x75, 0xc7, 0xc5, 0x3a, 0xfb, 0x37, 0x30, 0xc1, 0x9f, 0x14, 0x70, 0x6a, 0x97, 0xd8, 0xd7,
+	0x9b, 0x15, 0x9f, 0xda, 0x8e, 0x63, 0xa9, 0xff, 0x07, 0xa3, 0xa6, 0x63, 0x59, 0xc8, 0xcb, 0x28,
+	0x39, 0x65, 0x75, 0x6c, 0x27, 0xf3, 0xf8, 0x7e, 0xfe, 0x2c, 0x5f, 0xff, 0xba, 0x65, 0x79, 0x88,
+	0x90, 0x3d, 0xea, 0x39, 0xae, 0x5d, 0xe2, 0x38, 0xf5, 0x2a, 0x18, 0x36, 0x1d, 0x2b, 0x93, 0xca,
+	0x29, 0xab, 0xff, 0xbb, 0x34, 0x53, 0xe0, 0x58, 0x5f, 0x56, 0x81, 0xcb, 0x2a, 0x14, 0xb1, 0xe3,
+	0xee, 0x8c, 0x3d, 0xf8, 0x63, 0x7e, 0xe8, 0xdb, 0xe7, 0x87, 0x6b, 0x4a, 0xc9, 0x0f, 0x50, 0x21,
+	0x48, 0x53, 0xcf, 0x70, 0x89, 0xc1, 0x72, 0x93, 0xcc, 0x70, 0x6e, 0x78, 0x35, 0x5d, 0x8a, 0xd9,
+	0xb6, 0xf5, 0xbf, 0xef, 0xcd, 0x0f, 0xdd, 0x79, 0x7e, 0xb8, 0xc6, 0x93, 0x7d, 0xfa, 0xfc, 0x70,
+	0xed, 0xbc, 0x5f, 0x98, 0x03, 0x51, 0x9a, 0x18, 0x7d, 0x38, 0x0d, 0xce, 0xc5, 0x0c, 0x25, 0x44,
+	0x1a, 0xd8, 0x25, 0x08, 0xfe, 0xa2, 0x80, 0xf4, 0x2e, 0xb1, 0x8b, 0xb8, 0x5e, 0x77, 0xe8, 0xf1,
+	0x84, 0x66, 0x01, 0xa8, 0xb0, 0xf0, 0x3a, 0x72, 0x29, 0xd3, 0x9b, 0x2e, 0x45, 0x2c, 0xea, 0x1b,
+	0xbe, 0xbf, 0x56, 0x33, 0x28, 0xf2, 0x8c, 0x5a, 0x66, 0xf8, 0x08, 0xf5, 0x88, 0xc4, 0x6d, 0x17,
+	0x24, 0x92, 0xb5, 0x0e, 0xc9, 0x42, 0x07, 0x9c, 0x02, 0x67, 0xa3, 0xdf, 0x85, 0xe0, 0x27, 0x81,
+	0xe0, 0x12, 0x6a, 0x21, 0xa3, 0xf6, 0xd2, 0x75, 0x56, 0x55, 0xc1, 0x08, 0x31, 0x6a, 0x34, 0x33,
+	0xc2, 0xca, 0xc8, 0x3e, 0x0f, 0x28, 0x5d, 0x28, 0xe2, 0xd2, 0xc5, 0x77, 0x21, 0xfd, 0x1f, 0x05,
+	0x4c, 0x45, 0x1d, 0xc5, 0x76, 0x8f, 0x5e, 0xfe, 0x22, 0xbc, 0x2a, 0x29, 0xc2, 0x52, 0xf7, 0x22,
+	0xb4, 0xb5, 0xc1, 0x1c, 0xc8, 0xca, 0x3d, 0xa2, 0x30, 0x5f, 0x2b, 0xe0, 0xcc, 0x2e, 0xb1, 0xf7,
+	0x9a, 0xa6, 0xff, 0x63, 0x69, 0xba, 0x56, 0x0d, 0xf9, 0x15, 0x21, 0xc8, 0x1d, 0xa8, 0x22, 0x01,
+	0xae, 0x43, 0x59, 0x4a, 0xb2, 0x71, 0x37, 0x85, 0x8a, 0x20, 0xc8, 0x57, 0x31, 0xd7, 0xa1, 0x22,
+	0x4a, 0x04, 0xce, 0x80, 0xe9, 0x84, 0x49, 0xf0, 0xd6, 0x40, 0xe6, 0xcd, 0x03, 0x8a, 0x5c, 0xe2,
+	0x60, 0xf7, 0xdd, 0x06, 0xdb, 0xda, 0x0c, 0x60, 0xdd, 0x38, 0x80, 0x0f, 0x15, 0x30, 0xc9, 0x64,
+	0xdb, 0x0e, 0xa1, 0xc8, 0xdb, 0x43, 0x86, 0x57, 0xa9, 0x22, 0x4f, 0xdd, 0x02, 0x27, 0x09, 0xff,
+	0xdc, 0x57, 0x99, 0x40, 0x26, 0xf6, 0x70, 0xea, 0x98, 0x7b, 0xf8, 0x95, 0x50, 0xbd, 0x58, 0xd8,
+	0xd7, 0xbf, 0x20, 0xe9, 0x62, 0x9c, 0x34, 0x9c, 0x03, 0xe7, 0x25, 0x66, 0x51, 0x87, 0x87, 0x0a,
+	0xf3, 0xdf, 0x74, 0x4d, 0xec, 0x5a, 0xa1, 0xb7, 0x28, 0xf2, 0x1e, 0x53, 0xf3, 0xeb, 0x60, 0xd4,
+	0xa8, 0xe3, 0xa6, 0x4b, 0x8f, 0xa4, 0x97, 0xc7, 0x0c, 0xac, 0x35, 0x20, 0xdd, 0x26, 0x0b, 0x4b,
+	0x60, 0xb1, 0x87, 0x96, 0x50, 0xb3, 0xba, 0x0e, 0x26, 0x2a, 0xb8, 0xde, 0xa8, 0x21, 0xbf, 0xed,
+	0xe5, 0x2a, 0x72, 0xec, 0x2a, 0x65, 0xe2, 0x46, 0x4a, 0xe3, 0x6d, 0xc7, 0xdb, 0xcc, 0x0e, 0xef,
+	0x2a, 0x60, 0x6e, 0x97, 0xd8, 0x1f, 0x38, 0xb4, 0x6a, 0x79, 0xc6, 0xfe, 0x8b, 0x2a, 0xd1, 0xf6,
+	0x6b, 0x52, 0x91, 0x8b, 0x1d, 0x22, 0xc3, 0xc4, 0x11, 0x99, 0x5f, 0x28, 0x60, 0xb9, 0x27, 0x25,
+	0xa1, 0xb4, 0x2a, 0xfa, 0xa0, 0xe4, 0x86, 0x7b, 0xf7, 0xe1, 0x8a, 0xdf, 0x87, 0xef, 0xfe, 0x9c,
+	0x5f, 0xb5, 0x1d, 0x5a, 0x6d, 0x9a, 0x85, 0x0a, 0xae, 0xf3, 0x21, 0x80, 0xff, 0x97, 0x27, 0xd6,
+	0x2d, 0x9d, 0xde, 0x6e, 0x20, 0xc2, 0x02, 0x48, 0xac, 0x67, 0xf0, 0x37, 0x05, 0xcc, 0x44, 0x38,
+	0xed, 0x04, 0x02, 0x4a, 0x68, 0xdf, 0xf0, 0x2c, 0xa2, 0xbe, 0x03, 0x26, 0x5a, 0x46, 0xcd, 0xb1,
+	0x0c, 0x8a, 0xbd, 0xb2, 0x11, 0x54, 0x84, 0xd7, 0x6a, 0xe1, 0xf1, 0xfd, 0xfc, 0x1c, 0x67, 0xf5,
+	0x7e, 0x88, 0x89, 0x17, 0x6d, 0xbc, 0x95, 0xb0, 0x47, 0x4e, 0x98, 0xd4, 0x60, 0x27, 0xcc, 0xf6,
+	0x35, 0xc9, 0xe9, 0xb1, 0xd2, 0xb5, 0xd8, 0x71, 0xfa, 0xf0, 0x33, 0x05, 0x2c, 0x74, 0xf5, 0xfe,
+	0x07, 0xc5, 0xbe, 0x93, 0x62, 0x3f, 0xf4, 0x3d, 0x44, 0xe3, 0x54, 0x42, 0x82, 0x61, 0x99, 0x5e,
+	0x74, 0xd9, 0x8b, 0x60, 0x7c, 0x9f, 0xa7, 0x10, 0xcb, 0xf5, 0x6b, 0xc0, 0x99, 0xfd, 0x38, 0xa9,
+	0xed, 0xb7, 0xc2, 0x4e, 0x74, 0x72, 0x93, 0x37, 0x65, 0x0f, 0x51, 0x89, 0x3e, 0x98, 0x07, 0xeb,
+	0x03, 0xd4, 0x40, 0x1c, 0x74, 0xbf, 0xa7, 0xf8, 0xd5, 0xde, 0xc0, 0x1e, 0x2d, 0x22, 0x97, 0x60,
+	0x0f, 0x59, 0xfe, 0x10, 0xb3, 0x05, 0x4e, 0x7a, 0xcc, 0x38, 0xc8, 0xf6, 0x0d, 0x91, 0xea, 0x14,
+	0x18, 0xe5, 0x07, 0x47, 0x8a, 0x1d, 0x1c, 0xfc, 0x9b, 0x7a, 0x8e, 0x4d, 0x03, 0x65, 0x7a, 0xc0,
+	0xa6, 0xb5, 0x74, 0xe9, 0x84, 0xe9, 0x58, 0x37, 0x0e, 0xe4, 0x9d, 0x18, 0xe9, 0xe8, 0x44, 0xd1,
+	0xa7, 0xea, 0x92, 0x26, 0xe9, 0xd7, 0x89, 0x65, 0x70, 0xba, 0x85, 0x29, 0x2a, 0xa3, 0xf0, 0x0e,
+	0xcb, 0x9c, 0x60, 0xe9, 0x4e, 0xf9, 0x56, 0x71, 0xb1, 0xa9, 0x3a, 0x98, 0x14, 0x88, 0x32, 0x71,
+	0x6c, 0xd7, 0xa0, 0x4d, 0x0f, 0x65, 0x46, 0x19, 0x56, 0x15, 0xae, 0xbd, 0xd0, 0xd3, 0x1e, 0x15,
+	0x84, 0x52, 0xbf, 0x27, 0x50, 0x72, 0xcd, 0x24, 0xca, 0x08, 0xb3, 0x60, 0x56, 0x66, 0x17, 0xf5,
+	0xff, 0x3e, 0x18, 0x14, 0x6e, 0x36, 0x2c, 0x83, 0xa2, 0xf7, 0x0c, 0xcf, 0xa8, 0x13, 0xf5, 0x2a,
+	0x18, 0x33, 0x9a, 0xb4, 0x8a, 0x3d, 0x87, 0xde, 0xee, 0x5b, 0xfb, 0x36, 0x54, 0xdd, 0x02, 0xa3,
+	0x0d, 0xb6, 0x02, 0xbf, 0x5e, 0xa6, 0x0a, 0xf1, 0xd7, 0x53, 0x21, 0x58, 0x7f, 0x67, 0xc4, 0xdf,
+	0x66, 0x25, 0x8e, 0xdd, 0xde, 0x0a, 0xb5, 0xb5, 0x57, 0x92, 0xcf, 0x10, 0x51, 0x8e, 0x7c, 0x86,
+	0x88, 0x9a, 0x42, 0x49, 0x97, 0x9e, 0x9c, 0x02, 0xc3, 0xbb, 0xc4, 0x56, 0x31, 0x00, 0x91, 0xe7,
+	0xce, 0x5c, 0x92, 0x4c, 0xec, 0xf5, 0xa0, 0x2d, 0xf7, 0x74, 0x8b, 0x72, 0x9d, 0xbf, 0xf3, 0xf3,
+	0x5f, 0x5f, 0xa6, 0xce, 0xc1, 0x49, 0x3d, 0xf1, 0x68, 0xf3, 0x07, 0x41, 0x02, 0xc6, 0xda, 0xaf,
+	0x8e, 0x59, 0xc9, 0x82, 0xc2, 0xab, 0x2d, 0xf5, 0xf2, 0x8a, 0x6c, 0x90, 0x65, 0x9b, 0x85, 0x5a,
+	0x32, 0x5b, 0xf0, 0x16, 0x29, 0xf3, 0xa4, 0xed, 0xc9, 0x5f, 0x96, 0x54, 0x78, 0xb5, 0xa5, 0x5e,
+	0xde, 0xfe, 0x49, 0x3d, 0x06, 0x65, 0x49, 0xbf, 0x51, 0xc0, 0xa4, 0x6c, 0xe8, 0xbe, 0xd0, 0x2b,
+	0x43, 0x1b, 0xa7, 0x15, 0x06, 0xc3, 0x09, 0x4e, 0x79, 0xc6, 0x69, 0x05, 0x2e, 0x77, 0xe7, 0x54,
+	0x8e, 0xbc, 0xcf, 0x3e, 0x06, 0xe9, 0xd8, 0xe4, 0x3b, 0x2f, 0x49, 0x17, 0x05, 0x68, 0x2b, 0x7d,
+	0x00, 0x82, 0xc8, 0x32, 0x23, 0x32, 0x0f, 0xe7, 0x92, 0x44, 0x08, 0x43, 0x97, 0xcd, 0x20, 0xe1,
+	0xe7, 0x0a, 0x18, 0xef, 0x98, 0x53, 0x17, 0xa5, 0xa2, 0xe3, 0x20, 0x6d, 0x7d, 0x00, 0x90, 0x60,
+	0x73, 0x91, 0xb1, 0x59, 0x84, 0x0b, 0x9d, 0x65, 0x09, 0x22, 0xca, 0x62, 0xf4, 0x3b, 0x54, 0x40,
+	0xa6, 0xeb, 0x34, 0x29, 0x4b, 0xda, 0x0d, 0xac, 0x5d, 0x3e, 0x02, 0x58, 0x30, 0xbd, 0xc4, 0x98,
+	0x6e, 0xc0, 0xb5, 0x24, 0xd3, 0x26, 0x8b, 0x14, 0x3c, 0xcb, 0xed, 0xd9, 0x5a, 0xfd, 0x41, 0x01,
+	0x5a, 0x8f, 0xf9, 0x2e, 0x2f, 0xe1, 0xd1, 0x1d, 0xae, 0x5d, 0x39, 0x12, 0x5c, 0x10, 0xdf, 0x62,
+	0xc4, 0x0b, 0x70, 0x23, 0x49, 0x5c, 0xdc, 0xb8, 0x32, 0xea, 0xf7, 0x14, 0x30, 0xd5, 0x65, 0xe6,
+	0xba, 0xd8, 0x83, 0x47, 0x1c, 0xaa, 0x6d, 0x0e, 0x0c, 0x15, 0x74, 0x57, 0x19, 0x5d, 0x08, 0x73,
+	0x5d, 0xe9, 0x7a, 0x9c, 0xc7, 0x8f, 0x0a, 0xc8, 0xf5, 0x9d, 0x54, 0x64, 0xbd, 0xee, 0x17, 0xa4,
+	0x5d, 0x3b, 0x46, 0x90, 0x10, 0xb0, 0xc1, 0x04, 0x5c, 0x80, 0x4b, 0x1d, 0x1b, 0x0c, 0xd1, 0x72,
+	0x72, 0xca, 0x51, 0xbf, 0x52, 0xc0, 0x44, 0xe7, 0xe8, 0x20, 0x3f, 0xe7, 0x12, 0x28, 0x6d, 0x63,
+	0x10, 0x94, 0xe0, 0xb5, 0xce, 0x78, 0x2d, 0xc3, 0xc5, 0xce, 0xad, 0xe6, 0x87, 0x94, 0x2b, 0x3c,
+	0x86, 0x1d, 0x8f, 0x1f, 0x82, 0x74, 0xec, 0x42, 0x95, 0x9d, 0x3f, 0x51, 0x80, 0xb6, 0xd2, 0x07,
+	0x10, 0xd2, 0xd0, 0x4e, 0x7c, 0xe2, 0x4f, 0x9c, 0x3b, 0xd7, 0x1f, 0x3c, 0xcd, 0x2a, 0x8f, 0x9e,
+	0x66, 0x95, 0x27, 0x4f, 0xb3, 0xca, 0xdd, 0x67, 0xd9, 0xa1, 0x47, 0xcf, 0xb2, 0x43, 0xbf, 0x3e,
+	0xcb, 0x0e, 0x7d, 0xb4, 0x12, 0x19, 0x5d, 0xc9, 0x2d, 0xa7, 0x91, 0xaf, 0xa3, 0x96, 0x1e, 0xbf,
+	0x4a, 0xd9, 0xfc, 0x6a, 0x8e, 0xb2, 0xbf, 0x0b, 0x5e, 0xfe, 0x77, 0x00, 0x46, 0x64, 0xe9, 0x5f,
+	0xfb, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AuctionBid defines a method for sending bids to the x/builder module.
+	AuctionBid(ctx context.Context, in *MsgAuctionBid, opts ...grpc.CallOption) (*MsgAuctionBidResponse, error)
+	// CommitBid defines a method for committing to a sealed bid.
+	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method for revealing a sealed bid. Revealed bids
+	// participate in the auction in the same way as bids sent with AuctionBid.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	// RevealBidCommitment defines a method for revealing a sealed bid without
+	// bidding, e.g. once the revealed bid lost the auction. The collateral of a
+	// revealed commitment is returned to the bidder once the commitment expires.
+	RevealBidCommitment(ctx context.Context, in *MsgRevealBidCommitment, opts ...grpc.CallOption) (*MsgRevealBidCommitmentResponse, error)
+	// SubmitBundle defines a method for submitting an ordered bundle of
+	// transactions that is included atomically without a bid. Bundles are
+	// ranked by the combined fees of their transactions.
+	SubmitBundle(ctx context.Context, in *MsgSubmitBundle, opts ...grpc.CallOption) (*MsgSubmitBundleResponse, error)
+	// RegisterSearcher defines a method for registering a searcher and bonding
+	// collateral. Registered searchers can add collateral by registering again.
+	RegisterSearcher(ctx context.Context, in *MsgRegisterSearcher, opts ...grpc.CallOption) (*MsgRegisterSearcherResponse, error)
+	// UnbondSearcherCollateral defines a method for unbonding collateral of a
+	// registered searcher. The collateral can be withdrawn once the searcher
+	// unbonding period has passed.
+	UnbondSearcherCollateral(ctx context.Context, in *MsgUnbondSearcherCollateral, opts ...grpc.CallOption) (*MsgUnbondSearcherCollateralResponse, error)
+	// WithdrawSearcherCollateral defines a method for withdrawing the unbonded
+	// collateral of a registered searcher.
+	WithdrawSearcherCollateral(ctx context.Context, in *MsgWithdrawSearcherCollateral, opts ...grpc.CallOption) (*MsgWithdrawSearcherCollateralResponse, error)
+	// WithdrawBuilderRewards defines a method for withdrawing the proposer
+	// rewards accrued by a validator.
+	WithdrawBuilderRewards(ctx context.Context, in *MsgWithdrawBuilderRewards, opts ...grpc.CallOption) (*MsgWithdrawBuilderRewardsResponse, error)
+	// SetBuilderRewardsWithdrawAddress defines a method for setting the address
+	// that a validator withdraws its proposer rewards to.
+	SetBuilderRewardsWithdrawAddress(ctx context.Context, in *MsgSetBuilderRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetBuilderRewardsWithdrawAddressResponse, error)
+	// ReportCensoredBid defines a method for reporting that the proposer of a
+	// block omitted a bid of the reporter that was included in the vote
+	// extension of a validator.
+	ReportCensoredBid(ctx context.Context, in *MsgReportCensoredBid, opts ...grpc.CallOption) (*MsgReportCensoredBidResponse, error)
+	// UpdateParams defines a governance operation for updating the x/builder
+	// module parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AuctionBid(ctx context.Context, in *MsgAuctionBid, opts ...grpc.CallOption) (*MsgAuctionBidResponse, error) {
+	out := new(MsgAuctionBidResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/AuctionBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error) {
+	out := new(MsgCommitBidResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/CommitBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/RevealBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBidCommitment(ctx context.Context, in *MsgRevealBidCommitment, opts ...grpc.CallOption) (*MsgRevealBidCommitmentResponse, error) {
+	out := new(MsgRevealBidCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/RevealBidCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBundle(ctx context.Context, in *MsgSubmitBundle, opts ...grpc.CallOption) (*MsgSubmitBundleResponse, error) {
+	out := new(MsgSubmitBundleResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/SubmitBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSearcher(ctx context.Context, in *MsgRegisterSearcher, opts ...grpc.CallOption) (*MsgRegisterSearcherResponse, error) {
+	out := new(MsgRegisterSearcherResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/RegisterSearcher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondSearcherCollateral(ctx context.Context, in *MsgUnbondSearcherCollateral, opts ...grpc.CallOption) (*MsgUnbondSearcherCollateralResponse, error) {
+	out := new(MsgUnbondSearcherCollateralResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/UnbondSearcherCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSearcherCollateral(ctx context.Context, in *MsgWithdrawSearcherCollateral, opts ...grpc.CallOption) (*MsgWithdrawSearcherCollateralResponse, error) {
+	out := new(MsgWithdrawSearcherCollateralResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/WithdrawSearcherCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawBuilderRewards(ctx context.Context, in *MsgWithdrawBuilderRewards, opts ...grpc.CallOption) (*MsgWithdrawBuilderRewardsResponse, error) {
+	out := new(MsgWithdrawBuilderRewardsResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/WithdrawBuilderRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBuilderRewardsWithdrawAddress(ctx context.Context, in *MsgSetBuilderRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetBuilderRewardsWithdrawAddressResponse, error) {
+	out := new(MsgSetBuilderRewardsWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/SetBuilderRewardsWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportCensoredBid(ctx context.Context, in *MsgReportCensoredBid, opts ...grpc.CallOption) (*MsgReportCensoredBidResponse, error) {
+	out := new(MsgReportCensoredBidResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/ReportCensoredBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/pob.builder.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AuctionBid defines a method for sending bids to the x/builder module.
+	AuctionBid(context.Context, *MsgAuctionBid) (*MsgAuctionBidResponse, error)
+	// CommitBid defines a method for committing to a sealed bid.
+	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method for revealing a sealed bid. Revealed bids
+	// participate in the auction in the same way as bids sent with AuctionBid.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	// RevealBidCommitment defines a method for revealing a sealed bid without
+	// bidding, e.g. once the revealed bid lost the auction. The collateral of a
+	// revealed commitment is returned to the bidder once the commitment expires.
+	RevealBidCommitment(context.Context, *MsgRevealBidCommitment) (*MsgRevealBidCommitmentResponse, error)
+	// SubmitBundle defines a method for submitting an ordered bundle of
+	// transactions that is included atomically without a bid. Bundles are
+	// ranked by the combined fees of their transactions.
+	SubmitBundle(context.Context, *MsgSubmitBundle) (*MsgSubmitBundleResponse, error)
+	// RegisterSearcher defines a method for registering a searcher and bonding
+	// collateral. Registered searchers can add collateral by registering again.
+	RegisterSearcher(context.Context, *MsgRegisterSearcher) (*MsgRegisterSearcherResponse, error)
+	// UnbondSearcherCollateral defines a method for unbonding collateral of a
+	// registered searcher. The collateral can be withdrawn once the searcher
+	// unbonding period has passed.
+	UnbondSearcherCollateral(context.Context, *MsgUnbondSearcherCollateral) (*MsgUnbondSearcherCollateralResponse, error)
+	// WithdrawSearcherCollateral defines a method for withdrawing the unbonded
+	// collateral of a registered searcher.
+	WithdrawSearcherCollateral(context.Context, *MsgWithdrawSearcherCollateral) (*MsgWithdrawSearcherCollateralResponse, error)
+	// WithdrawBuilderRewards defines a method for withdrawing the proposer
+	// rewards accrued by a validator.
+	WithdrawBuilderRewards(context.Context, *MsgWithdrawBuilderRewards) (*MsgWithdrawBuilderRewardsResponse, error)
+	// SetBuilderRewardsWithdrawAddress defines a method for setting the address
+	// that a validator withdraws its proposer rewards to.
+	SetBuilderRewardsWithdrawAddress(context.Context, *MsgSetBuilderRewardsWithdrawAddress) (*MsgSetBuilderRewardsWithdrawAddressResponse, error)
+	// ReportCensoredBid defines a method for reporting that the proposer of a
+	// block omitted a bid of the reporter that was included in the vote
+	// extension of a validator.
+	ReportCensoredBid(context.Context, *MsgReportCensoredBid) (*MsgReportCensoredBidResponse, error)
+	// UpdateParams defines a governance operation for updating the x/builder
+	// module parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AuctionBid(ctx context.Context, req *MsgAuctionBid) (*MsgAuctionBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionBid not implemented")
+}
+func (*UnimplementedMsgServer) CommitBid(ctx context.Context, req *MsgCommitBid) (*MsgCommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (*UnimplementedMsgServer) RevealBid(ctx context.Context, req *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (*UnimplementedMsgServer) RevealBidCommitment(ctx context.Context, req *MsgRevealBidCommitment) (*MsgRevealBidCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBidCommitment not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBundle(ctx context.Context, req *MsgSubmitBundle) (*MsgSubmitBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBundle not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSearcher(ctx context.Context, req *MsgRegisterSearcher) (*MsgRegisterSearcherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSearcher not implemented")
+}
+func (*UnimplementedMsgServer) UnbondSearcherCollateral(ctx context.Context, req *MsgUnbondSearcherCollateral) (*MsgUnbondSearcherCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondSearcherCollateral not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSearcherCollateral(ctx context.Context, req *MsgWithdrawSearcherCollateral) (*MsgWithdrawSearcherCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSearcherCollateral not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawBuilderRewards(ctx context.Context, req *MsgWithdrawBuilderRewards) (*MsgWithdrawBuilderRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBuilderRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetBuilderRewardsWithdrawAddress(ctx context.Context, req *MsgSetBuilderRewardsWithdrawAddress) (*MsgSetBuilderRewardsWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBuilderRewardsWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) ReportCensoredBid(ctx context.Context, req *MsgReportCensoredBid) (*MsgReportCensoredBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCensoredBid not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AuctionBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuctionBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuctionBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/AuctionBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuctionBid(ctx, req.(*MsgAuctionBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/CommitBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBid(ctx, req.(*MsgCommitBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/RevealBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBidCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBidCommitment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBidCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/RevealBidCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBidCommitment(ctx, req.(*MsgRevealBidCommitment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBundle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/SubmitBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBundle(ctx, req.(*MsgSubmitBundle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSearcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSearcher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSearcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/RegisterSearcher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSearcher(ctx, req.(*MsgRegisterSearcher))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondSearcherCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondSearcherCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondSearcherCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/UnbondSearcherCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondSearcherCollateral(ctx, req.(*MsgUnbondSearcherCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSearcherCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSearcherCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSearcherCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/WithdrawSearcherCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSearcherCollateral(ctx, req.(*MsgWithdrawSearcherCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawBuilderRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawBuilderRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawBuilderRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/WithdrawBuilderRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawBuilderRewards(ctx, req.(*MsgWithdrawBuilderRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBuilderRewardsWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBuilderRewardsWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBuilderRewardsWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/SetBuilderRewardsWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBuilderRewardsWithdrawAddress(ctx, req.(*MsgSetBuilderRewardsWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportCensoredBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportCensoredBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportCensoredBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/ReportCensoredBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportCensoredBid(ctx, req.(*MsgReportCensoredBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pob.builder.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pob.builder.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AuctionBid",
+			Handler:    _Msg_AuctionBid_Handler,
+		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _Msg_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
+		{
+			MethodName: "RevealBidCommitment",
+			Handler:    _Msg_RevealBidCommitment_Handler,
+		},
+		{
+			MethodName: "SubmitBundle",
+			Handler:    _Msg_SubmitBundle_Handler,
+		},
+		{
+			MethodName: "RegisterSearcher",
+			Handler:    _Msg_RegisterSearcher_Handler,
+		},
+		{
+			MethodName: "UnbondSearcherCollateral",
+			Handler:    _Msg_UnbondSearcherCollateral_Handler,
+		},
+		{
+			MethodName: "WithdrawSearcherCollateral",
+			Handler:    _Msg_WithdrawSearcherCollateral_Handler,
+		},
+		{
+			MethodName: "WithdrawBuilderRewards",
+			Handler:    _Msg_WithdrawBuilderRewards_Handler,
+		},
+		{
+			MethodName: "SetBuilderRewardsWithdrawAddress",
+			Handler:    _Msg_SetBuilderRewardsWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "ReportCensoredBid",
+			Handler:    _Msg_ReportCensoredBid_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pob/builder/v1/tx.proto",
+}
+
+func (m *MsgAuctionBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuctionBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuctionBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transactions[iNdEx])
+			copy(dAtA[i:], m.Transactions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Transactions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuctionBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuctionBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuctionBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transactions[iNdEx])
+			copy(dAtA[i:], m.Transactions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Transactions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transactions[iNdEx])
+			copy(dAtA[i:], m.Transactions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Transactions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transactions[iNdEx])
+			copy(dAtA[i:], m.Transactions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Transactions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionBundledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionBundledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionBundledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSearcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSearcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSearcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Searcher) > 0 {
+		i -= len(m.Searcher)
+		copy(dAtA[i:], m.Searcher)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Searcher)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSearcherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSearcherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSearcherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondSearcherCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondSearcherCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondSearcherCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Searcher) > 0 {
+		i -= len(m.Searcher)
+		copy(dAtA[i:], m.Searcher)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Searcher)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondSearcherCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondSearcherCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondSearcherCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSearcherCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSearcherCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSearcherCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Searcher) > 0 {
+		i -= len(m.Searcher)
+		copy(dAtA[i:], m.Searcher)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Searcher)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSearcherCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSearcherCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSearcherCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBuilderRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBuilderRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBuilderRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBuilderRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBuilderRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBuilderRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportCensoredBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportCensoredBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportCensoredBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VoteExtension) > 0 {
+		i -= len(m.VoteExtension)
+		copy(dAtA[i:], m.VoteExtension)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteExtension)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BidTx) > 0 {
+		i -= len(m.BidTx)
+		copy(dAtA[i:], m.BidTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BidTx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportCensoredBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportCensoredBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportCensoredBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAuctionBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Transactions) > 0 {
+		for _, b := range m.Transactions {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAuctionBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCommitBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Transactions) > 0 {
+		for _, b := range m.Transactions {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealBidCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Transactions) > 0 {
+		for _, b := range m.Transactions {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBidCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Transactions) > 0 {
+		for _, b := range m.Transactions {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExtensionOptionBundledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSearcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Searcher)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterSearcherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondSearcherCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Searcher)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnbondSearcherCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *MsgWithdrawSearcherCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Searcher)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawSearcherCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawBuilderRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawBuilderRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportCensoredBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.BidTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoteExtension)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportCensoredBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAuctionBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuctionBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuctionBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, make([]byte, postIndex-iNdEx))
+			copy(m.Transactions[len(m.Transactions)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuctionBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuctionBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuctionBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, make([]byte, postIndex-iNdEx))
+			copy(m.Transactions[len(m.Transactions)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBidCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBidCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, make([]byte, postIndex-iNdEx))
+			copy(m.Transactions[len(m.Transactions)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBidCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBidCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBidCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, make([]byte, postIndex-iNdEx))
+			copy(m.Transactions[len(m.Transactions)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionBundledTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionBundledTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionBundledTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSearcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSearcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSearcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Searcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Searcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSearcherResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSearcherResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSearcherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondSearcherCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondSearcherCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondSearcherCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Searcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Searcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondSearcherCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondSearcherCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondSearcherCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSearcherCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSearcherCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSearcherCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Searcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Searcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSearcherCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSearcherCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSearcherCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawBuilderRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawBuilderRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawBuilderRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawBuilderRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawBuilderRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawBuilderRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBuilderRewardsWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBuilderRewardsWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBuilderRewardsWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgAuctionBid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Bid.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Transactions) > 0 {
-		for _, b := range m.Transactions {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetBuilderRewardsWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBuilderRewardsWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBuilderRewardsWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgAuctionBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAuctionBid) Unmarshal(dAtA []byte) error {
+func (m *MsgReportCensoredBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -629,15 +5198,15 @@ func (m *MsgAuctionBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuctionBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportCensoredBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuctionBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportCensoredBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -665,13 +5234,32 @@ func (m *MsgAuctionBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -681,28 +5269,61 @@ func (m *MsgAuctionBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BidTx = append(m.BidTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.BidTx == nil {
+				m.BidTx = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtension", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -729,8 +5350,44 @@ func (m *MsgAuctionBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transactions = append(m.Transactions, make([]byte, postIndex-iNdEx))
-			copy(m.Transactions[len(m.Transactions)-1], dAtA[iNdEx:postIndex])
+			m.VoteExtension = append(m.VoteExtension[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtension == nil {
+				m.VoteExtension = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -753,7 +5410,7 @@ func (m *MsgAuctionBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuctionBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportCensoredBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -776,10 +5433,10 @@ func (m *MsgAuctionBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuctionBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportCensoredBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuctionBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportCensoredBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorRewards returns a new ValidatorRewards instance.
+func NewValidatorRewards(validator sdk.ValAddress, rewards sdk.Coins) ValidatorRewards {
+	return ValidatorRewards{
+		ValidatorAddress: validator.String(),
+		Rewards:          rewards,
+	}
+}
+
+// Validate performs basic validation on the validator rewards.
+func (r ValidatorRewards) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if err := r.Rewards.Validate(); err != nil {
+		return errors.Wrap(err, "invalid validator rewards")
+	}
+
+	if r.Rewards.IsZero() {
+		return fmt.Errorf("rewards of validator %s cannot be zero", r.ValidatorAddress)
+	}
+
+	return nil
+}
+
+// NewRewardsWithdrawAddress returns a new RewardsWithdrawAddress instance.
+func NewRewardsWithdrawAddress(validator sdk.ValAddress, withdrawAddress sdk.AccAddress) RewardsWithdrawAddress {
+	return RewardsWithdrawAddress{
+		ValidatorAddress: validator.String(),
+		WithdrawAddress:  withdrawAddress.String(),
+	}
+}
+
+// Validate performs basic validation on the rewards withdraw address.
+func (a RewardsWithdrawAddress) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(a.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.WithdrawAddress); err != nil {
+		return errors.Wrap(err, "invalid withdraw address")
+	}
+
+	return nil
+}